@@ -33,6 +33,8 @@ type GatewayFlags struct {
 
 	Server miniogw.ServerConfig
 	Minio  miniogw.MinioConfig
+	Notify miniogw.NotificationConfig
+	Cache  miniogw.CacheConfig
 
 	uplink.Config
 }
@@ -172,11 +174,30 @@ func checkCfg(ctx context.Context) (err error) {
 
 // Run starts a Minio Gateway given proper config
 func (flags GatewayFlags) Run(ctx context.Context) (err error) {
+	var notifier *miniogw.Notifier
+	if flags.Notify.Webhooks != "" {
+		notifier, err = miniogw.NewNotifier(zap.L().Named("notify"), flags.Notify)
+		if err != nil {
+			return err
+		}
+		defer func() { err = errs.Combine(err, notifier.Close()) }()
+
+		err = notifier.RegisterWithMinio(flags.Minio.Dir)
+		if err != nil {
+			return err
+		}
+		go func() {
+			if err := notifier.Run(ctx); err != nil {
+				zap.S().Error("notification delivery stopped: ", err)
+			}
+		}()
+	}
+
 	err = minio.RegisterGatewayCommand(cli.Command{
 		Name:  "storj",
 		Usage: "Storj",
 		Action: func(cliCtx *cli.Context) error {
-			return flags.action(ctx, cliCtx)
+			return flags.action(ctx, cliCtx, notifier)
 		},
 		HideHelpCommand: true,
 	})
@@ -199,18 +220,28 @@ func (flags GatewayFlags) Run(ctx context.Context) (err error) {
 	return errs.New("unexpected minio exit")
 }
 
-func (flags GatewayFlags) action(ctx context.Context, cliCtx *cli.Context) (err error) {
+func (flags GatewayFlags) action(ctx context.Context, cliCtx *cli.Context, notifier *miniogw.Notifier) (err error) {
 	gw, err := flags.NewGateway(ctx)
 	if err != nil {
 		return err
 	}
+	if notifier != nil {
+		gw.SetNotifier(notifier)
+	}
+	if flags.Cache.Size > 0 {
+		cache, err := miniogw.NewReadCache(flags.Cache)
+		if err != nil {
+			return err
+		}
+		gw.SetReadCache(cache)
+	}
 
 	minio.StartGateway(cliCtx, miniogw.Logging(gw, zap.L()))
 	return errs.New("unexpected minio exit")
 }
 
 // NewGateway creates a new minio Gateway
-func (flags GatewayFlags) NewGateway(ctx context.Context) (gw minio.Gateway, err error) {
+func (flags GatewayFlags) NewGateway(ctx context.Context) (gw *miniogw.Gateway, err error) {
 	encKey := new(storj.Key)
 	copy(encKey[:], flags.Enc.Key)
 
@@ -14,9 +14,11 @@ import (
 	"os"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/gogo/protobuf/jsonpb"
 	"github.com/gogo/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
 	prompt "github.com/segmentio/go-prompt"
 	"github.com/spf13/cobra"
 	"github.com/zeebo/errs"
@@ -52,7 +54,9 @@ var (
 	// ErrArgs throws when there are errors with CLI args
 	ErrArgs = errs.Class("error with CLI args:")
 
-	irreparableLimit int32
+	irreparableLimit  int32
+	vettingLimit      int32
+	disqualifiedLimit int32
 
 	// Commander CLI
 	rootCmd = &cobra.Command{
@@ -135,6 +139,22 @@ var (
 		Args:  cobra.MinimumNArgs(1),
 		RunE:  CreateCSVStats,
 	}
+	vettingCmd = &cobra.Command{
+		Use:   "vetting",
+		Short: "list the nodes in the vetting pipeline",
+		RunE:  VettingPipeline,
+	}
+	disqualifyCmd = &cobra.Command{
+		Use:   "disqualify <node_id>",
+		Short: "disqualify a node, its pieces are considered lost",
+		Args:  cobra.MinimumNArgs(1),
+		RunE:  DisqualifyNode,
+	}
+	disqualifiedCmd = &cobra.Command{
+		Use:   "disqualified",
+		Short: "list the disqualified nodes",
+		RunE:  DisqualifiedNodes,
+	}
 	objectHealthCmd = &cobra.Command{
 		Use:   "object <project-id> <bucket> <encrypted-path>",
 		Short: "Get stats about an object's health",
@@ -394,6 +414,74 @@ func GetCSVStats(cmd *cobra.Command, args []string) (err error) {
 	return nil
 }
 
+// VettingPipeline lists the nodes that aren't vetted yet
+func VettingPipeline(cmd *cobra.Command, args []string) (err error) {
+	i, err := NewInspector(*Addr, *IdentityPath)
+	if err != nil {
+		return ErrInspectorDial.Wrap(err)
+	}
+
+	res, err := i.overlayclient.VettingPipeline(context.Background(), &pb.VettingPipelineRequest{
+		Limit: vettingLimit,
+	})
+	if err != nil {
+		return ErrRequest.Wrap(err)
+	}
+
+	fmt.Printf("Vetting requires AuditCount: %d, UptimeCount: %d\n", res.RequiredAuditCount, res.RequiredUptimeCount)
+	for _, node := range res.Nodes {
+		fmt.Printf("%s AuditCount: %d, UptimeCount: %d\n", node.NodeId, node.AuditCount, node.UptimeCount)
+	}
+	return nil
+}
+
+// DisqualifyNode disqualifies a node
+func DisqualifyNode(cmd *cobra.Command, args []string) (err error) {
+	i, err := NewInspector(*Addr, *IdentityPath)
+	if err != nil {
+		return ErrInspectorDial.Wrap(err)
+	}
+
+	nodeID, err := storj.NodeIDFromString(args[0])
+	if err != nil {
+		return ErrArgs.Wrap(err)
+	}
+
+	_, err = i.overlayclient.DisqualifyNode(context.Background(), &pb.DisqualifyNodeRequest{
+		NodeId: nodeID,
+	})
+	if err != nil {
+		return ErrRequest.Wrap(err)
+	}
+
+	fmt.Printf("Disqualified node %s\n", nodeID)
+	return nil
+}
+
+// DisqualifiedNodes lists the disqualified nodes
+func DisqualifiedNodes(cmd *cobra.Command, args []string) (err error) {
+	i, err := NewInspector(*Addr, *IdentityPath)
+	if err != nil {
+		return ErrInspectorDial.Wrap(err)
+	}
+
+	res, err := i.overlayclient.DisqualifiedNodes(context.Background(), &pb.DisqualifiedNodesRequest{
+		Limit: disqualifiedLimit,
+	})
+	if err != nil {
+		return ErrRequest.Wrap(err)
+	}
+
+	for _, node := range res.Nodes {
+		disqualified, err := ptypes.Timestamp(node.Disqualified)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("%s Disqualified: %s\n", node.NodeId, disqualified.Format(time.RFC3339))
+	}
+	return nil
+}
+
 // CreateStats creates a node with stats in overlay
 func CreateStats(cmd *cobra.Command, args []string) (err error) {
 	i, err := NewInspector(*Addr, *IdentityPath)
@@ -764,6 +852,9 @@ func init() {
 	statsCmd.AddCommand(getCSVStatsCmd)
 	statsCmd.AddCommand(createStatsCmd)
 	statsCmd.AddCommand(createCSVStatsCmd)
+	statsCmd.AddCommand(vettingCmd)
+	statsCmd.AddCommand(disqualifyCmd)
+	statsCmd.AddCommand(disqualifiedCmd)
 
 	healthCmd.AddCommand(objectHealthCmd)
 	healthCmd.AddCommand(segmentHealthCmd)
@@ -772,6 +863,9 @@ func init() {
 
 	irreparableCmd.Flags().Int32Var(&irreparableLimit, "limit", 50, "max number of results per page")
 
+	vettingCmd.Flags().Int32Var(&vettingLimit, "limit", 50, "max number of nodes to list")
+	disqualifiedCmd.Flags().Int32Var(&disqualifiedLimit, "limit", 50, "max number of nodes to list")
+
 	flag.Parse()
 }
 
@@ -32,5 +32,9 @@ func (c cacheConfig) open(ctx context.Context) (cache *overlay.Cache, dbClose fu
 		}
 	}
 
-	return overlay.NewCache(zap.L(), database.OverlayCache(), overlay.NodeSelectionConfig{OnlineWindow: time.Hour}), dbClose, nil
+	return overlay.NewCache(zap.L(), database.OverlayCache(), overlay.NodeSelectionConfig{
+		OnlineWindow:           time.Hour,
+		AuditReputationAlpha0:  20,
+		UptimeReputationAlpha0: 100,
+	}), dbClose, nil
 }
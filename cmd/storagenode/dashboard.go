@@ -115,6 +115,15 @@ func printDashboard(data *pb.DashboardResponse) error {
 		fmt.Fprintf(w, "Uptime\t%s\n", color.YellowString(uptime.Truncate(time.Second).String()))
 	}
 
+	switch {
+	case data.GetCorruptPieces() > 0:
+		fmt.Fprintf(w, "Corrupt Pieces\t%s\n", color.RedString(fmt.Sprintf("%d", data.GetCorruptPieces())))
+	case data.GetLastScrubbed() == nil:
+		fmt.Fprintf(w, "Corrupt Pieces\t%s\n", color.YellowString("not scrubbed yet"))
+	default:
+		fmt.Fprintf(w, "Corrupt Pieces\t%s\n", color.GreenString("0"))
+	}
+
 	if err = w.Flush(); err != nil {
 		return err
 	}
@@ -0,0 +1,116 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/storj/pkg/process"
+	"storj.io/storj/storagenode"
+	"storj.io/storj/storagenode/backup"
+	"storj.io/storj/storagenode/pieces"
+	"storj.io/storj/storagenode/storagenodedb"
+)
+
+func cmdDBCheck(cmd *cobra.Command, args []string) (err error) {
+	ctx := process.Ctx(cmd)
+
+	dbConfig, err := databaseConfig(dbCheckCfg.Config)
+	if err != nil {
+		return err
+	}
+
+	if _, err := os.Stat(dbConfig.Info2); err == nil {
+		problems, err := storagenodedb.CheckIntegrityOf(ctx, dbConfig.Info2)
+		if err != nil {
+			problems = append(problems, err.Error())
+		}
+		for _, problem := range problems {
+			fmt.Println("Integrity check:", problem)
+		}
+
+		if len(problems) > 0 {
+			if !dbCheckCfg.Restore {
+				return errs.New("database %q is corrupt, use --restore to restore the latest backup", dbConfig.Info2)
+			}
+			if err := restoreLatestBackup(ctx, dbCheckCfg.Backup.Dir, dbConfig.Info2); err != nil {
+				return err
+			}
+		} else {
+			fmt.Println("Integrity check passed.")
+		}
+	} else if dbCheckCfg.Restore {
+		if err := restoreLatestBackup(ctx, dbCheckCfg.Backup.Dir, dbConfig.Info2); err != nil {
+			return err
+		}
+	}
+
+	db, err := storagenodedb.New(zap.L().Named("db"), dbConfig)
+	if err != nil {
+		return errs.New("Error starting master database on storage node: %v", err)
+	}
+	defer func() {
+		err = errs.Combine(err, db.Close())
+	}()
+
+	err = db.CreateTables()
+	if err != nil {
+		return errs.New("Error creating tables for master database on storage node: %v", err)
+	}
+
+	dirs, err := storagenode.StorageDirs(db, dbCheckCfg.Storage)
+	if err != nil {
+		return err
+	}
+
+	store := pieces.NewMultiDirStore(zap.L().Named("pieces"), db.PieceInfo(), dirs)
+	stats, err := store.CheckPieceInfo(ctx, dbCheckCfg.Repair)
+	fmt.Printf("Checked %d pieces, %d piece files missing, %d removed from the database, %d piece files unknown.\n",
+		stats.Checked, stats.Missing, stats.Removed, stats.Unknown)
+	if err != nil {
+		return err
+	}
+
+	if !dbCheckCfg.Repair {
+		if stats.Missing > 0 || stats.Unknown > 0 {
+			fmt.Println("Use --repair to remove the missing pieces and recover the unknown pieces.")
+		}
+		return nil
+	}
+
+	if stats.Unknown > 0 {
+		satellites, closeSatellites, err := satelliteSignees(ctx, dbCheckCfg.Config)
+		if err != nil {
+			return err
+		}
+		defer func() { err = errs.Combine(err, closeSatellites()) }()
+
+		recovered, err := store.RecoverPieceInfo(ctx, satellites)
+		fmt.Printf("Recovered %d pieces, %d pieces unrecoverable.\n", recovered.Recovered, recovered.Unrecoverable)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// restoreLatestBackup replaces the database at path with the latest backup in dir.
+func restoreLatestBackup(ctx context.Context, dir, path string) error {
+	latest, err := backup.Latest(dir)
+	if err != nil {
+		return err
+	}
+
+	if err := storagenodedb.Restore(ctx, latest, path); err != nil {
+		return err
+	}
+	fmt.Printf("Restored %q from %q.\n", path, latest)
+	return nil
+}
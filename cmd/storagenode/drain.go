@@ -0,0 +1,61 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/storj/pkg/process"
+	"storj.io/storj/storagenode"
+	"storj.io/storj/storagenode/pieces"
+	"storj.io/storj/storagenode/storagenodedb"
+)
+
+func cmdDrainDir(cmd *cobra.Command, args []string) (err error) {
+	ctx := process.Ctx(cmd)
+
+	dbConfig, err := databaseConfig(drainCfg)
+	if err != nil {
+		return err
+	}
+
+	db, err := storagenodedb.New(zap.L().Named("db"), dbConfig)
+	if err != nil {
+		return errs.New("Error starting master database on storage node: %v", err)
+	}
+	defer func() {
+		err = errs.Combine(err, db.Close())
+	}()
+
+	err = db.CreateTables()
+	if err != nil {
+		return errs.New("Error creating tables for master database on storage node: %v", err)
+	}
+
+	dirs, err := storagenode.StorageDirs(db, drainCfg.Storage)
+	if err != nil {
+		return err
+	}
+
+	// the main storage directory is identified by an empty ID
+	dirID := args[0]
+	if filepath.Clean(dirID) == filepath.Clean(drainCfg.Storage.Path) {
+		dirID = ""
+	}
+
+	store := pieces.NewMultiDirStore(zap.L().Named("pieces"), db.PieceInfo(), dirs)
+	moved, err := store.Drain(ctx, dirID)
+	fmt.Printf("Moved %d pieces from %s.\n", moved, args[0])
+	if err != nil {
+		return err
+	}
+
+	fmt.Println("The directory can now be removed from the configuration.")
+	return nil
+}
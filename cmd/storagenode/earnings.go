@@ -0,0 +1,86 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/storj/internal/memory"
+	"storj.io/storj/pkg/process"
+	"storj.io/storj/storagenode/earnings"
+	"storj.io/storj/storagenode/storagenodedb"
+)
+
+func cmdEarnings(cmd *cobra.Command, args []string) (err error) {
+	ctx := process.Ctx(cmd)
+
+	if earningsCfg.Months <= 0 {
+		return errs.New("months must be positive")
+	}
+
+	dbConfig, err := databaseConfig(earningsCfg.Config)
+	if err != nil {
+		return err
+	}
+
+	// the database is only read, it is migrated by the running node
+	if _, err := os.Stat(dbConfig.Info2); err != nil {
+		return errs.New("storage node database not found: %v", err)
+	}
+
+	db, err := storagenodedb.New(zap.L().Named("db"), dbConfig)
+	if err != nil {
+		return errs.New("Error starting master database on storage node: %v", err)
+	}
+	defer func() {
+		err = errs.Combine(err, db.Close())
+	}()
+
+	service, err := earnings.NewService(zap.L().Named("earnings"), db.Orders(), db.PieceInfo(), db.Bandwidth(), earningsCfg.Earnings)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	estimates, err := service.Estimate(ctx, now.AddDate(0, 1-earningsCfg.Months, 0), now, now)
+	if err != nil {
+		return err
+	}
+
+	if len(estimates) == 0 {
+		fmt.Println("No earnings.")
+		return nil
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', tabwriter.AlignRight|tabwriter.Debug)
+	defer func() { err = errs.Combine(err, w.Flush()) }()
+
+	var total, heldBack, payable float64
+	fmt.Fprint(w, "Month\tSatellite\tEgress\tRepair & Audit\tStorage\tTotal\tHeld back\tPayable\n")
+	for _, estimate := range estimates {
+		fmt.Fprintf(w, "%s\t%v\t%v / $%.2f\t%v / $%.2f\t%v·h / $%.2f\t$%.2f\t%.0f%% / $%.2f\t$%.2f\n",
+			estimate.Month.Format("2006-01"),
+			estimate.SatelliteID,
+			memory.Size(estimate.EgressBytes), estimate.Egress,
+			memory.Size(estimate.RepairBytes+estimate.AuditBytes), estimate.Repair+estimate.Audit,
+			memory.Size(estimate.StorageByteHours), estimate.Storage,
+			estimate.Total,
+			estimate.HeldBackPercent, estimate.HeldBack,
+			estimate.Payable,
+		)
+		total += estimate.Total
+		heldBack += estimate.HeldBack
+		payable += estimate.Payable
+	}
+	fmt.Fprintf(w, "Total\t\t\t\t\t$%.2f\t$%.2f\t$%.2f\n", total, heldBack, payable)
+
+	return nil
+}
@@ -0,0 +1,87 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/internal/memory"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/process"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/pkg/transport"
+)
+
+func cmdExitSatellite(cmd *cobra.Command, args []string) (err error) {
+	ctx := process.Ctx(cmd)
+
+	satelliteID, err := storj.NodeIDFromString(args[0])
+	if err != nil {
+		return errs.New("invalid satellite id %q: %v", args[0], err)
+	}
+
+	conn, err := transport.DialAddressInsecure(ctx, gracefulExitCfg.Address)
+	if err != nil {
+		return err
+	}
+	defer func() { err = errs.Combine(err, conn.Close()) }()
+
+	_, err = pb.NewNodeGracefulExitClient(conn).InitiateGracefulExit(ctx, &pb.InitiateGracefulExitRequest{
+		NodeId: satelliteID,
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Graceful exit from %s initiated, use exit-status to follow the progress.\n", satelliteID)
+	return nil
+}
+
+func cmdExitStatus(cmd *cobra.Command, args []string) (err error) {
+	ctx := process.Ctx(cmd)
+
+	conn, err := transport.DialAddressInsecure(ctx, gracefulExitCfg.Address)
+	if err != nil {
+		return err
+	}
+	defer func() { err = errs.Combine(err, conn.Close()) }()
+
+	response, err := pb.NewNodeGracefulExitClient(conn).GetExitProgress(ctx, &pb.GetExitProgressRequest{})
+	if err != nil {
+		return err
+	}
+
+	if len(response.Progress) == 0 {
+		fmt.Println("No graceful exits initiated.")
+		return nil
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', tabwriter.AlignRight|tabwriter.Debug)
+	defer func() { err = errs.Combine(err, w.Flush()) }()
+
+	fmt.Fprint(w, "Satellite\tStatus\tTransferred\tPieces\tFailed\n")
+	for _, progress := range response.Progress {
+		status := "in progress"
+		switch {
+		case progress.Finished && progress.Successful:
+			status = "completed"
+		case progress.Finished:
+			status = "failed"
+		}
+		fmt.Fprintf(w, "%v\t%v\t%v\t%v\t%v\n",
+			progress.SatelliteId,
+			status,
+			memory.Size(progress.BytesTransferred),
+			progress.PiecesTransferred,
+			progress.PiecesFailed,
+		)
+	}
+
+	return nil
+}
@@ -67,13 +67,84 @@ var (
 		RunE:        cmdDashboard,
 		Annotations: map[string]string{"type": "helper"},
 	}
+	exitSatelliteCmd = &cobra.Command{
+		Use:         "exit-satellite <satellite-id>",
+		Short:       "Start a graceful exit from a satellite",
+		Args:        cobra.ExactArgs(1),
+		RunE:        cmdExitSatellite,
+		Annotations: map[string]string{"type": "helper"},
+	}
+	drainDirCmd = &cobra.Command{
+		Use:   "drain-dir <path>",
+		Short: "Move all pieces from a storage directory to the other directories",
+		Long: "Move all pieces from a storage directory to the other configured storage directories.\n" +
+			"The storage node must be stopped while draining.",
+		Args:        cobra.ExactArgs(1),
+		RunE:        cmdDrainDir,
+		Annotations: map[string]string{"type": "helper"},
+	}
+	recoverPieceInfoCmd = &cobra.Command{
+		Use:   "recover-pieceinfo",
+		Short: "Rebuild the piece info database from the stored piece headers",
+		Long: "Rebuild missing piece info database entries from the headers of the stored pieces.\n" +
+			"Pieces stored without a header cannot be recovered.\n" +
+			"The storage node must be stopped while recovering.",
+		Args:        cobra.NoArgs,
+		RunE:        cmdRecoverPieceInfo,
+		Annotations: map[string]string{"type": "helper"},
+	}
+	earningsCmd = &cobra.Command{
+		Use:   "earnings",
+		Short: "Estimate the earnings of the storage node",
+		Long: "Estimate the earnings of each satellite from the settled orders and the stored pieces.\n" +
+			"Pieces which have been deleted are not included in the storage earnings.",
+		RunE:        cmdEarnings,
+		Annotations: map[string]string{"type": "helper"},
+	}
+	dbCmd = &cobra.Command{
+		Use:         "db",
+		Short:       "Manage the storage node database",
+		Annotations: map[string]string{"type": "helper"},
+	}
+	dbCheckCmd = &cobra.Command{
+		Use:   "check",
+		Short: "Check the integrity of the storage node database",
+		Long: "Check the integrity of the storage node database and cross-check the piece info database against the stored pieces.\n" +
+			"A corrupt database can be restored from the latest backup with --restore.\n" +
+			"The storage node must be stopped while checking.",
+		Args:        cobra.NoArgs,
+		RunE:        cmdDBCheck,
+		Annotations: map[string]string{"type": "helper"},
+	}
+	exitStatusCmd = &cobra.Command{
+		Use:         "exit-status",
+		Short:       "Display the progress of the graceful exits",
+		RunE:        cmdExitStatus,
+		Annotations: map[string]string{"type": "helper"},
+	}
+
+	runCfg      StorageNodeFlags
+	setupCfg    StorageNodeFlags
+	diagCfg     storagenode.Config
+	drainCfg    storagenode.Config
+	recoverCfg  storagenode.Config
+	earningsCfg struct {
+		Months int `default:"1" help:"number of months to estimate, including the current month"`
+
+		storagenode.Config
+	}
+	dbCheckCfg struct {
+		Repair  bool `default:"false" help:"remove the pieces without piece files and recover the unknown piece files"`
+		Restore bool `default:"false" help:"restore the latest backup when the database is corrupt"`
 
-	runCfg       StorageNodeFlags
-	setupCfg     StorageNodeFlags
-	diagCfg      storagenode.Config
+		storagenode.Config
+	}
 	dashboardCfg struct {
 		Address string `default:"127.0.0.1:7778" help:"address for dashboard service"`
 	}
+	gracefulExitCfg struct {
+		Address string `default:"127.0.0.1:7778" help:"address of the storage node's private server"`
+	}
 	defaultDiagDir string
 	confDir        string
 	identityDir    string
@@ -98,21 +169,45 @@ func init() {
 	rootCmd.AddCommand(configCmd)
 	rootCmd.AddCommand(diagCmd)
 	rootCmd.AddCommand(dashboardCmd)
+	rootCmd.AddCommand(exitSatelliteCmd)
+	rootCmd.AddCommand(exitStatusCmd)
+	rootCmd.AddCommand(drainDirCmd)
+	rootCmd.AddCommand(recoverPieceInfoCmd)
+	rootCmd.AddCommand(earningsCmd)
+	rootCmd.AddCommand(dbCmd)
+	dbCmd.AddCommand(dbCheckCmd)
 	cfgstruct.Bind(runCmd.Flags(), &runCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
 	cfgstruct.BindSetup(setupCmd.Flags(), &setupCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
 	cfgstruct.BindSetup(configCmd.Flags(), &setupCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
 	cfgstruct.Bind(diagCmd.Flags(), &diagCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
+	cfgstruct.Bind(drainDirCmd.Flags(), &drainCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
+	cfgstruct.Bind(recoverPieceInfoCmd.Flags(), &recoverCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
+	cfgstruct.Bind(earningsCmd.Flags(), &earningsCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
+	cfgstruct.Bind(dbCheckCmd.Flags(), &dbCheckCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
 	cfgstruct.Bind(dashboardCmd.Flags(), &dashboardCfg, defaults, cfgstruct.ConfDir(defaultDiagDir))
+	cfgstruct.Bind(exitSatelliteCmd.Flags(), &gracefulExitCfg, defaults, cfgstruct.ConfDir(defaultDiagDir))
+	cfgstruct.Bind(exitStatusCmd.Flags(), &gracefulExitCfg, defaults, cfgstruct.ConfDir(defaultDiagDir))
 }
 
-func databaseConfig(config storagenode.Config) storagenodedb.Config {
-	return storagenodedb.Config{
-		Storage:  config.Storage.Path,
-		Info:     filepath.Join(config.Storage.Path, "piecestore.db"),
-		Info2:    filepath.Join(config.Storage.Path, "info.db"),
-		Pieces:   config.Storage.Path,
-		Kademlia: config.Kademlia.DBPath,
+func databaseConfig(config storagenode.Config) (storagenodedb.Config, error) {
+	extraDirs, err := config.Storage.ParseExtraDirs()
+	if err != nil {
+		return storagenodedb.Config{}, err
 	}
+
+	var extraPieces []string
+	for _, dir := range extraDirs {
+		extraPieces = append(extraPieces, dir.Path)
+	}
+
+	return storagenodedb.Config{
+		Storage:     config.Storage.Path,
+		Info:        filepath.Join(config.Storage.Path, "piecestore.db"),
+		Info2:       filepath.Join(config.Storage.Path, "info.db"),
+		Pieces:      config.Storage.Path,
+		ExtraPieces: extraPieces,
+		Kademlia:    config.Kademlia.DBPath,
+	}, nil
 }
 
 func cmdRun(cmd *cobra.Command, args []string) (err error) {
@@ -131,7 +226,12 @@ func cmdRun(cmd *cobra.Command, args []string) (err error) {
 		return err
 	}
 
-	db, err := storagenodedb.New(log.Named("db"), databaseConfig(runCfg.Config))
+	dbConfig, err := databaseConfig(runCfg.Config)
+	if err != nil {
+		return err
+	}
+
+	db, err := storagenodedb.New(log.Named("db"), dbConfig)
 	if err != nil {
 		return errs.New("Error starting master database on storagenode: %+v", err)
 	}
@@ -242,7 +342,12 @@ func cmdDiag(cmd *cobra.Command, args []string) (err error) {
 		return err
 	}
 
-	db, err := storagenodedb.New(zap.L().Named("db"), databaseConfig(diagCfg))
+	dbConfig, err := databaseConfig(diagCfg)
+	if err != nil {
+		return err
+	}
+
+	db, err := storagenodedb.New(zap.L().Named("db"), dbConfig)
 	if err != nil {
 		return errs.New("Error starting master database on storage node: %v", err)
 	}
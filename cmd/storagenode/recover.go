@@ -0,0 +1,102 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/storj/pkg/identity"
+	"storj.io/storj/pkg/kademlia"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/peertls/tlsopts"
+	"storj.io/storj/pkg/process"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/pkg/transport"
+	"storj.io/storj/storagenode"
+	"storj.io/storj/storagenode/pieces"
+	"storj.io/storj/storagenode/storagenodedb"
+	"storj.io/storj/storagenode/trust"
+)
+
+func cmdRecoverPieceInfo(cmd *cobra.Command, args []string) (err error) {
+	ctx := process.Ctx(cmd)
+
+	dbConfig, err := databaseConfig(recoverCfg)
+	if err != nil {
+		return err
+	}
+
+	db, err := storagenodedb.New(zap.L().Named("db"), dbConfig)
+	if err != nil {
+		return errs.New("Error starting master database on storage node: %v", err)
+	}
+	defer func() {
+		err = errs.Combine(err, db.Close())
+	}()
+
+	err = db.CreateTables()
+	if err != nil {
+		return errs.New("Error creating tables for master database on storage node: %v", err)
+	}
+
+	dirs, err := storagenode.StorageDirs(db, recoverCfg.Storage)
+	if err != nil {
+		return err
+	}
+
+	satellites, closeSatellites, err := satelliteSignees(ctx, recoverCfg)
+	if err != nil {
+		return err
+	}
+	defer func() { err = errs.Combine(err, closeSatellites()) }()
+
+	store := pieces.NewMultiDirStore(zap.L().Named("pieces"), db.PieceInfo(), dirs)
+	stats, err := store.RecoverPieceInfo(ctx, satellites)
+	fmt.Printf("Recovered %d pieces, %d pieces already known, %d pieces unrecoverable.\n",
+		stats.Recovered, stats.Existing, stats.Unrecoverable)
+	return err
+}
+
+// satelliteSignees returns a trust pool for verifying the signatures of the
+// satellites. As kademlia isn't running, only the identities of satellites
+// with an address in the trust sources can be fetched.
+func satelliteSignees(ctx context.Context, config storagenode.Config) (*trust.Pool, func() error, error) {
+	ident, err := config.Identity.Load()
+	if err != nil {
+		return nil, nil, err
+	}
+	options, err := tlsopts.NewOptions(ident, config.Server.Config)
+	if err != nil {
+		return nil, nil, err
+	}
+	dialer := kademlia.NewDialer(zap.L().Named("dialer"), transport.NewClient(options))
+
+	trustAllSatellites := !config.Storage.SatelliteIDRestriction
+	pool, err := trust.NewPool(zap.L().Named("trust"), dialerIdentities{dialer}, trustAllSatellites, config.Storage.WhitelistedSatelliteIDs, config.Trust)
+	if err != nil {
+		return nil, nil, errs.Combine(err, dialer.Close())
+	}
+	pool.Refresh(ctx)
+	return pool, dialer.Close, nil
+}
+
+// dialerIdentities fetches the identities of nodes with a known address.
+type dialerIdentities struct {
+	dialer *kademlia.Dialer
+}
+
+// FetchPeerIdentity fails, as nodes cannot be looked up without kademlia.
+func (identities dialerIdentities) FetchPeerIdentity(ctx context.Context, nodeID storj.NodeID) (*identity.PeerIdentity, error) {
+	return nil, errs.New("address of %s unknown", nodeID)
+}
+
+// FetchPeerIdentityFromNode connects to the node and returns its identity.
+func (identities dialerIdentities) FetchPeerIdentityFromNode(ctx context.Context, node pb.Node) (*identity.PeerIdentity, error) {
+	return identities.dialer.FetchPeerIdentity(ctx, node)
+}
@@ -4,8 +4,6 @@
 package cmd
 
 import (
-	"fmt"
-
 	"github.com/spf13/cobra"
 
 	"storj.io/storj/internal/fpath"
@@ -23,7 +21,7 @@ func init() {
 // catMain is the function executed when catCmd is called
 func catMain(cmd *cobra.Command, args []string) (err error) {
 	if len(args) == 0 {
-		return fmt.Errorf("No object specified for copy")
+		return usageError("No object specified for copy")
 	}
 
 	ctx := process.Ctx(cmd)
@@ -34,7 +32,7 @@ func catMain(cmd *cobra.Command, args []string) (err error) {
 	}
 
 	if src.IsLocal() {
-		return fmt.Errorf("No bucket specified, use format sj://bucket/")
+		return usageError("No bucket specified, use format sj://bucket/")
 	}
 
 	dst, err := fpath.New("-")
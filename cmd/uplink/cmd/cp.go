@@ -5,7 +5,6 @@ package cmd
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"io"
 	"os"
@@ -94,7 +93,7 @@ func upload(ctx context.Context, src fpath.FPath, dst fpath.FPath, showProgress
 
 	reader := io.Reader(file)
 	var bar *progressbar.ProgressBar
-	if showProgress {
+	if showProgress && !jsonOutput() {
 		bar = progressbar.New64(fileInfo.Size()).SetUnits(progressbar.U_BYTES)
 		bar.Start()
 		reader = bar.NewProxyReader(reader)
@@ -117,6 +116,10 @@ func upload(ctx context.Context, src fpath.FPath, dst fpath.FPath, showProgress
 		bar.Finish()
 	}
 
+	if jsonOutput() {
+		return printJSON(transferOutput{Action: "upload", Source: src.String(), Destination: dst.String(), Size: fileInfo.Size()})
+	}
+
 	fmt.Printf("Created %s\n", dst.String())
 
 	return nil
@@ -155,7 +158,7 @@ func download(ctx context.Context, src fpath.FPath, dst fpath.FPath, showProgres
 
 	var bar *progressbar.ProgressBar
 	var reader io.ReadCloser
-	if showProgress {
+	if showProgress && !jsonOutput() {
 		bar = progressbar.New64(object.Meta.Size).SetUnits(progressbar.U_BYTES)
 		bar.Start()
 		reader = bar.NewProxyReader(rc)
@@ -191,6 +194,10 @@ func download(ctx context.Context, src fpath.FPath, dst fpath.FPath, showProgres
 		bar.Finish()
 	}
 
+	if jsonOutput() && dst.Base() != "-" {
+		return printJSON(transferOutput{Action: "download", Source: src.String(), Destination: dst.String(), Size: object.Meta.Size})
+	}
+
 	if dst.Base() != "-" {
 		fmt.Printf("Downloaded %s to %s\n", src.String(), dst.String())
 	}
@@ -231,7 +238,7 @@ func copyObject(ctx context.Context, src fpath.FPath, dst fpath.FPath) (err erro
 
 	var bar *progressbar.ProgressBar
 	var reader io.Reader
-	if *progress {
+	if *progress && !jsonOutput() {
 		bar = progressbar.New64(object.Meta.Size).SetUnits(progressbar.U_BYTES)
 		bar.Start()
 		reader = bar.NewProxyReader(rc)
@@ -260,6 +267,10 @@ func copyObject(ctx context.Context, src fpath.FPath, dst fpath.FPath) (err erro
 		bar.Finish()
 	}
 
+	if jsonOutput() {
+		return printJSON(transferOutput{Action: "copy", Source: src.String(), Destination: dst.String(), Size: object.Meta.Size})
+	}
+
 	fmt.Printf("%s copied to %s\n", src.String(), dst.String())
 
 	return nil
@@ -268,10 +279,10 @@ func copyObject(ctx context.Context, src fpath.FPath, dst fpath.FPath) (err erro
 // copyMain is the function executed when cpCmd is called
 func copyMain(cmd *cobra.Command, args []string) (err error) {
 	if len(args) == 0 {
-		return fmt.Errorf("No object specified for copy")
+		return usageError("No object specified for copy")
 	}
 	if len(args) == 1 {
-		return fmt.Errorf("No destination specified")
+		return usageError("No destination specified")
 	}
 
 	ctx := process.Ctx(cmd)
@@ -288,7 +299,7 @@ func copyMain(cmd *cobra.Command, args []string) (err error) {
 
 	// if both local
 	if src.IsLocal() && dst.IsLocal() {
-		return errors.New("At least one of the source or the desination must be a Storj URL")
+		return usageError("At least one of the source or the desination must be a Storj URL")
 	}
 
 	// if uploading
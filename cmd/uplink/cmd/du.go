@@ -0,0 +1,235 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/spf13/cobra"
+	"golang.org/x/sync/errgroup"
+
+	"storj.io/storj/internal/fpath"
+	"storj.io/storj/internal/memory"
+	libuplink "storj.io/storj/lib/uplink"
+	"storj.io/storj/pkg/process"
+	"storj.io/storj/pkg/storj"
+)
+
+var (
+	duDepthFlag       *int
+	duParallelismFlag *int
+	duHumanFlag       *bool
+)
+
+func init() {
+	duCmd := addCmd(&cobra.Command{
+		Use:   "du",
+		Short: "Summarize the size and object count of a bucket or prefix",
+		RunE:  diskUsage,
+	}, RootCmd)
+	duDepthFlag = duCmd.Flags().Int("depth", 0, "show the usage of prefixes up to this many levels below the given prefix")
+	duParallelismFlag = duCmd.Flags().Int("parallelism", 8, "number of prefixes listed concurrently")
+	duHumanFlag = duCmd.Flags().Bool("human", false, "if true, print sizes with binary prefixes (KiB, MiB, ...)")
+}
+
+// usageOutput is the JSON representation of the usage of a prefix.
+type usageOutput struct {
+	Bucket  string `json:"bucket"`
+	Prefix  string `json:"prefix"`
+	Depth   int    `json:"depth"`
+	Size    int64  `json:"size"`
+	Objects int64  `json:"objects"`
+}
+
+// diskUsage is the function executed when duCmd is called
+func diskUsage(cmd *cobra.Command, args []string) error {
+	if len(args) == 0 {
+		return usageError("No bucket specified, use format sj://bucket/prefix")
+	}
+	if *duDepthFlag < 0 {
+		return usageError("Invalid depth %d", *duDepthFlag)
+	}
+	if *duParallelismFlag < 1 {
+		return usageError("Invalid parallelism %d", *duParallelismFlag)
+	}
+
+	ctx := process.Ctx(cmd)
+
+	src, err := fpath.New(args[0])
+	if err != nil {
+		return err
+	}
+
+	if src.IsLocal() {
+		return usageError("No bucket specified, use format sj://bucket/prefix")
+	}
+
+	var access libuplink.EncryptionAccess
+	copy(access.Key[:], []byte(cfg.Enc.Key))
+
+	project, bucket, err := cfg.GetProjectAndBucket(ctx, src.Bucket(), access)
+	if err != nil {
+		return convertError(err, src)
+	}
+	defer closeProjectAndBucket(project, bucket)
+
+	prefix := src.Path()
+	if prefix != "" && !strings.HasSuffix(prefix, "/") {
+		prefix += "/"
+	}
+
+	walker := newUsageWalker(bucket, src.Bucket(), prefix, *duDepthFlag, *duParallelismFlag)
+	usages, err := walker.walk(ctx)
+	if err != nil {
+		return convertError(err, src)
+	}
+
+	if jsonOutput() {
+		return printJSON(usages)
+	}
+
+	for _, usage := range usages {
+		size := fmt.Sprint(usage.Size)
+		if *duHumanFlag {
+			size = memory.Size(usage.Size).Base2String()
+		}
+		fmt.Printf("%12v %10d %s\n", size, usage.Objects, usage.Prefix)
+	}
+	return nil
+}
+
+// usageWalker sums the sizes of objects below a prefix, listing the
+// prefixes it finds concurrently.
+type usageWalker struct {
+	bucket     *libuplink.Bucket
+	bucketName string
+	prefix     string
+	depth      int
+	limiter    chan struct{}
+
+	mu     sync.Mutex
+	usages map[string]*usageOutput
+}
+
+func newUsageWalker(bucket *libuplink.Bucket, bucketName, prefix string, depth, parallelism int) *usageWalker {
+	return &usageWalker{
+		bucket:     bucket,
+		bucketName: bucketName,
+		prefix:     prefix,
+		depth:      depth,
+		limiter:    make(chan struct{}, parallelism),
+		usages:     map[string]*usageOutput{},
+	}
+}
+
+// walk lists everything below the prefix and returns the usage of all
+// prefixes up to the configured depth, sorted by prefix.
+func (walker *usageWalker) walk(ctx context.Context) ([]usageOutput, error) {
+	walker.usages[walker.prefix] = &usageOutput{
+		Bucket: walker.bucketName,
+		Prefix: walker.prefix,
+	}
+
+	group, ctx := errgroup.WithContext(ctx)
+	group.Go(func() error {
+		return walker.list(ctx, group, walker.prefix, nil)
+	})
+	if err := group.Wait(); err != nil {
+		return nil, err
+	}
+
+	usages := make([]usageOutput, 0, len(walker.usages))
+	for _, usage := range walker.usages {
+		usages = append(usages, *usage)
+	}
+	sort.Slice(usages, func(i, k int) bool {
+		return usages[i].Prefix < usages[k].Prefix
+	})
+	return usages, nil
+}
+
+// list lists a single prefix, adding its objects to the usage and
+// starting a new listing for every prefix found. ancestors are the
+// prefixes between walker.prefix and prefix.
+func (walker *usageWalker) list(ctx context.Context, group *errgroup.Group, prefix string, ancestors []string) error {
+	select {
+	case walker.limiter <- struct{}{}:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	defer func() { <-walker.limiter }()
+
+	var size, objects int64
+	startAfter := ""
+	for {
+		list, err := walker.bucket.ListObjects(ctx, &storj.ListOptions{
+			Direction: storj.After,
+			Cursor:    startAfter,
+			Prefix:    prefix,
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, object := range list.Items {
+			if !object.IsPrefix {
+				size += object.Size
+				objects++
+				continue
+			}
+
+			child := prefix + object.Path
+			if !strings.HasSuffix(child, "/") {
+				child += "/"
+			}
+			childAncestors := append(append([]string(nil), ancestors...), child)
+			if len(childAncestors) <= walker.depth {
+				walker.register(child, len(childAncestors))
+			}
+			group.Go(func() error {
+				return walker.list(ctx, group, child, childAncestors)
+			})
+		}
+
+		if !list.More {
+			break
+		}
+		startAfter = list.Items[len(list.Items)-1].Path
+	}
+
+	walker.add(append([]string{walker.prefix}, ancestors...), size, objects)
+	return nil
+}
+
+// register adds an empty entry for a prefix, so that prefixes without
+// objects are reported as well.
+func (walker *usageWalker) register(prefix string, depth int) {
+	walker.mu.Lock()
+	defer walker.mu.Unlock()
+
+	if _, ok := walker.usages[prefix]; !ok {
+		walker.usages[prefix] = &usageOutput{
+			Bucket: walker.bucketName,
+			Prefix: prefix,
+			Depth:  depth,
+		}
+	}
+}
+
+// add adds size and objects to all reported prefixes in prefixes.
+func (walker *usageWalker) add(prefixes []string, size, objects int64) {
+	walker.mu.Lock()
+	defer walker.mu.Unlock()
+
+	for _, prefix := range prefixes {
+		if usage, ok := walker.usages[prefix]; ok {
+			usage.Size += size
+			usage.Objects += objects
+		}
+	}
+}
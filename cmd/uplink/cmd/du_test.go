@@ -0,0 +1,81 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package cmd
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/testplanet"
+	"storj.io/storj/uplink"
+)
+
+func TestDiskUsage(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 1, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		// the command opens the bucket through libuplink, which requires an encryption key
+		config := planet.Uplinks[0].GetConfig(planet.Satellites[0])
+		config.Enc.Key = "du-test-key"
+
+		objects := map[string]int{
+			"1":       50,
+			"a/1":     10,
+			"a/b/2":   20,
+			"a/b/c/3": 30,
+			"d/4":     40,
+		}
+		for path, size := range objects {
+			uploadWithConfig(ctx, t, config, planet.Uplinks[0], "testbucket", path, make([]byte, size))
+		}
+
+		defer func(config uplink.Config, format string, depth int) {
+			cfg.Config, outputFormat, *duDepthFlag = config, format, depth
+		}(cfg.Config, outputFormat, *duDepthFlag)
+		cfg.Config, outputFormat = config, outputJSON
+
+		du := func(path string, depth int) []usageOutput {
+			*duDepthFlag = depth
+			output := captureStdout(t, func() error {
+				return diskUsage(&cobra.Command{}, []string{path})
+			})
+
+			var usages []usageOutput
+			require.NoError(t, json.Unmarshal(output, &usages))
+			return usages
+		}
+
+		// the whole bucket
+		assert.Equal(t, []usageOutput{
+			{Bucket: "testbucket", Prefix: "", Depth: 0, Size: 150, Objects: 5},
+		}, du("sj://testbucket", 0))
+
+		// nested prefixes are summarized up to the depth, deeper objects count towards their ancestors
+		assert.Equal(t, []usageOutput{
+			{Bucket: "testbucket", Prefix: "", Depth: 0, Size: 150, Objects: 5},
+			{Bucket: "testbucket", Prefix: "a/", Depth: 1, Size: 60, Objects: 3},
+			{Bucket: "testbucket", Prefix: "a/b/", Depth: 2, Size: 50, Objects: 2},
+			{Bucket: "testbucket", Prefix: "d/", Depth: 1, Size: 40, Objects: 1},
+		}, du("sj://testbucket", 2))
+
+		// a prefix, with or without the trailing slash
+		for _, path := range []string{"sj://testbucket/a", "sj://testbucket/a/"} {
+			assert.Equal(t, []usageOutput{
+				{Bucket: "testbucket", Prefix: "a/", Depth: 0, Size: 60, Objects: 3},
+				{Bucket: "testbucket", Prefix: "a/b/", Depth: 1, Size: 50, Objects: 2},
+				{Bucket: "testbucket", Prefix: "a/b/c/", Depth: 2, Size: 30, Objects: 1},
+			}, du(path, 5))
+		}
+
+		// an empty prefix
+		assert.Equal(t, []usageOutput{
+			{Bucket: "testbucket", Prefix: "missing/", Depth: 0, Size: 0, Objects: 0},
+		}, du("sj://testbucket/missing", 1))
+	})
+}
@@ -6,6 +6,7 @@ package cmd
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/spf13/cobra"
@@ -52,7 +53,7 @@ func list(cmd *cobra.Command, args []string) error {
 		}
 
 		if src.IsLocal() {
-			return fmt.Errorf("No bucket specified, use format sj://bucket/")
+			return usageError("No bucket specified, use format sj://bucket/")
 		}
 
 		bucket, err := project.OpenBucket(ctx, src.Bucket(), &access)
@@ -66,13 +67,21 @@ func list(cmd *cobra.Command, args []string) error {
 			}
 		}()
 
-		err = listFiles(ctx, bucket, src, false)
+		var listing listOutput
+		err = listFiles(ctx, bucket, src, false, &listing)
+		if err != nil {
+			return convertError(err, src)
+		}
 
-		return convertError(err, src)
+		if jsonOutput() {
+			return printJSON(listing)
+		}
+		return nil
 	}
 
 	startAfter := ""
 	noBuckets := true
+	var listing listOutput
 
 	for {
 		list, err := project.ListBuckets(ctx, &storj.BucketListOptions{Direction: storj.After, Cursor: startAfter})
@@ -82,9 +91,13 @@ func list(cmd *cobra.Command, args []string) error {
 		if len(list.Items) > 0 {
 			noBuckets = false
 			for _, bucket := range list.Items {
-				fmt.Println("BKT", formatTime(bucket.Created), bucket.Name)
+				if jsonOutput() {
+					listing.Buckets = append(listing.Buckets, newBucketOutput(bucket))
+				} else {
+					fmt.Println("BKT", formatTime(bucket.Created), bucket.Name)
+				}
 				if *recursiveFlag {
-					if err := listFilesFromBucket(ctx, project, bucket.Name, access); err != nil {
+					if err := listFilesFromBucket(ctx, project, bucket.Name, access, &listing); err != nil {
 						return err
 					}
 				}
@@ -96,6 +109,10 @@ func list(cmd *cobra.Command, args []string) error {
 		startAfter = list.Items[len(list.Items)-1].Name
 	}
 
+	if jsonOutput() {
+		return printJSON(listing)
+	}
+
 	if noBuckets {
 		fmt.Println("No buckets")
 	}
@@ -103,7 +120,13 @@ func list(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
-func listFilesFromBucket(ctx context.Context, project *libuplink.Project, bucketName string, access libuplink.EncryptionAccess) error {
+// listOutput is the JSON representation of a listing.
+type listOutput struct {
+	Buckets []bucketOutput `json:"buckets,omitempty"`
+	Objects []objectOutput `json:"objects,omitempty"`
+}
+
+func listFilesFromBucket(ctx context.Context, project *libuplink.Project, bucketName string, access libuplink.EncryptionAccess, listing *listOutput) error {
 	prefix, err := fpath.New(fmt.Sprintf("sj://%s/", bucketName))
 	if err != nil {
 		return err
@@ -120,7 +143,7 @@ func listFilesFromBucket(ctx context.Context, project *libuplink.Project, bucket
 		}
 	}()
 
-	err = listFiles(ctx, bucket, prefix, true)
+	err = listFiles(ctx, bucket, prefix, true, listing)
 	if err != nil {
 		return err
 	}
@@ -128,7 +151,7 @@ func listFilesFromBucket(ctx context.Context, project *libuplink.Project, bucket
 	return nil
 }
 
-func listFiles(ctx context.Context, bucket *libuplink.Bucket, prefix fpath.FPath, prependBucket bool) error {
+func listFiles(ctx context.Context, bucket *libuplink.Bucket, prefix fpath.FPath, prependBucket bool, listing *listOutput) error {
 	startAfter := ""
 
 	for {
@@ -143,6 +166,15 @@ func listFiles(ctx context.Context, bucket *libuplink.Bucket, prefix fpath.FPath
 		}
 
 		for _, object := range list.Items {
+			if jsonOutput() {
+				path := object.Path
+				if prefix.Path() != "" {
+					path = storj.JoinPaths(strings.TrimSuffix(prefix.Path(), "/"), path)
+				}
+				listing.Objects = append(listing.Objects, newObjectOutput(prefix.Bucket(), path, object))
+				continue
+			}
+
 			path := object.Path
 			if prependBucket {
 				path = fmt.Sprintf("%s/%s", prefix.Bucket(), path)
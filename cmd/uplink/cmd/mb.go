@@ -27,7 +27,7 @@ func makeBucket(cmd *cobra.Command, args []string) error {
 	ctx := process.Ctx(cmd)
 
 	if len(args) == 0 {
-		return fmt.Errorf("No bucket specified for creation")
+		return usageError("No bucket specified for creation")
 	}
 
 	dst, err := fpath.New(args[0])
@@ -36,11 +36,11 @@ func makeBucket(cmd *cobra.Command, args []string) error {
 	}
 
 	if dst.IsLocal() {
-		return fmt.Errorf("No bucket specified, use format sj://bucket/")
+		return usageError("No bucket specified, use format sj://bucket/")
 	}
 
 	if dst.Path() != "" {
-		return fmt.Errorf("Nested buckets not supported, use format sj://bucket/")
+		return usageError("Nested buckets not supported, use format sj://bucket/")
 	}
 
 	project, err := cfg.GetProject(ctx)
@@ -62,11 +62,15 @@ func makeBucket(cmd *cobra.Command, args []string) error {
 		RedundancyScheme: cfg.GetRedundancyScheme(),
 	}
 
-	_, err = project.CreateBucket(ctx, dst.Bucket(), bucketCfg)
+	bucket, err := project.CreateBucket(ctx, dst.Bucket(), bucketCfg)
 	if err != nil {
 		return err
 	}
 
+	if jsonOutput() {
+		return printJSON(newBucketOutput(bucket))
+	}
+
 	fmt.Printf("Bucket %s created\n", dst.Bucket())
 
 	return nil
@@ -0,0 +1,290 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/zeebo/errs"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"storj.io/storj/pkg/macaroon"
+	"storj.io/storj/pkg/storj"
+)
+
+// output formats supported by the --output flag
+const (
+	outputText = "text"
+	outputJSON = "json"
+)
+
+// exit codes of failed commands
+const (
+	exitGeneral      = 1
+	exitInvalidUsage = 2
+	exitNotFound     = 3
+	exitPermission   = 4
+	exitUnavailable  = 5
+)
+
+var outputFormat string
+
+func init() {
+	RootCmd.PersistentFlags().StringVar(&outputFormat, "output", outputText, "output format of the results, either text or json")
+}
+
+// jsonOutput returns whether results should be printed as JSON.
+func jsonOutput() bool {
+	return outputFormat == outputJSON
+}
+
+// printJSON writes v to standard out as a single line of JSON.
+func printJSON(v interface{}) error {
+	return json.NewEncoder(os.Stdout).Encode(v)
+}
+
+// cliError is an error which knows its class and exit code.
+type cliError struct {
+	class string
+	code  int
+	err   error
+}
+
+func (err *cliError) Error() string { return err.err.Error() }
+
+// ExitCode returns the code the process exits with when the command fails.
+func (err *cliError) ExitCode() int { return err.code }
+
+// usageError returns an error caused by invalid arguments.
+func usageError(format string, args ...interface{}) error {
+	return &cliError{class: "invalid usage", code: exitInvalidUsage, err: fmt.Errorf(format, args...)}
+}
+
+// notFoundError returns an error for a missing bucket or object.
+func notFoundError(format string, args ...interface{}) error {
+	return &cliError{class: "not found", code: exitNotFound, err: fmt.Errorf(format, args...)}
+}
+
+// errorOutput is the JSON representation of a failed command.
+type errorOutput struct {
+	Error struct {
+		Class    string `json:"class"`
+		Message  string `json:"message"`
+		ExitCode int    `json:"exit_code"`
+	} `json:"error"`
+}
+
+// classifyError finds the class and exit code of err.
+func classifyError(err error) (class string, code int) {
+	if err, ok := err.(*cliError); ok {
+		return err.class, err.code
+	}
+
+	if storj.ErrBucketNotFound.Has(err) || storj.ErrObjectNotFound.Has(err) {
+		return "not found", exitNotFound
+	}
+
+	if s, ok := status.FromError(errs.Unwrap(err)); ok {
+		switch s.Code() {
+		case codes.NotFound:
+			return "not found", exitNotFound
+		case codes.PermissionDenied, codes.Unauthenticated:
+			return "permission denied", exitPermission
+		case codes.Unavailable, codes.DeadlineExceeded:
+			return "unavailable", exitUnavailable
+		case codes.InvalidArgument:
+			return "invalid usage", exitInvalidUsage
+		}
+	}
+
+	if classes := errs.Classes(err); len(classes) > 0 {
+		return string(*classes[0]), exitGeneral
+	}
+
+	return "error", exitGeneral
+}
+
+// withStructuredErrors wraps the command to print errors as JSON when JSON
+// output is selected. The returned error carries the exit code of its class.
+func withStructuredErrors(run func(cmd *cobra.Command, args []string) error) func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		if outputFormat != outputText && outputFormat != outputJSON {
+			return usageError("unknown output format %q, expected %s or %s", outputFormat, outputText, outputJSON)
+		}
+
+		err := run(cmd, args)
+		if err == nil {
+			return nil
+		}
+
+		class, code := classifyError(err)
+		if jsonOutput() {
+			var out errorOutput
+			out.Error.Class, out.Error.ExitCode = class, code
+			out.Error.Message = err.Error()
+			_ = printJSON(out)
+		}
+		return &cliError{class: class, code: code, err: err}
+	}
+}
+
+// bucketOutput is the JSON representation of a bucket.
+type bucketOutput struct {
+	Name        string            `json:"name"`
+	Created     time.Time         `json:"created"`
+	PathCipher  string            `json:"path_cipher,omitempty"`
+	SegmentSize int64             `json:"segment_size,omitempty"`
+	Redundancy  *redundancyOutput `json:"redundancy,omitempty"`
+	Encryption  *encryptionOutput `json:"encryption,omitempty"`
+}
+
+// redundancyOutput is the JSON representation of a redundancy scheme.
+type redundancyOutput struct {
+	Algorithm      string `json:"algorithm"`
+	ShareSize      int32  `json:"share_size"`
+	RequiredShares int16  `json:"required_shares"`
+	RepairShares   int16  `json:"repair_shares"`
+	OptimalShares  int16  `json:"optimal_shares"`
+	TotalShares    int16  `json:"total_shares"`
+}
+
+// encryptionOutput is the JSON representation of encryption parameters.
+type encryptionOutput struct {
+	CipherSuite string `json:"cipher_suite"`
+	BlockSize   int32  `json:"block_size"`
+}
+
+// objectOutput is the JSON representation of a listed object or prefix.
+type objectOutput struct {
+	Bucket      string            `json:"bucket"`
+	Path        string            `json:"path"`
+	IsPrefix    bool              `json:"is_prefix"`
+	Size        int64             `json:"size"`
+	Created     *time.Time        `json:"created,omitempty"`
+	Modified    *time.Time        `json:"modified,omitempty"`
+	Expires     *time.Time        `json:"expires,omitempty"`
+	ContentType string            `json:"content_type,omitempty"`
+	Metadata    map[string]string `json:"metadata,omitempty"`
+}
+
+func newBucketOutput(bucket storj.Bucket) bucketOutput {
+	return bucketOutput{
+		Name:        bucket.Name,
+		Created:     bucket.Created,
+		PathCipher:  cipherName(bucket.PathCipher.ToCipherSuite()),
+		SegmentSize: bucket.SegmentsSize,
+		Redundancy:  newRedundancyOutput(bucket.RedundancyScheme),
+		Encryption:  newEncryptionOutput(bucket.EncryptionParameters),
+	}
+}
+
+func newRedundancyOutput(scheme storj.RedundancyScheme) *redundancyOutput {
+	algorithm := "invalid"
+	if scheme.Algorithm == storj.ReedSolomon {
+		algorithm = "reed-solomon"
+	}
+	return &redundancyOutput{
+		Algorithm:      algorithm,
+		ShareSize:      scheme.ShareSize,
+		RequiredShares: scheme.RequiredShares,
+		RepairShares:   scheme.RepairShares,
+		OptimalShares:  scheme.OptimalShares,
+		TotalShares:    scheme.TotalShares,
+	}
+}
+
+func newEncryptionOutput(params storj.EncryptionParameters) *encryptionOutput {
+	return &encryptionOutput{
+		CipherSuite: cipherName(params.CipherSuite),
+		BlockSize:   params.BlockSize,
+	}
+}
+
+func newObjectOutput(bucket string, path string, object storj.Object) objectOutput {
+	out := objectOutput{
+		Bucket:   bucket,
+		Path:     path,
+		IsPrefix: object.IsPrefix,
+	}
+	if object.IsPrefix {
+		return out
+	}
+
+	out.Size = object.Size
+	out.ContentType = object.ContentType
+	out.Metadata = object.Metadata
+	out.Created = optionalTime(object.Created)
+	out.Modified = optionalTime(object.Modified)
+	out.Expires = optionalTime(object.Expires)
+	return out
+}
+
+// optionalTime returns nil for the zero time, so that it is omitted from the output.
+func optionalTime(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &t
+}
+
+func cipherName(suite storj.CipherSuite) string {
+	switch suite {
+	case storj.EncNull:
+		return "null"
+	case storj.EncAESGCM:
+		return "aes-gcm"
+	case storj.EncSecretBox:
+		return "secretbox"
+	default:
+		return "unspecified"
+	}
+}
+
+// caveatOutput is the JSON representation of the restrictions of an API key.
+type caveatOutput struct {
+	DisallowReads   bool                `json:"disallow_reads"`
+	DisallowWrites  bool                `json:"disallow_writes"`
+	DisallowLists   bool                `json:"disallow_lists"`
+	DisallowDeletes bool                `json:"disallow_deletes"`
+	NotBefore       *time.Time          `json:"not_before,omitempty"`
+	NotAfter        *time.Time          `json:"not_after,omitempty"`
+	AllowedPaths    []allowedPathOutput `json:"allowed_paths,omitempty"`
+}
+
+// allowedPathOutput is the JSON representation of a path restriction.
+type allowedPathOutput struct {
+	Bucket              string `json:"bucket"`
+	EncryptedPathPrefix []byte `json:"encrypted_path_prefix"`
+}
+
+func newCaveatOutput(caveat *macaroon.Caveat) caveatOutput {
+	out := caveatOutput{
+		DisallowReads:   caveat.DisallowReads,
+		DisallowWrites:  caveat.DisallowWrites,
+		DisallowLists:   caveat.DisallowLists,
+		DisallowDeletes: caveat.DisallowDeletes,
+		NotBefore:       caveat.NotBefore,
+		NotAfter:        caveat.NotAfter,
+	}
+	for _, path := range caveat.AllowedPaths {
+		out.AllowedPaths = append(out.AllowedPaths, allowedPathOutput{
+			Bucket:              string(path.Bucket),
+			EncryptedPathPrefix: path.EncryptedPathPrefix,
+		})
+	}
+	return out
+}
+
+// transferOutput is the JSON representation of a finished cp, put or rm.
+type transferOutput struct {
+	Action      string `json:"action"`
+	Source      string `json:"source,omitempty"`
+	Destination string `json:"destination,omitempty"`
+	Size        int64  `json:"size,omitempty"`
+}
@@ -0,0 +1,132 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package cmd
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zeebo/errs"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"storj.io/storj/pkg/macaroon"
+	"storj.io/storj/pkg/storj"
+)
+
+func TestClassifyError(t *testing.T) {
+	wrapped := errs.Class("wrapped")
+	metainfoError := errs.Class("metainfo error")
+
+	for _, tt := range []struct {
+		err   error
+		class string
+		code  int
+	}{
+		{usageError("bad"), "invalid usage", exitInvalidUsage},
+		{notFoundError("gone"), "not found", exitNotFound},
+		{storj.ErrBucketNotFound.New("bucket"), "not found", exitNotFound},
+		{storj.ErrObjectNotFound.New("object"), "not found", exitNotFound},
+		{status.Error(codes.NotFound, "gone"), "not found", exitNotFound},
+		{wrapped.Wrap(status.Error(codes.PermissionDenied, "denied")), "permission denied", exitPermission},
+		{status.Error(codes.Unauthenticated, "who"), "permission denied", exitPermission},
+		{status.Error(codes.Unavailable, "down"), "unavailable", exitUnavailable},
+		{status.Error(codes.DeadlineExceeded, "slow"), "unavailable", exitUnavailable},
+		{status.Error(codes.InvalidArgument, "bad"), "invalid usage", exitInvalidUsage},
+		{metainfoError.New("failed"), "metainfo error", exitGeneral},
+		{errors.New("plain"), "error", exitGeneral},
+	} {
+		class, code := classifyError(tt.err)
+		assert.Equal(t, tt.class, class, tt.err.Error())
+		assert.Equal(t, tt.code, code, tt.err.Error())
+	}
+}
+
+func TestWithStructuredErrors(t *testing.T) {
+	defer func(format string) { outputFormat = format }(outputFormat)
+
+	run := withStructuredErrors(func(cmd *cobra.Command, args []string) error {
+		return storj.ErrObjectNotFound.New("object")
+	})
+
+	for _, format := range []string{outputText, outputJSON} {
+		outputFormat = format
+		err := run(nil, nil)
+		require.Error(t, err)
+		assert.Equal(t, exitNotFound, err.(*cliError).ExitCode())
+	}
+
+	// unknown formats are rejected before the command runs
+	outputFormat = "jsno"
+	err := withStructuredErrors(func(cmd *cobra.Command, args []string) error {
+		t.Fatal("command should not run")
+		return nil
+	})(nil, nil)
+	require.Error(t, err)
+	assert.Equal(t, exitInvalidUsage, err.(*cliError).ExitCode())
+}
+
+func TestOutputJSON(t *testing.T) {
+	created := time.Date(2019, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	t.Run("object", func(t *testing.T) {
+		data, err := json.Marshal(newObjectOutput("bucket", "a/b", storj.Object{
+			Path:        "a/b",
+			Created:     created,
+			Modified:    created,
+			ContentType: "text/plain",
+			Stream:      storj.Stream{Size: 10},
+		}))
+		require.NoError(t, err)
+		assert.JSONEq(t, `{
+			"bucket": "bucket",
+			"path": "a/b",
+			"is_prefix": false,
+			"size": 10,
+			"created": "2019-05-01T12:00:00Z",
+			"modified": "2019-05-01T12:00:00Z",
+			"content_type": "text/plain"
+		}`, string(data))
+	})
+
+	t.Run("prefix", func(t *testing.T) {
+		data, err := json.Marshal(newObjectOutput("bucket", "a/", storj.Object{Path: "a/", IsPrefix: true}))
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"bucket": "bucket", "path": "a/", "is_prefix": true, "size": 0}`, string(data))
+	})
+
+	t.Run("error", func(t *testing.T) {
+		var out errorOutput
+		out.Error.Class, out.Error.ExitCode = classifyError(notFoundError("Object not found: sj://bucket/a"))
+		out.Error.Message = "Object not found: sj://bucket/a"
+		data, err := json.Marshal(out)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"error": {"class": "not found", "message": "Object not found: sj://bucket/a", "exit_code": 3}}`, string(data))
+	})
+
+	t.Run("caveat", func(t *testing.T) {
+		prefix := []byte{0xff, 0x00, 0xfe, 'a'}
+		data, err := json.Marshal(newCaveatOutput(&macaroon.Caveat{
+			DisallowWrites: true,
+			AllowedPaths: []*macaroon.Caveat_Path{
+				{Bucket: []byte("bucket"), EncryptedPathPrefix: prefix},
+			},
+		}))
+		require.NoError(t, err)
+		require.True(t, utf8.Valid(data))
+
+		var out caveatOutput
+		require.NoError(t, json.Unmarshal(data, &out))
+		require.Len(t, out.AllowedPaths, 1)
+		assert.Equal(t, "bucket", out.AllowedPaths[0].Bucket)
+		assert.Equal(t, prefix, out.AllowedPaths[0].EncryptedPathPrefix)
+		assert.True(t, out.DisallowWrites)
+	})
+}
@@ -4,8 +4,6 @@
 package cmd
 
 import (
-	"fmt"
-
 	"github.com/spf13/cobra"
 
 	"storj.io/storj/internal/fpath"
@@ -23,7 +21,7 @@ func init() {
 // putMain is the function executed when putCmd is called
 func putMain(cmd *cobra.Command, args []string) (err error) {
 	if len(args) == 0 {
-		return fmt.Errorf("No object specified for copy")
+		return usageError("No object specified for copy")
 	}
 
 	ctx := process.Ctx(cmd)
@@ -34,7 +32,7 @@ func putMain(cmd *cobra.Command, args []string) (err error) {
 	}
 
 	if dst.IsLocal() {
-		return fmt.Errorf("No bucket specified, use format sj://bucket/")
+		return usageError("No bucket specified, use format sj://bucket/")
 	}
 
 	src, err := fpath.New("-")
@@ -26,7 +26,7 @@ func deleteBucket(cmd *cobra.Command, args []string) error {
 	ctx := process.Ctx(cmd)
 
 	if len(args) == 0 {
-		return fmt.Errorf("No bucket specified for deletion")
+		return usageError("No bucket specified for deletion")
 	}
 
 	dst, err := fpath.New(args[0])
@@ -35,11 +35,11 @@ func deleteBucket(cmd *cobra.Command, args []string) error {
 	}
 
 	if dst.IsLocal() {
-		return fmt.Errorf("No bucket specified, use format sj://bucket/")
+		return usageError("No bucket specified, use format sj://bucket/")
 	}
 
 	if dst.Path() != "" {
-		return fmt.Errorf("Nested buckets not supported, use format sj://bucket/")
+		return usageError("Nested buckets not supported, use format sj://bucket/")
 	}
 
 	var access libuplink.EncryptionAccess
@@ -66,6 +66,10 @@ func deleteBucket(cmd *cobra.Command, args []string) error {
 		return convertError(err, dst)
 	}
 
+	if jsonOutput() {
+		return printJSON(transferOutput{Action: "delete bucket", Destination: dst.Bucket()})
+	}
+
 	fmt.Printf("Bucket %s deleted\n", dst.Bucket())
 
 	return nil
@@ -25,7 +25,7 @@ func deleteObject(cmd *cobra.Command, args []string) error {
 	ctx := process.Ctx(cmd)
 
 	if len(args) == 0 {
-		return fmt.Errorf("No object specified for deletion")
+		return usageError("No object specified for deletion")
 	}
 
 	dst, err := fpath.New(args[0])
@@ -34,7 +34,7 @@ func deleteObject(cmd *cobra.Command, args []string) error {
 	}
 
 	if dst.IsLocal() {
-		return fmt.Errorf("No bucket specified, use format sj://bucket/")
+		return usageError("No bucket specified, use format sj://bucket/")
 	}
 
 	var access libuplink.EncryptionAccess
@@ -52,6 +52,10 @@ func deleteObject(cmd *cobra.Command, args []string) error {
 		return convertError(err, dst)
 	}
 
+	if jsonOutput() {
+		return printJSON(transferOutput{Action: "delete", Destination: dst.String()})
+	}
+
 	fmt.Printf("Deleted %s\n", dst)
 
 	return nil
@@ -41,6 +41,9 @@ var RootCmd = &cobra.Command{
 }
 
 func addCmd(cmd *cobra.Command, root *cobra.Command) *cobra.Command {
+	if cmd.RunE != nil {
+		cmd.RunE = withStructuredErrors(cmd.RunE)
+	}
 	root.AddCommand(cmd)
 
 	defaultConfDir := fpath.ApplicationDir("storj", "uplink")
@@ -139,11 +142,11 @@ func closeProjectAndBucket(project *libuplink.Project, bucket *libuplink.Bucket)
 
 func convertError(err error, path fpath.FPath) error {
 	if storj.ErrBucketNotFound.Has(err) {
-		return fmt.Errorf("Bucket not found: %s", path.Bucket())
+		return notFoundError("Bucket not found: %s", path.Bucket())
 	}
 
 	if storj.ErrObjectNotFound.Has(err) {
-		return fmt.Errorf("Object not found: %s", path.String())
+		return notFoundError("Object not found: %s", path.String())
 	}
 
 	return err
@@ -65,6 +65,12 @@ func parseHumanDate(date string, now time.Time) (*time.Time, error) {
 	}
 }
 
+// shareOutput is the JSON representation of a created api key.
+type shareOutput struct {
+	APIKey string       `json:"api_key"`
+	Caveat caveatOutput `json:"caveat"`
+}
+
 // shareMain is the function executed when shareCmd is called
 func shareMain(cmd *cobra.Command, args []string) (err error) {
 	ctx := process.Ctx(cmd)
@@ -143,6 +149,15 @@ func shareMain(cmd *cobra.Command, args []string) (err error) {
 		})
 	}
 
+	key, err = key.Restrict(caveat)
+	if err != nil {
+		return err
+	}
+
+	if jsonOutput() {
+		return printJSON(shareOutput{APIKey: key.Serialize(), Caveat: newCaveatOutput(&caveat)})
+	}
+
 	{
 		// Times don't marshal very well with MarshalTextString, and the nonce doesn't
 		// matter to humans, so handle those explicitly and then dispatch to the generic
@@ -160,11 +175,6 @@ func shareMain(cmd *cobra.Command, args []string) (err error) {
 		fmt.Print(proto.MarshalTextString(caveatCopy))
 	}
 
-	key, err = key.Restrict(caveat)
-	if err != nil {
-		return err
-	}
-
 	fmt.Println("new key:", key.Serialize())
 	return nil
 }
@@ -0,0 +1,248 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"golang.org/x/sync/errgroup"
+
+	"storj.io/storj/internal/fpath"
+	"storj.io/storj/pkg/identity"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/process"
+	"storj.io/storj/pkg/storage/streams"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/uplink/metainfo"
+)
+
+// statConcurrency is the number of segments requested at the same time.
+const statConcurrency = 10
+
+var (
+	statNodesFlag *bool
+)
+
+func init() {
+	statCmd := addCmd(&cobra.Command{
+		Use:   "stat",
+		Short: "Shows the metadata and the segment layout of an object",
+		RunE:  statMain,
+	}, RootCmd)
+	statNodesFlag = statCmd.Flags().Bool("nodes", false, "if true, show the storage nodes holding the pieces of each segment")
+}
+
+// statOutput is the JSON representation of the layout of an object.
+type statOutput struct {
+	Object       objectOutput      `json:"object"`
+	Bucket       bucketOutput      `json:"bucket"`
+	Redundancy   *redundancyOutput `json:"redundancy"`
+	Encryption   *encryptionOutput `json:"encryption"`
+	SegmentCount int64             `json:"segment_count"`
+	SegmentSize  int64             `json:"segment_size"`
+	Segments     []segmentOutput   `json:"segments"`
+}
+
+// segmentOutput is the JSON representation of a single segment.
+type segmentOutput struct {
+	Index       int64         `json:"index"`
+	Type        string        `json:"type"`
+	Size        int64         `json:"size"`
+	RootPieceID string        `json:"root_piece_id,omitempty"`
+	PieceCount  int           `json:"piece_count,omitempty"`
+	Pieces      []pieceOutput `json:"pieces,omitempty"`
+}
+
+// pieceOutput is the JSON representation of a piece of a remote segment.
+type pieceOutput struct {
+	Number int32  `json:"number"`
+	NodeID string `json:"node_id"`
+}
+
+// statMain is the function executed when statCmd is called
+func statMain(cmd *cobra.Command, args []string) (err error) {
+	if len(args) == 0 {
+		return usageError("No object specified")
+	}
+
+	ctx := process.Ctx(cmd)
+
+	src, err := fpath.New(args[0])
+	if err != nil {
+		return err
+	}
+
+	if src.IsLocal() {
+		return usageError("No bucket specified, use format sj://bucket/path")
+	}
+	if src.Path() == "" {
+		return usageError("No object specified, use format sj://bucket/path")
+	}
+
+	ident, err := identity.NewFullIdentity(ctx, identity.NewCAOptions{
+		Difficulty:  0,
+		Concurrency: 1,
+	})
+	if err != nil {
+		return err
+	}
+
+	db, _, err := cfg.GetMetainfo(ctx, ident)
+	if err != nil {
+		return err
+	}
+
+	bucket, err := db.GetBucket(ctx, src.Bucket())
+	if err != nil {
+		return convertError(err, src)
+	}
+
+	object, err := db.GetObject(ctx, src.Bucket(), src.Path())
+	if err != nil {
+		return convertError(err, src)
+	}
+
+	client, err := cfg.GetMetainfoClient(ctx, ident)
+	if err != nil {
+		return err
+	}
+
+	segments, err := statSegments(ctx, client, bucket, object)
+	if err != nil {
+		return convertError(err, src)
+	}
+
+	out := statOutput{
+		Object:       newObjectOutput(src.Bucket(), src.Path(), object),
+		Bucket:       newBucketOutput(bucket),
+		Redundancy:   newRedundancyOutput(object.RedundancyScheme),
+		Encryption:   newEncryptionOutput(storj.EncryptionParameters{CipherSuite: object.Cipher.ToCipherSuite(), BlockSize: object.EncryptionScheme.BlockSize}),
+		SegmentCount: object.SegmentCount,
+		SegmentSize:  object.FixedSegmentSize,
+		Segments:     segments,
+	}
+
+	if jsonOutput() {
+		return printJSON(out)
+	}
+
+	printStat(src, out)
+	return nil
+}
+
+// statSegments looks up the pointers of all segments of object.
+func statSegments(ctx context.Context, client metainfo.Client, bucket storj.Bucket, object storj.Object) ([]segmentOutput, error) {
+	key := new(storj.Key)
+	copy(key[:], cfg.Enc.Key)
+
+	encryptedPath, err := streams.EncryptAfterBucket(storj.JoinPaths(bucket.Name, object.Path), bucket.PathCipher, key)
+	if err != nil {
+		return nil, err
+	}
+	segmentPath := storj.JoinPaths(storj.SplitPath(encryptedPath)[1:]...)
+
+	// the segments are requested concurrently, so that large objects don't
+	// take a round trip per segment
+	segments := make([]segmentOutput, object.SegmentCount)
+	limiter := make(chan struct{}, statConcurrency)
+	group, groupCtx := errgroup.WithContext(ctx)
+	for index := int64(0); index < object.SegmentCount; index++ {
+		index := index
+		limiter <- struct{}{}
+		if groupCtx.Err() != nil {
+			<-limiter
+			break
+		}
+		group.Go(func() error {
+			defer func() { <-limiter }()
+
+			// the last segment is always stored under the "l" key
+			segmentIndex := index
+			if index+1 == object.SegmentCount {
+				segmentIndex = -1
+			}
+
+			pointer, err := client.SegmentInfo(groupCtx, bucket.Name, segmentPath, segmentIndex)
+			if err != nil {
+				return err
+			}
+
+			segments[index] = newSegmentOutput(index, pointer)
+			return nil
+		})
+	}
+
+	if err := group.Wait(); err != nil {
+		return nil, err
+	}
+	return segments, nil
+}
+
+func newSegmentOutput(index int64, pointer *pb.Pointer) segmentOutput {
+	out := segmentOutput{
+		Index: index,
+		Type:  strings.ToLower(pointer.GetType().String()),
+		Size:  pointer.GetSegmentSize(),
+	}
+
+	remote := pointer.GetRemote()
+	if pointer.GetType() != pb.Pointer_REMOTE || remote == nil {
+		return out
+	}
+
+	out.RootPieceID = remote.RootPieceId.String()
+	out.PieceCount = len(remote.RemotePieces)
+	if *statNodesFlag {
+		for _, piece := range remote.RemotePieces {
+			out.Pieces = append(out.Pieces, pieceOutput{
+				Number: piece.PieceNum,
+				NodeID: piece.NodeId.String(),
+			})
+		}
+	}
+	return out
+}
+
+func printStat(src fpath.FPath, out statOutput) {
+	fmt.Printf("Object:        %s\n", src.String())
+	fmt.Printf("Size:          %d\n", out.Object.Size)
+	if out.Object.Created != nil {
+		fmt.Printf("Created:       %s\n", formatTime(*out.Object.Created))
+	}
+	if out.Object.Modified != nil {
+		fmt.Printf("Modified:      %s\n", formatTime(*out.Object.Modified))
+	}
+	if out.Object.Expires != nil {
+		fmt.Printf("Expires:       %s\n", formatTime(*out.Object.Expires))
+	}
+	if out.Object.ContentType != "" {
+		fmt.Printf("Content type:  %s\n", out.Object.ContentType)
+	}
+	for key, value := range out.Object.Metadata {
+		fmt.Printf("Metadata:      %s=%s\n", key, value)
+	}
+
+	fmt.Printf("Path cipher:   %s\n", out.Bucket.PathCipher)
+	fmt.Printf("Encryption:    %s, block size %d\n", out.Encryption.CipherSuite, out.Encryption.BlockSize)
+	fmt.Printf("Redundancy:    %s, k=%d m=%d o=%d n=%d, share size %d\n",
+		out.Redundancy.Algorithm,
+		out.Redundancy.RequiredShares, out.Redundancy.RepairShares,
+		out.Redundancy.OptimalShares, out.Redundancy.TotalShares,
+		out.Redundancy.ShareSize)
+	fmt.Printf("Segments:      %d of %d bytes\n", out.SegmentCount, out.SegmentSize)
+
+	for _, segment := range out.Segments {
+		if segment.Type != "remote" {
+			fmt.Printf("  %5d  %-6s  %12d\n", segment.Index, segment.Type, segment.Size)
+			continue
+		}
+		fmt.Printf("  %5d  %-6s  %12d  %d pieces  %s\n", segment.Index, segment.Type, segment.Size, segment.PieceCount, segment.RootPieceID)
+		for _, piece := range segment.Pieces {
+			fmt.Printf("         piece %3d  %s\n", piece.Number, piece.NodeID)
+		}
+	}
+}
@@ -0,0 +1,139 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"math/rand"
+	"os"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/internal/memory"
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/testplanet"
+	"storj.io/storj/pkg/encryption"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/pkg/stream"
+	"storj.io/storj/uplink"
+)
+
+func TestStat(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 5, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		config := planet.Uplinks[0].GetConfig(planet.Satellites[0])
+		config.Client.SegmentSize = 8 * memory.KiB
+		config.Client.MaxInlineSize = 1 * memory.KiB
+
+		// three remote segments and an inline last segment
+		data := make([]byte, 3*config.Client.SegmentSize.Int()+100)
+		_, err := rand.Read(data)
+		require.NoError(t, err)
+		uploadWithConfig(ctx, t, config, planet.Uplinks[0], "testbucket", "test/path", data)
+
+		defer func(config uplink.Config, format string, nodes bool) {
+			cfg.Config, outputFormat, *statNodesFlag = config, format, nodes
+		}(cfg.Config, outputFormat, *statNodesFlag)
+		cfg.Config, outputFormat, *statNodesFlag = config, outputJSON, true
+
+		output := captureStdout(t, func() error {
+			return statMain(&cobra.Command{}, []string{"sj://testbucket/test/path"})
+		})
+
+		var out statOutput
+		require.NoError(t, json.Unmarshal(output, &out))
+
+		assert.Equal(t, "testbucket", out.Object.Bucket)
+		assert.Equal(t, "test/path", out.Object.Path)
+		assert.Equal(t, int64(len(data)), out.Object.Size)
+		assert.Equal(t, "testbucket", out.Bucket.Name)
+		assert.EqualValues(t, 4, out.SegmentCount)
+		assert.Equal(t, config.Client.SegmentSize.Int64(), out.SegmentSize)
+		assert.Equal(t, "reed-solomon", out.Redundancy.Algorithm)
+
+		// segment sizes are the sizes of the encrypted segments
+		remoteSize, err := encryption.CalcEncryptedSize(config.Client.SegmentSize.Int64(), config.GetEncryptionScheme())
+		require.NoError(t, err)
+
+		require.Len(t, out.Segments, 4)
+		for i, segment := range out.Segments[:3] {
+			assert.EqualValues(t, i, segment.Index)
+			assert.Equal(t, "remote", segment.Type)
+			assert.Equal(t, remoteSize, segment.Size)
+			assert.NotEmpty(t, segment.RootPieceID)
+			assert.Len(t, segment.Pieces, segment.PieceCount)
+			for _, piece := range segment.Pieces {
+				assert.NotEmpty(t, piece.NodeID)
+			}
+		}
+
+		last := out.Segments[3]
+		assert.EqualValues(t, 3, last.Index)
+		assert.Equal(t, "inline", last.Type)
+		assert.True(t, last.Size >= 100 && last.Size < remoteSize)
+		assert.Empty(t, last.Pieces)
+
+		// missing objects are reported as not found
+		err = statMain(&cobra.Command{}, []string{"sj://testbucket/missing"})
+		class, code := classifyError(err)
+		assert.Equal(t, "not found", class)
+		assert.Equal(t, exitNotFound, code)
+	})
+}
+
+// uploadWithConfig uploads data with the segment size and inline size of config.
+func uploadWithConfig(ctx *testcontext.Context, t *testing.T, config uplink.Config, client *testplanet.Uplink, bucket string, path storj.Path, data []byte) {
+	metainfo, streams, err := config.GetMetainfo(ctx, client.Identity)
+	require.NoError(t, err)
+
+	encScheme := config.GetEncryptionScheme()
+	_, err = metainfo.GetBucket(ctx, bucket)
+	if storj.ErrBucketNotFound.Has(err) {
+		_, err = metainfo.CreateBucket(ctx, bucket, &storj.Bucket{PathCipher: encScheme.Cipher})
+	}
+	require.NoError(t, err)
+
+	object, err := metainfo.CreateObject(ctx, bucket, path, &storj.CreateObject{
+		RedundancyScheme: config.GetRedundancyScheme(),
+		EncryptionScheme: encScheme,
+	})
+	require.NoError(t, err)
+
+	mutableStream, err := object.CreateStream(ctx)
+	require.NoError(t, err)
+
+	upload := stream.NewUpload(ctx, mutableStream, streams)
+	_, err = io.Copy(upload, bytes.NewReader(data))
+	require.NoError(t, errs.Combine(err, upload.Close()))
+}
+
+// captureStdout returns what run writes to standard out.
+func captureStdout(t *testing.T, run func() error) []byte {
+	reader, writer, err := os.Pipe()
+	require.NoError(t, err)
+
+	stdout := os.Stdout
+	os.Stdout = writer
+	defer func() { os.Stdout = stdout }()
+
+	output := make(chan []byte, 1)
+	go func() {
+		data, _ := ioutil.ReadAll(reader)
+		output <- data
+	}()
+
+	runErr := run()
+	require.NoError(t, writer.Close())
+	data := <-output
+	require.NoError(t, runErr)
+	return data
+}
@@ -161,7 +161,7 @@ func (size *Size) Set(s string) error {
 	for isLetter(s[p-1]) {
 		p--
 
-		if p < 0 {
+		if p <= 0 {
 			return errors.New("p out of bounds")
 		}
 	}
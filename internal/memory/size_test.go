@@ -146,6 +146,7 @@ func TestInvalidParse(t *testing.T) {
 		"z1.0Q",
 		"1.0zQ",
 		"1.0zQB",
+		"many",
 	}
 
 	for i, test := range tests {
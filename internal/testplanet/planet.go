@@ -48,14 +48,24 @@ import (
 	"storj.io/storj/satellite"
 	"storj.io/storj/satellite/console"
 	"storj.io/storj/satellite/console/consoleweb"
+	"storj.io/storj/satellite/gc"
+	"storj.io/storj/satellite/gracefulexit"
 	"storj.io/storj/satellite/mailservice"
 	"storj.io/storj/satellite/metainfo"
+	"storj.io/storj/satellite/piecedeletion"
 	"storj.io/storj/satellite/satellitedb"
 	"storj.io/storj/storagenode"
+	"storj.io/storj/storagenode/backup"
 	"storj.io/storj/storagenode/collector"
+	"storj.io/storj/storagenode/console/consoleserver"
+	"storj.io/storj/storagenode/contact"
+	"storj.io/storj/storagenode/earnings"
+	sngracefulexit "storj.io/storj/storagenode/gracefulexit"
 	"storj.io/storj/storagenode/orders"
 	"storj.io/storj/storagenode/piecestore"
+	"storj.io/storj/storagenode/scrubber"
 	"storj.io/storj/storagenode/storagenodedb"
+	"storj.io/storj/storagenode/trust"
 	"storj.io/storj/versioncontrol"
 )
 
@@ -448,12 +458,24 @@ func (planet *Planet) newSatellites(count int) ([]*satellite.Peer, error) {
 			},
 			Overlay: overlay.Config{
 				Node: overlay.NodeSelectionConfig{
-					UptimeRatio:       0,
 					UptimeCount:       0,
-					AuditSuccessRatio: 0,
 					AuditCount:        0,
 					NewNodePercentage: 0,
 					OnlineWindow:      time.Hour,
+					DistinctNetworks:  false,
+					NetworkPrefixV4:   24,
+					NetworkPrefixV6:   64,
+
+					AuditReputationAlpha0:  20,
+					AuditReputationBeta0:   0,
+					AuditReputationLambda:  0.95,
+					AuditReputationWeight:  1,
+					AuditReputationDQ:      0,
+					UptimeReputationAlpha0: 100,
+					UptimeReputationBeta0:  0,
+					UptimeReputationLambda: 0.99,
+					UptimeReputationWeight: 1,
+					UptimeReputationDQ:     0,
 				},
 			},
 			Discovery: discovery.Config{
@@ -483,6 +505,27 @@ func (planet *Planet) newSatellites(count int) ([]*satellite.Peer, error) {
 				MaxRetriesStatDB:  0,
 				Interval:          30 * time.Second,
 				MinBytesPerSecond: 1 * memory.KB,
+				MaxReverifyCount:  3,
+			},
+			GarbageCollection: gc.Config{
+				Interval:          1 * time.Minute,
+				Enabled:           true,
+				InitialPieces:     10,
+				FalsePositiveRate: 0.1,
+				ConcurrentSends:   1,
+			},
+			PieceDeletion: piecedeletion.Config{
+				Enabled:         false,
+				Interval:        time.Minute,
+				BatchSize:       1000,
+				MaxQueued:       100000,
+				ConcurrentSends: 1,
+				Expiration:      time.Hour,
+			},
+			GracefulExit: gracefulexit.Config{
+				MaxInflightTransfers:         5,
+				MaxFailuresPerPiece:          3,
+				OverallMaxFailuresPercentage: 10,
 			},
 			Tally: tally.Config{
 				Interval: 30 * time.Second,
@@ -608,13 +651,45 @@ func (planet *Planet) newStorageNodes(count int, whitelistedSatelliteIDs []strin
 				WhitelistedSatelliteIDs: strings.Join(whitelistedSatelliteIDs, ","),
 			},
 			Collector: collector.Config{
-				Interval: time.Minute,
+				Interval:       time.Minute,
+				TrashRetention: 7 * 24 * time.Hour,
+			},
+			Scrubber: scrubber.Config{
+				Interval: time.Hour,
+			},
+			Backup: backup.Config{
+				Dir:      filepath.Join(storageDir, "backups"),
+				Interval: time.Hour,
+				Keep:     1,
+			},
+			Contact: contact.Config{
+				Interval: time.Hour,
+			},
+			Trust: trust.Config{
+				RefreshInterval:      time.Hour,
+				RejectRemovedUploads: true,
+			},
+			Console: consoleserver.Config{
+				Address: "127.0.0.1:0",
+			},
+			Earnings: earnings.Config{
+				Prices:   "egress=20,repair=10,audit=10,storage=1.5",
+				HeldBack: "75,75,75,50,50,50,25,25,25",
 			},
 			Storage2: piecestore.Config{
 				Sender: orders.SenderConfig{
-					Interval: time.Hour,
-					Timeout:  time.Hour,
+					Interval:         time.Hour,
+					Timeout:          time.Hour,
+					BatchSize:        1000,
+					MaxBackoff:       time.Hour,
+					ArchiveRetention: 90 * 24 * time.Hour,
 				},
+				RetainTimeBuffer: time.Hour,
+				DeleteQueueSize:  10000,
+				DeleteWorkers:    1,
+			},
+			GracefulExit: sngracefulexit.Config{
+				ChoreInterval: time.Hour,
 			},
 			Version: planet.NewVersionConfig(),
 		}
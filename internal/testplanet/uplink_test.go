@@ -223,6 +223,18 @@ func (mock *piecestoreMock) Delete(ctx context.Context, delete *pb.PieceDeleteRe
 	return nil, nil
 }
 
+func (mock *piecestoreMock) Retain(ctx context.Context, retain *pb.RetainRequest) (_ *pb.RetainResponse, err error) {
+	return nil, nil
+}
+
+func (mock *piecestoreMock) RestoreTrash(ctx context.Context, restore *pb.RestoreTrashRequest) (_ *pb.RestoreTrashResponse, err error) {
+	return nil, nil
+}
+
+func (mock *piecestoreMock) DeletePieces(ctx context.Context, delete *pb.DeletePiecesRequest) (_ *pb.DeletePiecesResponse, err error) {
+	return nil, nil
+}
+
 func TestDownloadFromUnresponsiveNode(t *testing.T) {
 	testplanet.Run(t, testplanet.Config{
 		SatelliteCount: 1, StorageNodeCount: 5, UplinkCount: 1,
@@ -0,0 +1,46 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package audit
+
+import (
+	"context"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/pkg/storj"
+)
+
+var (
+	// ContainError is the containment errs class
+	ContainError = errs.Class("containment error")
+
+	// ErrContainedNotFound is the errs class for when a pending audit isn't found
+	ErrContainedNotFound = errs.Class("pending audit not found")
+
+	// ErrAlreadyExists is the errs class for when a pending audit with different info already exists
+	ErrAlreadyExists = errs.Class("pending audit already exists for nodeID")
+)
+
+// PendingAudit contains the info needed to retry the audit of a share
+// that a contained node failed to return in time
+type PendingAudit struct {
+	NodeID            storj.NodeID
+	PieceID           storj.PieceID
+	StripeIndex       int64
+	ShareSize         int32
+	ExpectedShareHash []byte
+	ReverifyCount     int32
+	Path              storj.Path
+}
+
+// Containment holds the pending audits of contained nodes
+type Containment interface {
+	// Get returns the pending audit for the node
+	Get(ctx context.Context, nodeID storj.NodeID) (*PendingAudit, error)
+	// IncrementPending creates a pending audit for the node and marks it as contained,
+	// or increments the reverify count of the node's existing pending audit
+	IncrementPending(ctx context.Context, pendingAudit *PendingAudit) error
+	// Delete removes the pending audit of the node and releases it from containment
+	Delete(ctx context.Context, nodeID storj.NodeID) (bool, error)
+}
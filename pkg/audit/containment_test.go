@@ -0,0 +1,92 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package audit_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/teststorj"
+	"storj.io/storj/pkg/audit"
+	"storj.io/storj/pkg/overlay"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/pkcrypto"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/satellitedb/satellitedbtest"
+)
+
+func TestContainment(t *testing.T) {
+	satellitedbtest.Run(t, func(t *testing.T, db satellite.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		containment := db.Containment()
+		cache := db.OverlayCache()
+
+		nodeID := teststorj.NodeIDFromString("contained")
+		require.NoError(t, cache.UpdateAddress(ctx, &pb.Node{Id: nodeID}, "", overlay.NodeSelectionConfig{
+			AuditReputationAlpha0:  20,
+			UptimeReputationAlpha0: 100,
+		}))
+
+		_, err := containment.Get(ctx, nodeID)
+		require.True(t, audit.ErrContainedNotFound.Has(err))
+
+		pending := &audit.PendingAudit{
+			NodeID:            nodeID,
+			PieceID:           storj.NewPieceID(),
+			StripeIndex:       3,
+			ShareSize:         256,
+			ExpectedShareHash: pkcrypto.SHA256Hash([]byte("share")),
+			Path:              "project/l/bucket/path",
+		}
+
+		{ // creating a pending audit contains the node
+			require.NoError(t, containment.IncrementPending(ctx, pending))
+
+			got, err := containment.Get(ctx, nodeID)
+			require.NoError(t, err)
+			require.Equal(t, pending, got)
+
+			node, err := cache.Get(ctx, nodeID)
+			require.NoError(t, err)
+			require.True(t, node.Contained)
+		}
+
+		{ // the same pending audit increments the reverify count
+			require.NoError(t, containment.IncrementPending(ctx, pending))
+
+			got, err := containment.Get(ctx, nodeID)
+			require.NoError(t, err)
+			require.EqualValues(t, 1, got.ReverifyCount)
+		}
+
+		{ // a different pending audit for the same node is rejected
+			other := *pending
+			other.StripeIndex = 4
+			err := containment.IncrementPending(ctx, &other)
+			require.True(t, audit.ErrAlreadyExists.Has(err))
+		}
+
+		{ // deleting the pending audit releases the node
+			deleted, err := containment.Delete(ctx, nodeID)
+			require.NoError(t, err)
+			require.True(t, deleted)
+
+			_, err = containment.Get(ctx, nodeID)
+			require.True(t, audit.ErrContainedNotFound.Has(err))
+
+			node, err := cache.Get(ctx, nodeID)
+			require.NoError(t, err)
+			require.False(t, node.Contained)
+
+			deleted, err = containment.Delete(ctx, nodeID)
+			require.NoError(t, err)
+			require.False(t, deleted)
+		}
+	})
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/zeebo/errs"
 
 	"storj.io/storj/pkg/eestream"
+	"storj.io/storj/pkg/overlay"
 	"storj.io/storj/pkg/pb"
 	"storj.io/storj/pkg/storage/meta"
 	"storj.io/storj/pkg/storj"
@@ -28,17 +29,25 @@ type Stripe struct {
 	SegmentPath storj.Path
 }
 
+// maxUnvettedCandidates is the number of segments checked for pieces on
+// unvetted nodes before falling back to a random segment.
+const maxUnvettedCandidates = 10
+
 // Cursor keeps track of audit location in pointer db
 type Cursor struct {
 	metainfo *metainfo.Service
+	overlay  *overlay.Cache
 	lastPath storj.Path
 	mutex    sync.Mutex
 }
 
-// NewCursor creates a Cursor which iterates over pointer db
-func NewCursor(metainfo *metainfo.Service) *Cursor {
+// NewCursor creates a Cursor which iterates over pointer db. The segments
+// with pieces on unvetted nodes are preferred, so that the new nodes are
+// audited sooner; overlay may be nil to pick the segments at random.
+func NewCursor(metainfo *metainfo.Service, overlay *overlay.Cache) *Cursor {
 	return &Cursor{
 		metainfo: metainfo,
+		overlay:  overlay,
 	}
 }
 
@@ -62,7 +71,7 @@ func (cursor *Cursor) NextStripe(ctx context.Context) (stripe *Stripe, more bool
 		cursor.lastPath = pointerItems[len(pointerItems)-1].Path
 	}
 
-	pointer, path, err := cursor.getRandomValidPointer(pointerItems)
+	pointer, path, err := cursor.getRandomValidPointer(ctx, pointerItems)
 	if err != nil {
 		return nil, more, err
 	}
@@ -101,13 +110,18 @@ func getRandomStripe(pointer *pb.Pointer) (index int64, err error) {
 	return randomStripeIndex, nil
 }
 
-// getRandomValidPointer attempts to get a random remote pointer from a list. If it sees expired pointers in the process of looking, deletes them
-func (cursor *Cursor) getRandomValidPointer(pointerItems []*pb.ListResponse_Item) (pointer *pb.Pointer, path storj.Path, err error) {
+// getRandomValidPointer attempts to get a random remote pointer from a list,
+// preferring the pointers with pieces on unvetted nodes. If it sees expired
+// pointers in the process of looking, deletes them
+func (cursor *Cursor) getRandomValidPointer(ctx context.Context, pointerItems []*pb.ListResponse_Item) (pointer *pb.Pointer, path storj.Path, err error) {
 	var src cryptoSource
 	rnd := rand.New(src)
 	errGroup := new(errs.Group)
 	randomNums := rnd.Perm(len(pointerItems))
 
+	var candidates []*pb.Pointer
+	var candidatePaths []storj.Path
+
 	for _, randomIndex := range randomNums {
 		pointerItem := pointerItems[randomIndex]
 		path := pointerItem.Path
@@ -139,10 +153,60 @@ func (cursor *Cursor) getRandomValidPointer(pointerItems []*pb.ListResponse_Item
 			continue
 		}
 
-		return pointer, path, nil
+		if cursor.overlay == nil {
+			return pointer, path, nil
+		}
+
+		candidates = append(candidates, pointer)
+		candidatePaths = append(candidatePaths, path)
+		if len(candidates) >= maxUnvettedCandidates {
+			break
+		}
+	}
+
+	if len(candidates) == 0 {
+		return nil, "", errGroup.Err()
+	}
+
+	unvetted, err := cursor.unvettedNodes(ctx, candidates)
+	if err != nil {
+		// fall back to a random segment
+		return candidates[0], candidatePaths[0], nil
 	}
 
-	return nil, "", errGroup.Err()
+	for i, candidate := range candidates {
+		for _, piece := range candidate.GetRemote().GetRemotePieces() {
+			if unvetted[piece.NodeId] {
+				return candidate, candidatePaths[i], nil
+			}
+		}
+	}
+	return candidates[0], candidatePaths[0], nil
+}
+
+// unvettedNodes returns the unvetted nodes storing pieces of the pointers.
+func (cursor *Cursor) unvettedNodes(ctx context.Context, pointers []*pb.Pointer) (map[storj.NodeID]bool, error) {
+	seen := map[storj.NodeID]bool{}
+	var nodeIDs storj.NodeIDList
+	for _, pointer := range pointers {
+		for _, piece := range pointer.GetRemote().GetRemotePieces() {
+			if !seen[piece.NodeId] {
+				seen[piece.NodeId] = true
+				nodeIDs = append(nodeIDs, piece.NodeId)
+			}
+		}
+	}
+
+	unvetted, err := cursor.overlay.KnownUnvetted(ctx, nodeIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[storj.NodeID]bool, len(unvetted))
+	for _, id := range unvetted {
+		result[id] = true
+	}
+	return result, nil
 }
 
 // cryptoSource implements the math/rand Source interface using crypto/rand
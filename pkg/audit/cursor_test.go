@@ -5,6 +5,7 @@ package audit_test
 
 import (
 	"crypto/rand"
+	"fmt"
 	"math"
 	"math/big"
 	"reflect"
@@ -13,14 +14,17 @@ import (
 
 	"github.com/golang/protobuf/ptypes/timestamp"
 	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
 
 	"storj.io/storj/internal/testcontext"
 	"storj.io/storj/internal/testplanet"
 	"storj.io/storj/internal/teststorj"
 	"storj.io/storj/pkg/audit"
+	"storj.io/storj/pkg/overlay"
 	"storj.io/storj/pkg/pb"
 	"storj.io/storj/pkg/storage/meta"
 	"storj.io/storj/pkg/storj"
+	"storj.io/storj/satellite"
 	"storj.io/storj/satellite/metainfo"
 )
 
@@ -140,6 +144,46 @@ type testData struct {
 	path storj.Path
 }
 
+func TestCursorPrefersUnvettedNodes(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 2, UplinkCount: 0,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.Overlay.Node.AuditCount = 1
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		vetted, unvetted := planet.StorageNodes[0].ID(), planet.StorageNodes[1].ID()
+
+		stats, err := satellite.Overlay.Service.UpdateStats(ctx, &overlay.UpdateRequest{
+			NodeID:       vetted,
+			AuditSuccess: true,
+			IsUp:         true,
+		})
+		require.NoError(t, err)
+		require.NotNil(t, stats.VettedAt)
+
+		metainfo := satellite.Metainfo.Service
+		for i := 0; i < 9; i++ {
+			pointer := makePointer(fmt.Sprintf("vetted/%d", i), nil)
+			pointer.Remote.RemotePieces[0].NodeId = vetted
+			require.NoError(t, metainfo.Put(fmt.Sprintf("vetted/%d", i), pointer))
+		}
+		pointer := makePointer("unvetted", nil)
+		pointer.Remote.RemotePieces[0].NodeId = unvetted
+		require.NoError(t, metainfo.Put("unvetted", pointer))
+
+		cursor := audit.NewCursor(metainfo, satellite.Overlay.Service)
+		for i := 0; i < 5; i++ {
+			stripe, _, err := cursor.NextStripe(ctx)
+			require.NoError(t, err)
+			require.NotNil(t, stripe)
+			require.Equal(t, "unvetted", stripe.SegmentPath)
+		}
+	})
+}
+
 func populateTestData(t *testing.T, planet *testplanet.Planet, expiration *timestamp.Timestamp) ([]testData, *audit.Cursor, *metainfo.Service) {
 	tests := []testData{
 		{bm: "success-1", path: "folder1/file1"},
@@ -154,7 +198,7 @@ func populateTestData(t *testing.T, planet *testplanet.Planet, expiration *times
 		{bm: "success-10", path: "Nada/ビデオ/😶"},
 	}
 	metainfo := planet.Satellites[0].Metainfo.Service
-	cursor := audit.NewCursor(metainfo)
+	cursor := audit.NewCursor(metainfo, nil)
 
 	// put 10 pointers in db with expirations
 	t.Run("putToDB", func(t *testing.T) {
@@ -39,7 +39,7 @@ func TestVerifierHappyPath(t *testing.T) {
 
 		metainfo := planet.Satellites[0].Metainfo.Service
 		overlay := planet.Satellites[0].Overlay.Service
-		cursor := audit.NewCursor(metainfo)
+		cursor := audit.NewCursor(metainfo, planet.Satellites[0].Overlay.Service)
 
 		stripe, _, err := cursor.NextStripe(ctx)
 		require.NoError(t, err)
@@ -48,7 +48,7 @@ func TestVerifierHappyPath(t *testing.T) {
 		transport := planet.Satellites[0].Transport
 		orders := planet.Satellites[0].Orders.Service
 		minBytesPerSecond := 128 * memory.B
-		verifier := audit.NewVerifier(zap.L(), transport, overlay, orders, planet.Satellites[0].Identity, minBytesPerSecond)
+		verifier := audit.NewVerifier(zap.L(), metainfo, transport, overlay, planet.Satellites[0].DB.Containment(), orders, planet.Satellites[0].Identity, minBytesPerSecond, 3)
 		require.NotNil(t, verifier)
 
 		// stop some storage nodes to ensure audit can deal with it
@@ -63,7 +63,7 @@ func TestVerifierHappyPath(t *testing.T) {
 		_, err = planet.Satellites[0].Overlay.Service.UpdateUptime(ctx, planet.StorageNodes[1].ID(), false)
 		require.NoError(t, err)
 
-		verifiedNodes, err := verifier.Verify(ctx, stripe)
+		verifiedNodes, err := verifier.Verify(ctx, stripe, nil)
 		require.NoError(t, err)
 
 		require.Len(t, verifiedNodes.SuccessNodeIDs, 4)
@@ -16,8 +16,9 @@ type reporter interface {
 
 // Reporter records audit reports in overlay and implements the reporter interface
 type Reporter struct {
-	overlay    *overlay.Cache
-	maxRetries int
+	overlay     *overlay.Cache
+	containment Containment
+	maxRetries  int
 }
 
 // RecordAuditsInfo is a struct containing arguments/return values for RecordAudits()
@@ -25,11 +26,30 @@ type RecordAuditsInfo struct {
 	SuccessNodeIDs storj.NodeIDList
 	FailNodeIDs    storj.NodeIDList
 	OfflineNodeIDs storj.NodeIDList
+	PendingAudits  []*PendingAudit
+}
+
+// nodeIDs returns the set of all nodes in the report
+func (info *RecordAuditsInfo) nodeIDs() map[storj.NodeID]bool {
+	nodeIDs := make(map[storj.NodeID]bool)
+	for _, nodeID := range info.SuccessNodeIDs {
+		nodeIDs[nodeID] = true
+	}
+	for _, nodeID := range info.FailNodeIDs {
+		nodeIDs[nodeID] = true
+	}
+	for _, nodeID := range info.OfflineNodeIDs {
+		nodeIDs[nodeID] = true
+	}
+	for _, pendingAudit := range info.PendingAudits {
+		nodeIDs[pendingAudit.NodeID] = true
+	}
+	return nodeIDs
 }
 
 // NewReporter instantiates a reporter
-func NewReporter(overlay *overlay.Cache, maxRetries int) *Reporter {
-	return &Reporter{overlay: overlay, maxRetries: maxRetries}
+func NewReporter(overlay *overlay.Cache, containment Containment, maxRetries int) *Reporter {
+	return &Reporter{overlay: overlay, containment: containment, maxRetries: maxRetries}
 }
 
 // RecordAudits saves failed audit details to overlay
@@ -37,12 +57,13 @@ func (reporter *Reporter) RecordAudits(ctx context.Context, req *RecordAuditsInf
 	successNodeIDs := req.SuccessNodeIDs
 	failNodeIDs := req.FailNodeIDs
 	offlineNodeIDs := req.OfflineNodeIDs
+	pendingAudits := req.PendingAudits
 
 	var errNodeIDs storj.NodeIDList
 
 	retries := 0
 	for retries < reporter.maxRetries {
-		if len(successNodeIDs) == 0 && len(failNodeIDs) == 0 && len(offlineNodeIDs) == 0 {
+		if len(successNodeIDs) == 0 && len(failNodeIDs) == 0 && len(offlineNodeIDs) == 0 && len(pendingAudits) == 0 {
 			return nil, nil
 		}
 
@@ -66,6 +87,14 @@ func (reporter *Reporter) RecordAudits(ctx context.Context, req *RecordAuditsInf
 				errNodeIDs = append(errNodeIDs, offlineNodeIDs...)
 			}
 		}
+		if len(pendingAudits) > 0 {
+			pendingAudits, err = reporter.recordPendingAudits(ctx, pendingAudits)
+			if err != nil {
+				for _, pendingAudit := range pendingAudits {
+					errNodeIDs = append(errNodeIDs, pendingAudit.NodeID)
+				}
+			}
+		}
 
 		retries++
 	}
@@ -74,6 +103,7 @@ func (reporter *Reporter) RecordAudits(ctx context.Context, req *RecordAuditsInf
 			SuccessNodeIDs: successNodeIDs,
 			FailNodeIDs:    failNodeIDs,
 			OfflineNodeIDs: offlineNodeIDs,
+			PendingAudits:  pendingAudits,
 		}, Error.New("some nodes failed to be updated in overlay")
 	}
 	return nil, nil
@@ -84,7 +114,14 @@ func (reporter *Reporter) recordAuditFailStatus(ctx context.Context, failedAudit
 	failedIDs := storj.NodeIDList{}
 
 	for _, nodeID := range failedAuditNodeIDs {
-		_, err := reporter.overlay.UpdateStats(ctx, &overlay.UpdateRequest{
+		// the audit outcome is final, so the node no longer has to be contained
+		_, err := reporter.containment.Delete(ctx, nodeID)
+		if err != nil {
+			failedIDs = append(failedIDs, nodeID)
+			continue
+		}
+
+		_, err = reporter.overlay.UpdateStats(ctx, &overlay.UpdateRequest{
 			NodeID:       nodeID,
 			IsUp:         true,
 			AuditSuccess: false,
@@ -121,7 +158,14 @@ func (reporter *Reporter) recordAuditSuccessStatus(ctx context.Context, successN
 	failedIDs := storj.NodeIDList{}
 
 	for _, nodeID := range successNodeIDs {
-		_, err := reporter.overlay.UpdateStats(ctx, &overlay.UpdateRequest{
+		// the audit outcome is final, so the node no longer has to be contained
+		_, err := reporter.containment.Delete(ctx, nodeID)
+		if err != nil {
+			failedIDs = append(failedIDs, nodeID)
+			continue
+		}
+
+		_, err = reporter.overlay.UpdateStats(ctx, &overlay.UpdateRequest{
 			NodeID:       nodeID,
 			IsUp:         true,
 			AuditSuccess: true,
@@ -135,3 +179,19 @@ func (reporter *Reporter) recordAuditSuccessStatus(ctx context.Context, successN
 	}
 	return nil, nil
 }
+
+// recordPendingAudits saves the pending audits of contained nodes, or increments their reverify count
+func (reporter *Reporter) recordPendingAudits(ctx context.Context, pendingAudits []*PendingAudit) (failed []*PendingAudit, err error) {
+	var failedAudits []*PendingAudit
+
+	for _, pendingAudit := range pendingAudits {
+		err := reporter.containment.IncrementPending(ctx, pendingAudit)
+		if err != nil {
+			failedAudits = append(failedAudits, pendingAudit)
+		}
+	}
+	if len(failedAudits) > 0 {
+		return failedAudits, Error.New("failed to record some pending audits")
+	}
+	return nil, nil
+}
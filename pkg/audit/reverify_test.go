@@ -0,0 +1,115 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package audit_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"storj.io/storj/internal/memory"
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/testplanet"
+	"storj.io/storj/pkg/audit"
+	"storj.io/storj/pkg/pkcrypto"
+	"storj.io/storj/pkg/storj"
+)
+
+func TestReverify(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 6, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		err := satellite.Audit.Service.Close()
+		require.NoError(t, err)
+
+		testData := make([]byte, 1*memory.MiB)
+		_, err = rand.Read(testData)
+		require.NoError(t, err)
+
+		err = planet.Uplinks[0].Upload(ctx, satellite, "testbucket", "test/path", testData)
+		require.NoError(t, err)
+
+		cursor := audit.NewCursor(satellite.Metainfo.Service, satellite.Overlay.Service)
+		stripe, _, err := cursor.NextStripe(ctx)
+		require.NoError(t, err)
+		require.NotNil(t, stripe)
+
+		containment := satellite.DB.Containment()
+		verifier := audit.NewVerifier(zap.L(), satellite.Metainfo.Service, satellite.Transport, satellite.Overlay.Service,
+			containment, satellite.Orders.Service, satellite.Identity, 128*memory.B, 3)
+		reporter := audit.NewReporter(satellite.Overlay.Service, containment, 1)
+
+		// download the shares the contained nodes are expected to return
+		remote := stripe.Segment.GetRemote()
+		shareSize := remote.GetRedundancy().GetErasureShareSize()
+		comps := storj.SplitPath(stripe.SegmentPath)
+		bucketID := []byte(storj.JoinPaths(comps[0], comps[2]))
+		limits, err := satellite.Orders.Service.CreateAuditOrderLimits(ctx, satellite.Identity.PeerIdentity(), bucketID, stripe.Segment, nil)
+		require.NoError(t, err)
+		shares, _, err := verifier.DownloadShares(ctx, limits, stripe.Index, shareSize)
+		require.NoError(t, err)
+
+		honest := remote.GetRemotePieces()[0]
+		cheater := remote.GetRemotePieces()[1]
+
+		for _, piece := range []int32{honest.PieceNum, cheater.PieceNum} {
+			require.NoError(t, shares[int(piece)].Error)
+		}
+
+		err = containment.IncrementPending(ctx, &audit.PendingAudit{
+			NodeID:            honest.NodeId,
+			PieceID:           remote.RootPieceId,
+			StripeIndex:       stripe.Index,
+			ShareSize:         shareSize,
+			ExpectedShareHash: pkcrypto.SHA256Hash(shares[int(honest.PieceNum)].Data),
+			Path:              stripe.SegmentPath,
+		})
+		require.NoError(t, err)
+
+		err = containment.IncrementPending(ctx, &audit.PendingAudit{
+			NodeID:            cheater.NodeId,
+			PieceID:           remote.RootPieceId,
+			StripeIndex:       stripe.Index,
+			ShareSize:         shareSize,
+			ExpectedShareHash: pkcrypto.SHA256Hash([]byte("not the share")),
+			Path:              stripe.SegmentPath,
+		})
+		require.NoError(t, err)
+
+		node, err := satellite.Overlay.Service.Get(ctx, honest.NodeId)
+		require.NoError(t, err)
+		require.True(t, node.Contained)
+
+		verifiedNodes, err := verifier.Reverify(ctx, stripe)
+		require.NoError(t, err)
+		require.Len(t, verifiedNodes.SuccessNodeIDs, 1)
+		require.Equal(t, honest.NodeId, verifiedNodes.SuccessNodeIDs[0])
+		require.Len(t, verifiedNodes.FailNodeIDs, 1)
+		require.Equal(t, cheater.NodeId, verifiedNodes.FailNodeIDs[0])
+		require.Empty(t, verifiedNodes.OfflineNodeIDs)
+		require.Empty(t, verifiedNodes.PendingAudits)
+
+		failed, err := reporter.RecordAudits(ctx, verifiedNodes)
+		require.NoError(t, err)
+		require.Nil(t, failed)
+
+		// both nodes are released from containment once their audits are recorded
+		for _, nodeID := range []storj.NodeID{honest.NodeId, cheater.NodeId} {
+			_, err = containment.Get(ctx, nodeID)
+			require.True(t, audit.ErrContainedNotFound.Has(err))
+
+			node, err := satellite.Overlay.Service.Get(ctx, nodeID)
+			require.NoError(t, err)
+			require.False(t, node.Contained)
+		}
+
+		node, err = satellite.Overlay.Service.Get(ctx, cheater.NodeId)
+		require.NoError(t, err)
+		require.EqualValues(t, 1, node.Reputation.AuditCount)
+		require.EqualValues(t, 0, node.Reputation.AuditSuccessCount)
+	})
+}
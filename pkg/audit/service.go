@@ -27,6 +27,7 @@ type Config struct {
 	MaxRetriesStatDB  int           `help:"max number of times to attempt updating a statdb batch" default:"3"`
 	Interval          time.Duration `help:"how frequently segments are audited" default:"30s"`
 	MinBytesPerSecond memory.Size   `help:"the minimum acceptable bytes that storage nodes can transfer per second to the satellite" default:"128B"`
+	MaxReverifyCount  int           `help:"the number of times a contained node can fail reverification before it gets an audit failure" default:"3"`
 }
 
 // Service helps coordinate Cursor and Verifier to run the audit process continuously
@@ -43,13 +44,13 @@ type Service struct {
 // NewService instantiates a Service with access to a Cursor and Verifier
 func NewService(log *zap.Logger, config Config, metainfo *metainfo.Service,
 	orders *orders.Service, transport transport.Client, overlay *overlay.Cache,
-	identity *identity.FullIdentity) (service *Service, err error) {
+	containment Containment, identity *identity.FullIdentity) (service *Service, err error) {
 	return &Service{
 		log: log,
 
-		Cursor:   NewCursor(metainfo),
-		Verifier: NewVerifier(log.Named("audit:verifier"), transport, overlay, orders, identity, config.MinBytesPerSecond),
-		Reporter: NewReporter(overlay, config.MaxRetriesStatDB),
+		Cursor:   NewCursor(metainfo, overlay),
+		Verifier: NewVerifier(log.Named("audit:verifier"), metainfo, transport, overlay, containment, orders, identity, config.MinBytesPerSecond, config.MaxReverifyCount),
+		Reporter: NewReporter(overlay, containment, config.MaxRetriesStatDB),
 
 		Loop: *sync2.NewCycle(config.Interval),
 	}, nil
@@ -92,7 +93,18 @@ func (service *Service) process(ctx context.Context) error {
 		}
 	}
 
-	verifiedNodes, err := service.Verifier.Verify(ctx, stripe)
+	// contained nodes have to pass the reverification of their pending audits first
+	reverifiedNodes, err := service.Verifier.Reverify(ctx, stripe)
+	if err != nil {
+		return err
+	}
+
+	_, err = service.Reporter.RecordAudits(ctx, reverifiedNodes)
+	if err != nil {
+		return err
+	}
+
+	verifiedNodes, err := service.Verifier.Verify(ctx, stripe, reverifiedNodes.nodeIDs())
 	if err != nil {
 		return err
 	}
@@ -41,7 +41,7 @@ func TestGetShareTimeout(t *testing.T) {
 
 		metainfo := planet.Satellites[0].Metainfo.Service
 		overlay := planet.Satellites[0].Overlay.Service
-		cursor := audit.NewCursor(metainfo)
+		cursor := audit.NewCursor(metainfo, planet.Satellites[0].Overlay.Service)
 
 		var stripe *audit.Stripe
 		stripe, _, err = cursor.NextStripe(ctx)
@@ -61,7 +61,7 @@ func TestGetShareTimeout(t *testing.T) {
 		// downloading from new nodes.
 		minBytesPerSecond := 110 * memory.KB
 		orders := planet.Satellites[0].Orders.Service
-		verifier := audit.NewVerifier(zap.L(), slowClient, overlay, orders, planet.Satellites[0].Identity, minBytesPerSecond)
+		verifier := audit.NewVerifier(zap.L(), metainfo, slowClient, overlay, planet.Satellites[0].DB.Containment(), orders, planet.Satellites[0].Identity, minBytesPerSecond, 3)
 		require.NotNil(t, verifier)
 
 		// stop some storage nodes to ensure audit can deal with it
@@ -77,7 +77,7 @@ func TestGetShareTimeout(t *testing.T) {
 			require.NoError(t, err)
 		}
 
-		_, err = verifier.Verify(ctx, stripe)
+		_, err = verifier.Verify(ctx, stripe, nil)
 		require.NoError(t, err)
 	})
 }
@@ -12,6 +12,8 @@ import (
 	"github.com/vivint/infectious"
 	"github.com/zeebo/errs"
 	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	monkit "gopkg.in/spacemonkeygo/monkit.v2"
 
 	"storj.io/storj/internal/memory"
@@ -19,9 +21,12 @@ import (
 	"storj.io/storj/pkg/identity"
 	"storj.io/storj/pkg/overlay"
 	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/pkcrypto"
 	"storj.io/storj/pkg/storj"
 	"storj.io/storj/pkg/transport"
+	"storj.io/storj/satellite/metainfo"
 	"storj.io/storj/satellite/orders"
+	"storj.io/storj/storage"
 	"storj.io/storj/uplink/piecestore"
 )
 
@@ -39,27 +44,41 @@ type Share struct {
 // Verifier helps verify the correctness of a given stripe
 type Verifier struct {
 	log               *zap.Logger
+	metainfo          *metainfo.Service
 	orders            *orders.Service
 	auditor           *identity.PeerIdentity
 	transport         transport.Client
 	overlay           *overlay.Cache
+	containment       Containment
 	minBytesPerSecond memory.Size
+	maxReverifyCount  int
 }
 
 // NewVerifier creates a Verifier
-func NewVerifier(log *zap.Logger, transport transport.Client, overlay *overlay.Cache, orders *orders.Service, id *identity.FullIdentity, minBytesPerSecond memory.Size) *Verifier {
-	return &Verifier{log: log, orders: orders, auditor: id.PeerIdentity(), transport: transport, overlay: overlay, minBytesPerSecond: minBytesPerSecond}
+func NewVerifier(log *zap.Logger, metainfo *metainfo.Service, transport transport.Client, overlay *overlay.Cache, containment Containment, orders *orders.Service, id *identity.FullIdentity, minBytesPerSecond memory.Size, maxReverifyCount int) *Verifier {
+	return &Verifier{
+		log:               log,
+		metainfo:          metainfo,
+		orders:            orders,
+		auditor:           id.PeerIdentity(),
+		transport:         transport,
+		overlay:           overlay,
+		containment:       containment,
+		minBytesPerSecond: minBytesPerSecond,
+		maxReverifyCount:  maxReverifyCount,
+	}
 }
 
-// Verify downloads shares then verifies the data correctness at the given stripe
-func (verifier *Verifier) Verify(ctx context.Context, stripe *Stripe) (verifiedNodes *RecordAuditsInfo, err error) {
+// Verify downloads shares then verifies the data correctness at the given stripe.
+// The pieces held by the nodes in skip are left out of the audit.
+func (verifier *Verifier) Verify(ctx context.Context, stripe *Stripe, skip map[storj.NodeID]bool) (verifiedNodes *RecordAuditsInfo, err error) {
 	defer mon.Task()(&ctx)(&err)
 
 	pointer := stripe.Segment
 	shareSize := pointer.GetRemote().GetRedundancy().GetErasureShareSize()
 	bucketID := createBucketID(stripe.SegmentPath)
 
-	orderLimits, err := verifier.orders.CreateAuditOrderLimits(ctx, verifier.auditor, bucketID, pointer)
+	orderLimits, err := verifier.orders.CreateAuditOrderLimits(ctx, verifier.auditor, bucketID, pointer, skip)
 	if err != nil {
 		return nil, err
 	}
@@ -69,19 +88,33 @@ func (verifier *Verifier) Verify(ctx context.Context, stripe *Stripe) (verifiedN
 		return nil, err
 	}
 
+	return verifyShares(ctx, stripe, shares, nodes)
+}
+
+// verifyShares checks the downloaded shares of the stripe and sorts the nodes
+// holding them by the outcome of their audit.
+func verifyShares(ctx context.Context, stripe *Stripe, shares map[int]Share, nodes map[int]storj.NodeID) (verifiedNodes *RecordAuditsInfo, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	pointer := stripe.Segment
+
 	var offlineNodes storj.NodeIDList
 	var failedNodes storj.NodeIDList
+	containedNodes := make(map[int]storj.NodeID)
 	sharesToAudit := make(map[int]Share)
 
 	for pieceNum, share := range shares {
-		if shares[pieceNum].Error != nil {
-			if shares[pieceNum].Error == context.DeadlineExceeded || !transport.Error.Has(shares[pieceNum].Error) {
-				failedNodes = append(failedNodes, nodes[pieceNum])
-			} else {
-				offlineNodes = append(offlineNodes, nodes[pieceNum])
-			}
-		} else {
+		switch {
+		case share.Error == nil:
 			sharesToAudit[pieceNum] = share
+		case transport.Error.Has(share.Error):
+			offlineNodes = append(offlineNodes, nodes[pieceNum])
+		case isTimeout(share.Error):
+			// the node accepted the download but didn't return the share in time,
+			// so it has to return the same share on the next audits
+			containedNodes[pieceNum] = nodes[pieceNum]
+		default:
+			failedNodes = append(failedNodes, nodes[pieceNum])
 		}
 	}
 
@@ -89,6 +122,12 @@ func (verifier *Verifier) Verify(ctx context.Context, stripe *Stripe) (verifiedN
 	total := int(pointer.Remote.Redundancy.GetTotal())
 
 	if len(sharesToAudit) < required {
+		// the shares expected from the contained nodes can't be computed
+		// without enough shares, so they fail the audit instead
+		for _, nodeID := range containedNodes {
+			failedNodes = append(failedNodes, nodeID)
+		}
+
 		return &RecordAuditsInfo{
 			SuccessNodeIDs: nil,
 			FailNodeIDs:    failedNodes,
@@ -96,7 +135,7 @@ func (verifier *Verifier) Verify(ctx context.Context, stripe *Stripe) (verifiedN
 		}, nil
 	}
 
-	pieceNums, err := auditShares(ctx, required, total, sharesToAudit)
+	pieceNums, correctedShares, err := auditShares(ctx, required, total, sharesToAudit)
 	if err != nil {
 		return nil, err
 	}
@@ -105,15 +144,118 @@ func (verifier *Verifier) Verify(ctx context.Context, stripe *Stripe) (verifiedN
 		failedNodes = append(failedNodes, nodes[pieceNum])
 	}
 
-	successNodes := getSuccessNodes(ctx, nodes, failedNodes, offlineNodes)
+	successNodes := getSuccessNodes(ctx, nodes, failedNodes, offlineNodes, containedNodes)
+
+	pendingAudits, err := createPendingAudits(ctx, containedNodes, correctedShares, stripe)
+	if err != nil {
+		return nil, err
+	}
 
 	return &RecordAuditsInfo{
 		SuccessNodeIDs: successNodes,
 		FailNodeIDs:    failedNodes,
 		OfflineNodeIDs: offlineNodes,
+		PendingAudits:  pendingAudits,
 	}, nil
 }
 
+// Reverify retries the pending audits of the contained nodes holding pieces of the stripe's segment
+func (verifier *Verifier) Reverify(ctx context.Context, stripe *Stripe) (verifiedNodes *RecordAuditsInfo, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	verifiedNodes = &RecordAuditsInfo{}
+
+	for _, piece := range stripe.Segment.GetRemote().GetRemotePieces() {
+		pending, err := verifier.containment.Get(ctx, piece.NodeId)
+		if err != nil {
+			if ErrContainedNotFound.Has(err) {
+				continue
+			}
+			return nil, err
+		}
+
+		err = verifier.reverifyPending(ctx, pending, verifiedNodes)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return verifiedNodes, nil
+}
+
+// reverifyPending downloads the share of a pending audit again and adds the outcome to verifiedNodes
+func (verifier *Verifier) reverifyPending(ctx context.Context, pending *PendingAudit, verifiedNodes *RecordAuditsInfo) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	pieceNum, ok, err := verifier.pendingPieceNum(ctx, pending)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		// the share can no longer be audited, so release the node without penalty
+		_, err = verifier.containment.Delete(ctx, pending.NodeID)
+		return err
+	}
+
+	limit, err := verifier.orders.CreateAuditOrderLimit(ctx, verifier.auditor, createBucketID(pending.Path), pending.NodeID, pieceNum, pending.PieceID, pending.ShareSize)
+	if err != nil {
+		if overlay.ErrNodeOffline.Has(err) {
+			verifiedNodes.OfflineNodeIDs = append(verifiedNodes.OfflineNodeIDs, pending.NodeID)
+			return nil
+		}
+		return err
+	}
+
+	share, err := verifier.getShare(ctx, limit, pending.StripeIndex, pending.ShareSize, int(pieceNum))
+	switch {
+	case err == nil:
+		if bytes.Equal(pkcrypto.SHA256Hash(share.Data), pending.ExpectedShareHash) {
+			verifiedNodes.SuccessNodeIDs = append(verifiedNodes.SuccessNodeIDs, pending.NodeID)
+		} else {
+			verifiedNodes.FailNodeIDs = append(verifiedNodes.FailNodeIDs, pending.NodeID)
+		}
+	case transport.Error.Has(err):
+		verifiedNodes.OfflineNodeIDs = append(verifiedNodes.OfflineNodeIDs, pending.NodeID)
+	case isTimeout(err):
+		if int(pending.ReverifyCount)+1 >= verifier.maxReverifyCount {
+			verifiedNodes.FailNodeIDs = append(verifiedNodes.FailNodeIDs, pending.NodeID)
+		} else {
+			verifiedNodes.PendingAudits = append(verifiedNodes.PendingAudits, pending)
+		}
+	default:
+		verifiedNodes.FailNodeIDs = append(verifiedNodes.FailNodeIDs, pending.NodeID)
+	}
+
+	return nil
+}
+
+// pendingPieceNum looks up the number of the piece a pending audit refers to.
+// It returns false if the segment was deleted or replaced, or the node no longer holds the piece.
+func (verifier *Verifier) pendingPieceNum(ctx context.Context, pending *PendingAudit) (pieceNum int32, ok bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	pointer, err := verifier.metainfo.Get(pending.Path)
+	if err != nil {
+		if storage.ErrKeyNotFound.Has(err) || storage.ErrEmptyKey.Has(err) {
+			return 0, false, nil
+		}
+		return 0, false, err
+	}
+
+	remote := pointer.GetRemote()
+	if remote == nil || remote.RootPieceId != pending.PieceID {
+		return 0, false, nil
+	}
+
+	for _, piece := range remote.GetRemotePieces() {
+		if piece.NodeId == pending.NodeID {
+			return piece.PieceNum, true, nil
+		}
+	}
+
+	return 0, false, nil
+}
+
 // DownloadShares downloads shares from the nodes where remote pieces are located
 func (verifier *Verifier) DownloadShares(ctx context.Context, limits []*pb.AddressedOrderLimit, stripeIndex int64, shareSize int32) (shares map[int]Share, nodes map[int]storj.NodeID, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -204,22 +346,23 @@ func (verifier *Verifier) getShare(ctx context.Context, limit *pb.AddressedOrder
 }
 
 // auditShares takes the downloaded shares and uses infectious's Correct function to check that they
-// haven't been altered. auditShares returns a slice containing the piece numbers of altered shares.
-func auditShares(ctx context.Context, required, total int, originals map[int]Share) (pieceNums []int, err error) {
+// haven't been altered. auditShares returns a slice containing the piece numbers of altered shares,
+// and the corrected shares.
+func auditShares(ctx context.Context, required, total int, originals map[int]Share) (pieceNums []int, corrected []infectious.Share, err error) {
 	defer mon.Task()(&ctx)(&err)
 	f, err := infectious.NewFEC(required, total)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	copies, err := makeCopies(ctx, originals)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	err = f.Correct(copies)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	for _, share := range copies {
@@ -227,7 +370,54 @@ func auditShares(ctx context.Context, required, total int, originals map[int]Sha
 			pieceNums = append(pieceNums, share.Number)
 		}
 	}
-	return pieceNums, nil
+	return pieceNums, copies, nil
+}
+
+// createPendingAudits rebuilds the stripe from the corrected shares and computes the shares
+// the contained nodes are expected to return on reverification
+func createPendingAudits(ctx context.Context, containedNodes map[int]storj.NodeID, corrected []infectious.Share, stripe *Stripe) (pendingAudits []*PendingAudit, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if len(containedNodes) == 0 {
+		return nil, nil
+	}
+
+	redundancy := stripe.Segment.GetRemote().GetRedundancy()
+	required := int(redundancy.GetMinReq())
+	total := int(redundancy.GetTotal())
+	shareSize := redundancy.GetErasureShareSize()
+
+	f, err := infectious.NewFEC(required, total)
+	if err != nil {
+		return nil, err
+	}
+
+	stripeData := make([]byte, required*int(shareSize))
+	err = f.Rebuild(corrected, func(share infectious.Share) {
+		copy(stripeData[share.Number*int(shareSize):], share.Data)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for pieceNum, nodeID := range containedNodes {
+		share := make([]byte, shareSize)
+		err = f.EncodeSingle(stripeData, share, pieceNum)
+		if err != nil {
+			return nil, err
+		}
+
+		pendingAudits = append(pendingAudits, &PendingAudit{
+			NodeID:            nodeID,
+			PieceID:           stripe.Segment.GetRemote().RootPieceId,
+			StripeIndex:       stripe.Index,
+			ShareSize:         shareSize,
+			ExpectedShareHash: pkcrypto.SHA256Hash(share),
+			Path:              stripe.SegmentPath,
+		})
+	}
+
+	return pendingAudits, nil
 }
 
 // makeCopies takes in a map of audit Shares and deep copies their data to a slice of infectious Shares
@@ -242,8 +432,8 @@ func makeCopies(ctx context.Context, originals map[int]Share) (copies []infectio
 	return copies, nil
 }
 
-// getSuccessNodes uses the failed nodes and offline nodes arrays to determine which nodes passed the audit
-func getSuccessNodes(ctx context.Context, nodes map[int]storj.NodeID, failedNodes, offlineNodes storj.NodeIDList) (successNodes storj.NodeIDList) {
+// getSuccessNodes uses the failed, offline and contained nodes to determine which nodes passed the audit
+func getSuccessNodes(ctx context.Context, nodes map[int]storj.NodeID, failedNodes, offlineNodes storj.NodeIDList, containedNodes map[int]storj.NodeID) (successNodes storj.NodeIDList) {
 	fails := make(map[storj.NodeID]bool)
 	for _, fail := range failedNodes {
 		fails[fail] = true
@@ -251,6 +441,9 @@ func getSuccessNodes(ctx context.Context, nodes map[int]storj.NodeID, failedNode
 	for _, offline := range offlineNodes {
 		fails[offline] = true
 	}
+	for _, contained := range containedNodes {
+		fails[contained] = true
+	}
 
 	for _, node := range nodes {
 		if !fails[node] {
@@ -269,3 +462,9 @@ func createBucketID(path storj.Path) []byte {
 	// project_id/bucket_name
 	return []byte(storj.JoinPaths(comps[0], comps[2]))
 }
+
+// isTimeout returns whether err was caused by the download not finishing in time
+func isTimeout(err error) bool {
+	err = errs.Unwrap(err)
+	return err == context.DeadlineExceeded || status.Code(err) == codes.DeadlineExceeded
+}
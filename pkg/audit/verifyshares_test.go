@@ -0,0 +1,92 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package audit
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vivint/infectious"
+
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/teststorj"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/pkcrypto"
+	"storj.io/storj/pkg/storj"
+)
+
+func TestVerifySharesContainsTimedOutNodes(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	const (
+		required  = 2
+		total     = 4
+		shareSize = 8
+	)
+
+	f, err := infectious.NewFEC(required, total)
+	require.NoError(t, err)
+
+	encoded := make([][]byte, total)
+	err = f.Encode([]byte("hello, world! __"), func(share infectious.Share) {
+		encoded[share.Number] = append([]byte(nil), share.Data...)
+	})
+	require.NoError(t, err)
+
+	stripe := &Stripe{
+		Index:       0,
+		SegmentPath: "projectID/l/bucket/path",
+		Segment: &pb.Pointer{
+			Remote: &pb.RemoteSegment{
+				Redundancy: &pb.RedundancyScheme{
+					MinReq:           required,
+					Total:            total,
+					ErasureShareSize: shareSize,
+				},
+				RootPieceId: teststorj.PieceIDFromString("root"),
+			},
+		},
+	}
+
+	nodes := make(map[int]storj.NodeID, total)
+	for i := 0; i < total; i++ {
+		nodes[i] = teststorj.NodeIDFromString(string(rune('a' + i)))
+	}
+
+	{ // the contained nodes are expected to return the rebuilt shares
+		shares := map[int]Share{
+			0: {PieceNum: 0, Data: encoded[0]},
+			1: {PieceNum: 1, Data: encoded[1]},
+			2: {PieceNum: 2, Data: encoded[2]},
+			3: {PieceNum: 3, Error: context.DeadlineExceeded},
+		}
+
+		verifiedNodes, err := verifyShares(ctx, stripe, shares, nodes)
+		require.NoError(t, err)
+		assert.Len(t, verifiedNodes.SuccessNodeIDs, 3)
+		assert.Empty(t, verifiedNodes.FailNodeIDs)
+		require.Len(t, verifiedNodes.PendingAudits, 1)
+
+		pending := verifiedNodes.PendingAudits[0]
+		assert.Equal(t, nodes[3], pending.NodeID)
+		assert.Equal(t, pkcrypto.SHA256Hash(encoded[3]), pending.ExpectedShareHash)
+	}
+
+	{ // without enough shares the contained nodes fail the audit
+		shares := map[int]Share{
+			0: {PieceNum: 0, Data: encoded[0]},
+			1: {PieceNum: 1, Error: context.DeadlineExceeded},
+			2: {PieceNum: 2, Error: context.DeadlineExceeded},
+		}
+
+		verifiedNodes, err := verifyShares(ctx, stripe, shares, nodes)
+		require.NoError(t, err)
+		assert.Empty(t, verifiedNodes.SuccessNodeIDs)
+		assert.ElementsMatch(t, storj.NodeIDList{nodes[1], nodes[2]}, verifiedNodes.FailNodeIDs)
+		assert.Empty(t, verifiedNodes.PendingAudits)
+	}
+}
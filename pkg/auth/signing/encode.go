@@ -32,3 +32,19 @@ func EncodePieceHash(hash *pb.PieceHash) ([]byte, error) {
 	defer func() { hash.Signature = signature }()
 	return proto.Marshal(hash)
 }
+
+// EncodeDeletePiecesRequest encodes delete pieces request into bytes for signing.
+func EncodeDeletePiecesRequest(req *pb.DeletePiecesRequest) ([]byte, error) {
+	signature := req.SatelliteSignature
+	req.SatelliteSignature = nil
+	defer func() { req.SatelliteSignature = signature }()
+	return proto.Marshal(req)
+}
+
+// EncodeRestoreTrashRequest encodes restore trash request into bytes for signing.
+func EncodeRestoreTrashRequest(req *pb.RestoreTrashRequest) ([]byte, error) {
+	signature := req.SatelliteSignature
+	req.SatelliteSignature = nil
+	defer func() { req.SatelliteSignature = signature }()
+	return proto.Marshal(req)
+}
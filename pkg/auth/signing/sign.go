@@ -70,3 +70,37 @@ func SignPieceHash(signer Signer, unsigned *pb.PieceHash) (*pb.PieceHash, error)
 
 	return &signed, nil
 }
+
+// SignDeletePiecesRequest signs the delete pieces request using the specified signer.
+// Signer is a satellite.
+func SignDeletePiecesRequest(satellite Signer, unsigned *pb.DeletePiecesRequest) (*pb.DeletePiecesRequest, error) {
+	bytes, err := EncodeDeletePiecesRequest(unsigned)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	signed := *unsigned
+	signed.SatelliteSignature, err = satellite.HashAndSign(bytes)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	return &signed, nil
+}
+
+// SignRestoreTrashRequest signs the restore trash request using the specified signer.
+// Signer is a satellite.
+func SignRestoreTrashRequest(satellite Signer, unsigned *pb.RestoreTrashRequest) (*pb.RestoreTrashRequest, error) {
+	bytes, err := EncodeRestoreTrashRequest(unsigned)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	signed := *unsigned
+	signed.SatelliteSignature, err = satellite.HashAndSign(bytes)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	return &signed, nil
+}
@@ -43,3 +43,23 @@ func VerifyPieceHashSignature(signee Signee, signed *pb.PieceHash) error {
 
 	return signee.HashAndVerifySignature(bytes, signed.Signature)
 }
+
+// VerifyDeletePiecesRequestSignature verifies that the signature inside delete pieces request belongs to the satellite.
+func VerifyDeletePiecesRequestSignature(satellite Signee, signed *pb.DeletePiecesRequest) error {
+	bytes, err := EncodeDeletePiecesRequest(signed)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	return satellite.HashAndVerifySignature(bytes, signed.SatelliteSignature)
+}
+
+// VerifyRestoreTrashRequestSignature verifies that the signature inside restore trash request belongs to the satellite.
+func VerifyRestoreTrashRequestSignature(satellite Signee, signed *pb.RestoreTrashRequest) error {
+	bytes, err := EncodeRestoreTrashRequest(signed)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	return satellite.HashAndVerifySignature(bytes, signed.SatelliteSignature)
+}
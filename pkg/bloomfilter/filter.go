@@ -0,0 +1,139 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package bloomfilter
+
+import (
+	"encoding/binary"
+	"math"
+	"math/rand"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/pkg/storj"
+)
+
+// Error is the default error class for bloom filters.
+var Error = errs.Class("bloom filter error")
+
+const (
+	version1 = 1
+
+	// headerSize is the size of the version, seed and hash count header.
+	headerSize = 3
+	// maxHashCount is the number of distinct hashes that can be taken from a piece ID.
+	maxHashCount = len(storj.PieceID{})
+)
+
+// Filter is a bloom filter for piece IDs.
+//
+// Piece IDs are already uniformly random, so instead of hashing them the filter
+// uses different 8 byte windows of the ID as its hashes. The seed decides where
+// the windows start, so that filters with different seeds have independent
+// false positives.
+type Filter struct {
+	seed      byte
+	hashCount byte
+	table     []byte
+}
+
+// NewOptimal returns a filter sized for the expected number of elements and the false positive rate.
+func NewOptimal(expectedElements int, falsePositiveRate float64) *Filter {
+	hashCount, size := getHashCountAndSize(expectedElements, falsePositiveRate)
+	return newExplicit(byte(rand.Intn(maxHashCount)), hashCount, size)
+}
+
+// newExplicit returns a filter with the given parameters.
+func newExplicit(seed, hashCount byte, sizeInBytes int) *Filter {
+	return &Filter{
+		seed:      seed,
+		hashCount: hashCount,
+		table:     make([]byte, sizeInBytes),
+	}
+}
+
+// getHashCountAndSize returns the optimal hash count and table size in bytes.
+func getHashCountAndSize(expectedElements int, falsePositiveRate float64) (hashCount byte, size int) {
+	if expectedElements < 1 {
+		expectedElements = 1
+	}
+	if falsePositiveRate <= 0 || falsePositiveRate >= 1 {
+		falsePositiveRate = 0.1
+	}
+
+	bits := -float64(expectedElements) * math.Log(falsePositiveRate) / (math.Ln2 * math.Ln2)
+	hashes := math.Ceil(bits / float64(expectedElements) * math.Ln2)
+	if hashes < 1 {
+		hashes = 1
+	}
+	if hashes > float64(maxHashCount) {
+		hashes = float64(maxHashCount)
+	}
+
+	size = int(math.Ceil(bits / 8))
+	if size < 1 {
+		size = 1
+	}
+	return byte(hashes), size
+}
+
+// Add adds a piece ID to the filter.
+func (filter *Filter) Add(pieceID storj.PieceID) {
+	for k := 0; k < int(filter.hashCount); k++ {
+		index := filter.bitIndex(pieceID, k)
+		filter.table[index/8] |= 1 << (index % 8)
+	}
+}
+
+// Contains returns true if the piece ID may be in the filter and false if it definitely isn't.
+func (filter *Filter) Contains(pieceID storj.PieceID) bool {
+	for k := 0; k < int(filter.hashCount); k++ {
+		index := filter.bitIndex(pieceID, k)
+		if filter.table[index/8]&(1<<(index%8)) == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// bitIndex returns the index of the bit for the k-th hash of the piece ID.
+func (filter *Filter) bitIndex(pieceID storj.PieceID, k int) uint64 {
+	var window [8]byte
+	start := int(filter.seed) + k
+	for i := range window {
+		window[i] = pieceID[(start+i)%len(pieceID)]
+	}
+	return binary.LittleEndian.Uint64(window[:]) % uint64(len(filter.table)*8)
+}
+
+// Size returns the size of the filter in bytes.
+func (filter *Filter) Size() int64 {
+	return int64(headerSize + len(filter.table))
+}
+
+// Bytes returns the serialized filter.
+func (filter *Filter) Bytes() []byte {
+	data := make([]byte, 0, filter.Size())
+	data = append(data, version1, filter.seed, filter.hashCount)
+	return append(data, filter.table...)
+}
+
+// NewFromBytes deserializes a filter created with Bytes.
+func NewFromBytes(data []byte) (*Filter, error) {
+	if len(data) < headerSize+1 {
+		return nil, Error.New("not enough data")
+	}
+	if data[0] != version1 {
+		return nil, Error.New("unsupported version %d", data[0])
+	}
+
+	filter := &Filter{
+		seed:      data[1],
+		hashCount: data[2],
+		table:     append([]byte(nil), data[headerSize:]...),
+	}
+	if filter.hashCount == 0 || int(filter.hashCount) > maxHashCount {
+		return nil, Error.New("invalid hash count %d", filter.hashCount)
+	}
+	return filter, nil
+}
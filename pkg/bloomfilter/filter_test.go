@@ -0,0 +1,79 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package bloomfilter_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/pkg/bloomfilter"
+	"storj.io/storj/pkg/storj"
+)
+
+func TestNoFalseNegatives(t *testing.T) {
+	const numPieces = 10000
+
+	pieceIDs := make([]storj.PieceID, numPieces)
+	for i := range pieceIDs {
+		pieceIDs[i] = storj.NewPieceID()
+	}
+
+	filter := bloomfilter.NewOptimal(numPieces, 0.1)
+	for _, pieceID := range pieceIDs {
+		filter.Add(pieceID)
+	}
+
+	for _, pieceID := range pieceIDs {
+		require.True(t, filter.Contains(pieceID))
+	}
+}
+
+func TestFalsePositiveRate(t *testing.T) {
+	const numPieces = 10000
+
+	for _, rate := range []float64{0.5, 0.1, 0.01} {
+		filter := bloomfilter.NewOptimal(numPieces, rate)
+		for i := 0; i < numPieces; i++ {
+			filter.Add(storj.NewPieceID())
+		}
+
+		falsePositives := 0
+		for i := 0; i < numPieces; i++ {
+			if filter.Contains(storj.NewPieceID()) {
+				falsePositives++
+			}
+		}
+
+		assert.InDelta(t, rate, float64(falsePositives)/numPieces, rate/2, "rate %v", rate)
+	}
+}
+
+func TestBytes(t *testing.T) {
+	filter := bloomfilter.NewOptimal(1000, 0.1)
+
+	pieceIDs := make([]storj.PieceID, 1000)
+	for i := range pieceIDs {
+		pieceIDs[i] = storj.NewPieceID()
+		filter.Add(pieceIDs[i])
+	}
+
+	data := filter.Bytes()
+	require.EqualValues(t, filter.Size(), len(data))
+
+	decoded, err := bloomfilter.NewFromBytes(data)
+	require.NoError(t, err)
+	require.Equal(t, filter, decoded)
+
+	for _, pieceID := range pieceIDs {
+		require.True(t, decoded.Contains(pieceID))
+	}
+
+	_, err = bloomfilter.NewFromBytes(nil)
+	require.Error(t, err)
+
+	_, err = bloomfilter.NewFromBytes([]byte{2, 0, 1, 0})
+	require.Error(t, err)
+}
@@ -135,6 +135,55 @@ func TestIdentifyIrreparableSegments(t *testing.T) {
 	})
 }
 
+func TestDisqualifiedNodePiecesAreMissing(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 0,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		checker := satellite.Repair.Checker
+		checker.Loop.Stop()
+
+		pieces := make([]*pb.RemotePiece, 0, len(planet.StorageNodes))
+		for i, node := range planet.StorageNodes {
+			pieces = append(pieces, &pb.RemotePiece{
+				PieceNum: int32(i),
+				NodeId:   node.ID(),
+			})
+		}
+		pointer := &pb.Pointer{
+			Remote: &pb.RemoteSegment{
+				Redundancy: &pb.RedundancyScheme{
+					MinReq:           2,
+					RepairThreshold:  3,
+					SuccessThreshold: 4,
+				},
+				RootPieceId:  teststorj.PieceIDFromString("a"),
+				RemotePieces: pieces,
+			},
+		}
+		err := satellite.Metainfo.Service.Put("a", pointer)
+		require.NoError(t, err)
+
+		repairQueue := satellite.DB.RepairQueue()
+
+		err = checker.IdentifyInjuredSegments(ctx)
+		require.NoError(t, err)
+		_, err = repairQueue.Select(ctx)
+		require.True(t, storage.ErrEmptyQueue.Has(err))
+
+		err = satellite.Overlay.Service.Disqualify(ctx, planet.StorageNodes[0].ID())
+		require.NoError(t, err)
+
+		err = checker.IdentifyInjuredSegments(ctx)
+		require.NoError(t, err)
+
+		injuredSegment, err := repairQueue.Select(ctx)
+		require.NoError(t, err)
+		require.Equal(t, "a", injuredSegment.Path)
+		require.Equal(t, []int32{0}, injuredSegment.LostPieces)
+	})
+}
+
 func makePointer(t *testing.T, planet *testplanet.Planet, pieceID string, createLost bool) {
 	numOfStorageNodes := len(planet.StorageNodes)
 	pieces := make([]*pb.RemotePiece, 0, numOfStorageNodes)
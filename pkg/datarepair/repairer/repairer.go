@@ -33,10 +33,11 @@ var (
 
 // Config contains configurable values for repairer
 type Config struct {
-	MaxRepair    int           `help:"maximum segments that can be repaired concurrently" releaseDefault:"5" devDefault:"1"`
-	Interval     time.Duration `help:"how frequently checker should audit segments" releaseDefault:"1h" devDefault:"0h5m0s"`
-	Timeout      time.Duration `help:"time limit for uploading repaired pieces to new storage nodes" default:"10m0s"`
-	MaxBufferMem memory.Size   `help:"maximum buffer memory (in bytes) to be allocated for read buffers" default:"4M"`
+	MaxRepair      int           `help:"maximum segments that can be repaired concurrently" releaseDefault:"5" devDefault:"1"`
+	Interval       time.Duration `help:"how frequently checker should audit segments" releaseDefault:"1h" devDefault:"0h5m0s"`
+	Timeout        time.Duration `help:"time limit for uploading repaired pieces to new storage nodes" default:"10m0s"`
+	MaxBufferMem   memory.Size   `help:"maximum buffer memory (in bytes) to be allocated for read buffers" default:"4M"`
+	HoldBackSpares bool          `help:"hold back some of the new nodes above the success threshold and upload to them only when a node is overloaded" default:"false"`
 }
 
 // GetSegmentRepairer creates a new segment repairer from storeConfig values
@@ -44,6 +45,9 @@ func (c Config) GetSegmentRepairer(ctx context.Context, tc transport.Client, met
 	defer mon.Task()(&ctx)(&err)
 
 	ec := ecclient.NewClient(tc, c.MaxBufferMem.Int())
+	if c.HoldBackSpares {
+		ec = ecclient.NewClientWithSpares(tc, c.MaxBufferMem.Int())
+	}
 
 	return segments.NewSegmentRepairer(metainfo, orders, cache, ec, identity, c.Timeout), nil
 }
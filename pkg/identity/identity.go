@@ -468,6 +468,21 @@ func (fi *FullIdentity) RawRestChain() [][]byte {
 	return rawChain
 }
 
+// Chain returns the Identity's certificate chain
+func (pi *PeerIdentity) Chain() []*x509.Certificate {
+	return append([]*x509.Certificate{pi.Leaf, pi.CA}, pi.RestChain...)
+}
+
+// RawChain returns all of the certificate chain as a 2d byte slice
+func (pi *PeerIdentity) RawChain() [][]byte {
+	chain := pi.Chain()
+	rawChain := make([][]byte, len(chain))
+	for i, cert := range chain {
+		rawChain[i] = cert.Raw
+	}
+	return rawChain
+}
+
 // PeerIdentity converts a FullIdentity into a PeerIdentity
 func (fi *FullIdentity) PeerIdentity() *PeerIdentity {
 	return &PeerIdentity{
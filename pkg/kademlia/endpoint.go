@@ -10,17 +10,23 @@ import (
 	"github.com/zeebo/errs"
 	"go.uber.org/zap"
 
+	"storj.io/storj/pkg/identity"
 	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
 )
 
 // EndpointError defines errors class for Endpoint
 var EndpointError = errs.Class("kademlia endpoint error")
 
+// CapacityFunc returns the capacity advertised to the peer.
+type CapacityFunc func(ctx context.Context, peerID storj.NodeID) (*pb.NodeCapacity, error)
+
 // Endpoint implements the kademlia Endpoints
 type Endpoint struct {
 	log          *zap.Logger
 	service      *Kademlia
 	routingTable *RoutingTable
+	capacity     CapacityFunc
 	connected    int32
 }
 
@@ -33,6 +39,10 @@ func NewEndpoint(log *zap.Logger, service *Kademlia, routingTable *RoutingTable)
 	}
 }
 
+// SetCapacityFunc sets the function for advertising a different capacity to each peer.
+// Must be called before the endpoint starts serving requests.
+func (endpoint *Endpoint) SetCapacityFunc(capacity CapacityFunc) { endpoint.capacity = capacity }
+
 // Query is a node to node communication query
 func (endpoint *Endpoint) Query(ctx context.Context, req *pb.QueryRequest) (*pb.QueryResponse, error) {
 	endpoint.service.Queried()
@@ -83,10 +93,22 @@ func (endpoint *Endpoint) Ping(ctx context.Context, req *pb.PingRequest) (*pb.Pi
 func (endpoint *Endpoint) RequestInfo(ctx context.Context, req *pb.InfoRequest) (*pb.InfoResponse, error) {
 	self := endpoint.service.Local()
 
+	capacity := &self.Capacity
+	if endpoint.capacity != nil {
+		if peer, err := identity.PeerIdentityFromContext(ctx); err == nil {
+			peerCapacity, err := endpoint.capacity(ctx, peer.ID)
+			if err != nil {
+				endpoint.log.Warn("unable to get capacity for peer", zap.Stringer("peer", peer.ID), zap.Error(err))
+			} else {
+				capacity = peerCapacity
+			}
+		}
+	}
+
 	return &pb.InfoResponse{
 		Type:     self.Type,
 		Operator: &self.Operator,
-		Capacity: &self.Capacity,
+		Capacity: capacity,
 		Version:  &self.Version,
 	}, nil
 }
@@ -231,6 +231,15 @@ func (k *Kademlia) FetchPeerIdentity(ctx context.Context, nodeID storj.NodeID) (
 	return k.dialer.FetchPeerIdentity(ctx, node)
 }
 
+// FetchPeerIdentityFromNode connects to a node with a known address and returns its peer identity
+func (k *Kademlia) FetchPeerIdentityFromNode(ctx context.Context, node pb.Node) (*identity.PeerIdentity, error) {
+	if !k.lookups.Start() {
+		return nil, context.Canceled
+	}
+	defer k.lookups.Done()
+	return k.dialer.FetchPeerIdentity(ctx, node)
+}
+
 // Ping checks that the provided node is still accessible on the network
 func (k *Kademlia) Ping(ctx context.Context, node pb.Node) (pb.Node, error) {
 	if !k.lookups.Start() {
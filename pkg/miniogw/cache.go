@@ -0,0 +1,351 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package miniogw
+
+import (
+	"bytes"
+	"container/list"
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/internal/memory"
+)
+
+// CacheError is the errs class of read cache errors
+var CacheError = errs.Class("read cache error")
+
+// CacheConfig configures the local disk read cache
+type CacheConfig struct {
+	Dir       string      `help:"directory for caching decrypted object data, stored unencrypted" default:"$CONFDIR/cache"`
+	Size      memory.Size `help:"maximum size of the read cache, 0 disables it" default:"0B"`
+	BlockSize memory.Size `help:"size of the object ranges stored in the read cache" default:"1MiB"`
+}
+
+// ReadCache is a size bounded disk cache of decrypted object ranges.
+//
+// Objects are split into fixed size blocks. Blocks are keyed by bucket, path
+// and modification time of the object, so a replaced object never serves
+// stale data. When the cache is full the least recently used blocks are
+// evicted.
+type ReadCache struct {
+	dir       string
+	capacity  int64
+	blockSize int64
+
+	mu      sync.Mutex
+	used    int64
+	lru     *list.List
+	entries map[string]*list.Element
+}
+
+// cacheEntry is a block stored on disk
+type cacheEntry struct {
+	name string
+	size int64
+}
+
+// NewReadCache creates a read cache in config.Dir and loads the blocks already stored there.
+func NewReadCache(config CacheConfig) (*ReadCache, error) {
+	if config.BlockSize <= 0 {
+		return nil, CacheError.New("invalid block size %v", config.BlockSize)
+	}
+
+	cache := &ReadCache{
+		dir:       config.Dir,
+		capacity:  config.Size.Int64(),
+		blockSize: config.BlockSize.Int64(),
+		lru:       list.New(),
+		entries:   map[string]*list.Element{},
+	}
+
+	if err := os.MkdirAll(cache.dir, 0700); err != nil {
+		return nil, CacheError.Wrap(err)
+	}
+
+	type stored struct {
+		name    string
+		size    int64
+		modTime time.Time
+	}
+	var blocks []stored
+
+	err := filepath.Walk(cache.dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			return nil
+		}
+		if strings.HasPrefix(info.Name(), ".tmp-") {
+			return os.Remove(path)
+		}
+		blocks = append(blocks, stored{info.Name(), info.Size(), info.ModTime()})
+		return nil
+	})
+	if err != nil {
+		return nil, CacheError.Wrap(err)
+	}
+
+	// blocks are touched on every hit, so modification time approximates recency
+	sort.Slice(blocks, func(i, k int) bool {
+		return blocks[i].modTime.After(blocks[k].modTime)
+	})
+	for _, block := range blocks {
+		cache.entries[block.name] = cache.lru.PushBack(&cacheEntry{block.name, block.size})
+		cache.used += block.size
+	}
+
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+	return cache, CacheError.Wrap(cache.evict())
+}
+
+// Used returns the number of bytes stored in the cache.
+func (cache *ReadCache) Used() int64 {
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+	return cache.used
+}
+
+// FetchFunc downloads length bytes of an object starting at offset.
+type FetchFunc func(ctx context.Context, offset, length int64) (io.ReadCloser, error)
+
+// Read writes length bytes starting at offset of the object identified by
+// bucket, path and modified to writer. A length of -1 reads until the end of the
+// object. Blocks that are not cached are downloaded with fetch and stored.
+func (cache *ReadCache) Read(ctx context.Context, bucket, path string, modified time.Time, size, offset, length int64, writer io.Writer, fetch FetchFunc) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if length < 0 || offset+length > size {
+		length = size - offset
+	}
+	if length <= 0 {
+		return nil
+	}
+
+	key := cacheKey(bucket, path, modified)
+	first := offset / cache.blockSize
+	last := (offset + length - 1) / cache.blockSize
+
+	for block := first; block <= last; {
+		data, ok := cache.get(key, block, cache.blockLength(block, size))
+		if ok {
+			if err := cache.writeBlock(writer, block, data, offset, length); err != nil {
+				return err
+			}
+			block++
+			continue
+		}
+
+		// download the whole run of missing blocks at once
+		end := block + 1
+		for end <= last && !cache.has(key, end) {
+			end++
+		}
+
+		start := block * cache.blockSize
+		stop := end * cache.blockSize
+		if stop > size {
+			stop = size
+		}
+
+		reader, err := fetch(ctx, start, stop-start)
+		if err != nil {
+			return err
+		}
+
+		for ; block < end; block++ {
+			data := make([]byte, cache.blockLength(block, size))
+			if _, err := io.ReadFull(reader, data); err != nil {
+				return errs.Combine(err, reader.Close())
+			}
+
+			if err := cache.put(key, block, data); err != nil {
+				return errs.Combine(err, reader.Close())
+			}
+
+			if err := cache.writeBlock(writer, block, data, offset, length); err != nil {
+				return errs.Combine(err, reader.Close())
+			}
+		}
+
+		if err := reader.Close(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// blockLength returns the length of block in an object of size bytes.
+func (cache *ReadCache) blockLength(block, size int64) int64 {
+	if (block+1)*cache.blockSize > size {
+		return size - block*cache.blockSize
+	}
+	return cache.blockSize
+}
+
+// writeBlock writes the part of block data which is inside of the requested range.
+func (cache *ReadCache) writeBlock(writer io.Writer, block int64, data []byte, offset, length int64) error {
+	blockStart := block * cache.blockSize
+	from := offset - blockStart
+	if from < 0 {
+		from = 0
+	}
+	to := offset + length - blockStart
+	if to > int64(len(data)) {
+		to = int64(len(data))
+	}
+	if from >= to {
+		return nil
+	}
+	_, err := io.Copy(writer, bytes.NewReader(data[from:to]))
+	return err
+}
+
+// cacheKey returns the key of the object, which changes whenever the object is replaced.
+func cacheKey(bucket, path string, modified time.Time) string {
+	var nanos [8]byte
+	binary.BigEndian.PutUint64(nanos[:], uint64(modified.UnixNano()))
+
+	hash := sha256.New()
+	_, _ = hash.Write([]byte(bucket))
+	_, _ = hash.Write([]byte{0})
+	_, _ = hash.Write([]byte(path))
+	_, _ = hash.Write([]byte{0})
+	_, _ = hash.Write(nanos[:])
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+func blockName(key string, block int64) string {
+	return key + "-" + strconv.FormatInt(block, 10)
+}
+
+func (cache *ReadCache) blockPath(name string) string {
+	return filepath.Join(cache.dir, name[:2], name)
+}
+
+func (cache *ReadCache) has(key string, block int64) bool {
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+	_, ok := cache.entries[blockName(key, block)]
+	return ok
+}
+
+// get returns the cached block and marks it as recently used. A block which
+// doesn't have the expected length, e.g. because it was truncated by a crash,
+// is dropped from the cache.
+func (cache *ReadCache) get(key string, block int64, expected int64) ([]byte, bool) {
+	name := blockName(key, block)
+
+	cache.mu.Lock()
+	element, ok := cache.entries[name]
+	if ok {
+		cache.lru.MoveToFront(element)
+	}
+	cache.mu.Unlock()
+	if !ok {
+		return nil, false
+	}
+
+	path := cache.blockPath(name)
+	data, err := ioutil.ReadFile(path)
+	if err != nil || int64(len(data)) != expected {
+		cache.remove(name)
+		return nil, false
+	}
+
+	now := time.Now()
+	_ = os.Chtimes(path, now, now)
+	return data, true
+}
+
+// put stores a block and evicts old blocks when the cache is over capacity.
+func (cache *ReadCache) put(key string, block int64, data []byte) error {
+	if int64(len(data)) > cache.capacity {
+		return nil
+	}
+
+	name := blockName(key, block)
+	path := cache.blockPath(name)
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return CacheError.Wrap(err)
+	}
+
+	tmp, err := ioutil.TempFile(filepath.Dir(path), ".tmp-")
+	if err != nil {
+		return CacheError.Wrap(err)
+	}
+	_, err = tmp.Write(data)
+	if err == nil {
+		err = tmp.Sync()
+	}
+	err = errs.Combine(err, tmp.Close())
+	if err == nil {
+		err = os.Rename(tmp.Name(), path)
+	}
+	if err != nil {
+		return CacheError.Wrap(errs.Combine(err, os.Remove(tmp.Name())))
+	}
+
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	if element, ok := cache.entries[name]; ok {
+		entry := element.Value.(*cacheEntry)
+		cache.used -= entry.size
+		cache.lru.Remove(element)
+	}
+	cache.entries[name] = cache.lru.PushFront(&cacheEntry{name, int64(len(data))})
+	cache.used += int64(len(data))
+
+	return CacheError.Wrap(cache.evict())
+}
+
+func (cache *ReadCache) remove(name string) {
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	if element, ok := cache.entries[name]; ok {
+		cache.used -= element.Value.(*cacheEntry).size
+		cache.lru.Remove(element)
+		delete(cache.entries, name)
+	}
+	_ = os.Remove(cache.blockPath(name))
+}
+
+// evict removes least recently used blocks until the cache fits its
+// capacity. cache.mu must be held.
+func (cache *ReadCache) evict() error {
+	var group errs.Group
+	for cache.used > cache.capacity {
+		element := cache.lru.Back()
+		if element == nil {
+			break
+		}
+		entry := element.Value.(*cacheEntry)
+		cache.lru.Remove(element)
+		delete(cache.entries, entry.name)
+		cache.used -= entry.size
+
+		err := os.Remove(cache.blockPath(entry.name))
+		if err != nil && !os.IsNotExist(err) {
+			group.Add(err)
+		}
+	}
+	return group.Err()
+}
@@ -0,0 +1,88 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package miniogw
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"io/ioutil"
+	"math/rand"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/internal/memory"
+	"storj.io/storj/internal/testcontext"
+)
+
+func TestReadCache(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	config := CacheConfig{
+		Dir:       ctx.Dir("cache"),
+		Size:      4 * memory.KiB,
+		BlockSize: 1 * memory.KiB,
+	}
+
+	cache, err := NewReadCache(config)
+	require.NoError(t, err)
+
+	data := make([]byte, 3*memory.KiB.Int()+100)
+	_, err = rand.Read(data)
+	require.NoError(t, err)
+
+	modified := time.Now()
+
+	var fetched []int64
+	fetch := func(ctx context.Context, offset, length int64) (io.ReadCloser, error) {
+		fetched = append(fetched, offset, length)
+		return ioutil.NopCloser(bytes.NewReader(data[offset : offset+length])), nil
+	}
+
+	read := func(modified time.Time, offset, length int64) []byte {
+		var buf bytes.Buffer
+		err := cache.Read(ctx, "bucket", "path", modified, int64(len(data)), offset, length, &buf, fetch)
+		require.NoError(t, err)
+		return buf.Bytes()
+	}
+
+	// a ranged read downloads only the blocks it covers
+	assert.Equal(t, data[1500:2100], read(modified, 1500, 600))
+	assert.Equal(t, []int64{1024, 2048}, fetched)
+
+	// cached blocks are served from disk
+	fetched = nil
+	assert.Equal(t, data[1100:2000], read(modified, 1100, 900))
+	assert.Empty(t, fetched)
+
+	// partially cached reads download only the missing blocks
+	assert.Equal(t, data, read(modified, 0, -1))
+	assert.Equal(t, []int64{0, 1024, 3072, 100}, fetched)
+	assert.Equal(t, int64(len(data)), cache.Used())
+
+	// a modified object does not use the old blocks
+	fetched = nil
+	newModified := modified.Add(time.Second)
+	assert.Equal(t, data[:10], read(newModified, 0, 10))
+	assert.Equal(t, []int64{0, 1024}, fetched)
+
+	// truncated blocks are dropped and downloaded again
+	fetched = nil
+	require.NoError(t, os.Truncate(cache.blockPath(blockName(cacheKey("bucket", "path", newModified), 0)), 10))
+	assert.Equal(t, data[:10], read(newModified, 0, 10))
+	assert.Equal(t, []int64{0, 1024}, fetched)
+
+	// least recently used blocks were evicted to stay within capacity
+	assert.True(t, cache.Used() <= config.Size.Int64())
+
+	// the cache is reloaded from disk
+	reloaded, err := NewReadCache(config)
+	require.NoError(t, err)
+	assert.Equal(t, cache.Used(), reloaded.Used())
+}
@@ -7,12 +7,15 @@ import (
 	"context"
 	"encoding/hex"
 	"io"
+	"io/ioutil"
 	"strings"
 
 	minio "github.com/minio/minio/cmd"
 	"github.com/minio/minio/pkg/auth"
+	"github.com/minio/minio/pkg/event"
 	"github.com/minio/minio/pkg/hash"
 	"github.com/zeebo/errs"
+	"go.uber.org/zap"
 	monkit "gopkg.in/spacemonkeygo/monkit.v2"
 
 	"storj.io/storj/internal/memory"
@@ -51,6 +54,18 @@ type Gateway struct {
 	redundancy  storj.RedundancyScheme
 	segmentSize memory.Size
 	multipart   *MultipartUploads
+	notifier    *Notifier
+	cache       *ReadCache
+}
+
+// SetReadCache enables caching of downloaded object ranges in cache
+func (gateway *Gateway) SetReadCache(cache *ReadCache) {
+	gateway.cache = cache
+}
+
+// SetNotifier enables bucket event notifications through notifier
+func (gateway *Gateway) SetNotifier(notifier *Notifier) {
+	gateway.notifier = notifier
 }
 
 // Name implements cmd.Gateway
@@ -73,6 +88,23 @@ type gatewayLayer struct {
 	gateway *Gateway
 }
 
+// IsNotificationSupported implements minio.ObjectLayer
+func (layer *gatewayLayer) IsNotificationSupported() bool {
+	return layer.gateway.notifier != nil
+}
+
+// notify queues a bucket event, the operation has already succeeded so
+// failures are only logged.
+func (layer *gatewayLayer) notify(ctx context.Context, name event.Name, bucketName string, info minio.ObjectInfo) {
+	if layer.gateway.notifier == nil {
+		return
+	}
+	err := layer.gateway.notifier.Notify(ctx, name, bucketName, info)
+	if err != nil {
+		layer.gateway.notifier.log.Error("failed to queue notification", zap.String("bucket", bucketName), zap.String("object", info.Name), zap.Error(err))
+	}
+}
+
 func (layer *gatewayLayer) DeleteBucket(ctx context.Context, bucketName string) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
@@ -86,6 +118,9 @@ func (layer *gatewayLayer) DeleteBucket(ctx context.Context, bucketName string)
 	}
 
 	err = layer.gateway.project.DeleteBucket(ctx, bucketName)
+	if err == nil && layer.gateway.notifier != nil {
+		err = layer.gateway.notifier.RemoveBucket(bucketName)
+	}
 
 	return convertError(err, bucketName, "")
 }
@@ -108,6 +143,10 @@ func (layer *gatewayLayer) bucketEmpty(ctx context.Context, bucketName string) (
 func (layer *gatewayLayer) DeleteObject(ctx context.Context, bucketName, objectPath string) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
+	if bucketName == minioMetaBucket && layer.gateway.notifier != nil {
+		return layer.gateway.notifier.writeConfig(objectPath, nil)
+	}
+
 	bucket, err := layer.gateway.project.OpenBucket(ctx, bucketName, &uplink.EncryptionAccess{Key: *layer.gateway.rootEncKey})
 	if err != nil {
 		return convertError(err, bucketName, "")
@@ -115,8 +154,12 @@ func (layer *gatewayLayer) DeleteObject(ctx context.Context, bucketName, objectP
 	defer func() { err = errs.Combine(err, bucket.Close()) }()
 
 	err = bucket.DeleteObject(ctx, objectPath)
+	if err != nil {
+		return convertError(err, bucketName, objectPath)
+	}
 
-	return convertError(err, bucketName, objectPath)
+	layer.notify(ctx, event.ObjectRemovedDelete, bucketName, minio.ObjectInfo{Name: objectPath})
+	return nil
 }
 
 func (layer *gatewayLayer) GetBucketInfo(ctx context.Context, bucketName string) (bucketInfo minio.BucketInfo, err error) {
@@ -134,6 +177,14 @@ func (layer *gatewayLayer) GetBucketInfo(ctx context.Context, bucketName string)
 func (layer *gatewayLayer) GetObject(ctx context.Context, bucketName, objectPath string, startOffset int64, length int64, writer io.Writer, etag string) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
+	if bucketName == minioMetaBucket && layer.gateway.notifier != nil {
+		data, err := layer.gateway.notifier.readConfig(objectPath)
+		if err != nil {
+			return err
+		}
+		return writeRange(writer, data, startOffset, length)
+	}
+
 	bucket, err := layer.gateway.project.OpenBucket(ctx, bucketName, &uplink.EncryptionAccess{Key: *layer.gateway.rootEncKey})
 	if err != nil {
 		return convertError(err, bucketName, "")
@@ -154,6 +205,14 @@ func (layer *gatewayLayer) GetObject(ctx context.Context, bucketName, objectPath
 		}
 	}
 
+	if layer.gateway.cache != nil {
+		err = layer.gateway.cache.Read(ctx, bucketName, objectPath, object.Meta.Modified, object.Meta.Size, startOffset, length, writer,
+			func(ctx context.Context, offset, length int64) (io.ReadCloser, error) {
+				return object.DownloadRange(ctx, offset, length)
+			})
+		return convertError(err, bucketName, objectPath)
+	}
+
 	reader, err := object.DownloadRange(ctx, startOffset, length)
 	if err != nil {
 		return convertError(err, bucketName, objectPath)
@@ -416,7 +475,13 @@ func (layer *gatewayLayer) CopyObject(ctx context.Context, srcBucket, srcObject,
 	opts.Volatile.EncryptionParameters = object.Meta.Volatile.EncryptionParameters
 	opts.Volatile.RedundancyScheme = object.Meta.Volatile.RedundancyScheme
 
-	return layer.putObject(ctx, destBucket, destObject, reader, &opts)
+	objInfo, err = layer.putObject(ctx, destBucket, destObject, reader, &opts)
+	if err != nil {
+		return objInfo, err
+	}
+
+	layer.notify(ctx, event.ObjectCreatedCopy, destBucket, objInfo)
+	return objInfo, nil
 }
 
 func (layer *gatewayLayer) putObject(ctx context.Context, bucketName, objectPath string, reader io.Reader, opts *uplink.UploadOptions) (objInfo minio.ObjectInfo, err error) {
@@ -466,6 +531,18 @@ func upload(ctx context.Context, streams streams.Store, mutableObject storj.Muta
 func (layer *gatewayLayer) PutObject(ctx context.Context, bucketName, objectPath string, data *hash.Reader, metadata map[string]string) (objInfo minio.ObjectInfo, err error) {
 	defer mon.Task()(&ctx)(&err)
 
+	if bucketName == minioMetaBucket && layer.gateway.notifier != nil {
+		config, err := ioutil.ReadAll(data)
+		if err != nil {
+			return minio.ObjectInfo{}, err
+		}
+		err = layer.gateway.notifier.writeConfig(objectPath, config)
+		if err != nil {
+			return minio.ObjectInfo{}, err
+		}
+		return minio.ObjectInfo{Bucket: bucketName, Name: objectPath, Size: int64(len(config))}, nil
+	}
+
 	contentType := metadata["content-type"]
 	delete(metadata, "content-type")
 
@@ -474,7 +551,13 @@ func (layer *gatewayLayer) PutObject(ctx context.Context, bucketName, objectPath
 		Metadata:    metadata,
 	}
 
-	return layer.putObject(ctx, bucketName, objectPath, data, &opts)
+	objInfo, err = layer.putObject(ctx, bucketName, objectPath, data, &opts)
+	if err != nil {
+		return objInfo, err
+	}
+
+	layer.notify(ctx, event.ObjectCreatedPut, bucketName, objInfo)
+	return objInfo, nil
 }
 
 func (layer *gatewayLayer) Shutdown(ctx context.Context) (err error) {
@@ -13,6 +13,7 @@ import (
 	"time"
 
 	minio "github.com/minio/minio/cmd"
+	"github.com/minio/minio/pkg/event"
 	"github.com/minio/minio/pkg/hash"
 
 	"storj.io/storj/lib/uplink"
@@ -120,8 +121,13 @@ func (layer *gatewayLayer) CompleteMultipartUpload(ctx context.Context, bucket,
 	upload.Stream.Close()
 	// wait for completion
 	result := <-upload.Done
+	if result.Error != nil {
+		return result.Info, result.Error
+	}
+
+	layer.notify(ctx, event.ObjectCreatedCompleteMultipartUpload, bucket, result.Info)
 	// return the final info
-	return result.Info, result.Error
+	return result.Info, nil
 }
 
 func (layer *gatewayLayer) ListObjectParts(ctx context.Context, bucket, object, uploadID string, partNumberMarker int, maxParts int) (result minio.ListPartsInfo, err error) {
@@ -0,0 +1,590 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package miniogw
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	minio "github.com/minio/minio/cmd"
+	"github.com/minio/minio/pkg/event"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/storj/internal/sync2"
+)
+
+// minioMetaBucket is the bucket minio uses to store bucket configuration
+// (such as notification.xml) through the object layer.
+const minioMetaBucket = ".minio.sys"
+
+// notificationConfigFile is the name of the per-bucket notification configuration.
+const notificationConfigFile = "notification.xml"
+
+// NotifyError is the errs class of bucket notification errors
+var NotifyError = errs.Class("notification error")
+
+// NotificationConfig configures bucket event notifications
+type NotificationConfig struct {
+	Webhooks      string        `help:"comma-separated list of webhook notification targets in the form id=url" default:""`
+	Dir           string        `help:"directory for bucket notification configurations and the delivery queue" default:"$CONFDIR/notify"`
+	RetryInterval time.Duration `help:"how frequently undelivered notifications are retried" default:"30s"`
+}
+
+// Notifier delivers bucket events to webhook targets.
+//
+// Bucket notification configurations are set with the S3 notification
+// configuration API. Events are written to an on-disk queue before they are
+// delivered and are removed only after the target acknowledged them, which
+// gives at-least-once delivery.
+type Notifier struct {
+	log     *zap.Logger
+	dir     string
+	targets *event.TargetList
+	webhook map[event.TargetID]*webhookTarget
+
+	mu    sync.Mutex
+	rules map[string]event.RulesMap
+
+	// sink accepts the events minio sends to its own copy of the
+	// targets, see RegisterWithMinio.
+	sink     net.Listener
+	sinkDone chan struct{}
+
+	Loop sync2.Cycle
+}
+
+// NewNotifier creates a notifier from config and loads the stored bucket configurations.
+func NewNotifier(log *zap.Logger, config NotificationConfig) (*Notifier, error) {
+	notifier := &Notifier{
+		log:     log,
+		dir:     config.Dir,
+		targets: event.NewTargetList(),
+		webhook: map[event.TargetID]*webhookTarget{},
+		rules:   map[string]event.RulesMap{},
+		Loop:    *sync2.NewCycle(config.RetryInterval),
+	}
+
+	for _, entry := range strings.Split(config.Webhooks, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		parts := strings.SplitN(entry, "=", 2)
+		if len(parts) != 2 || parts[0] == "" {
+			return nil, NotifyError.New("invalid webhook target %q", entry)
+		}
+		endpoint, err := url.Parse(parts[1])
+		if err != nil {
+			return nil, NotifyError.Wrap(err)
+		}
+
+		target := newWebhookTarget(parts[0], endpoint.String())
+		if err := notifier.targets.Add(target); err != nil {
+			return nil, NotifyError.Wrap(err)
+		}
+		notifier.webhook[target.ID()] = target
+	}
+
+	if err := os.MkdirAll(notifier.queueDir(), 0700); err != nil {
+		return nil, NotifyError.Wrap(err)
+	}
+
+	if err := notifier.loadRules(); err != nil {
+		return nil, err
+	}
+
+	return notifier, nil
+}
+
+// Targets returns the ids of the configured webhook targets.
+func (notifier *Notifier) Targets() []event.TargetID {
+	return notifier.targets.List()
+}
+
+// Run delivers queued events until ctx is canceled.
+func (notifier *Notifier) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return notifier.Loop.Run(ctx, func(ctx context.Context) error {
+		err := notifier.Deliver(ctx)
+		if err != nil {
+			notifier.log.Error("notification delivery failed", zap.Error(err))
+		}
+		return nil
+	})
+}
+
+// Close stops delivery and the minio sink.
+func (notifier *Notifier) Close() error {
+	notifier.Loop.Close()
+	if notifier.sink != nil {
+		err := notifier.sink.Close()
+		<-notifier.sinkDone
+		return err
+	}
+	return nil
+}
+
+// RegisterWithMinio adds the webhook targets to the minio server configuration in minioDir.
+//
+// Minio validates the ARNs of a notification configuration against its own
+// target list, so every target must be known to it. Minio's copies point at a
+// local endpoint which discards the events; delivery happens through the queue.
+func (notifier *Notifier) RegisterWithMinio(minioDir string) (err error) {
+	if len(notifier.webhook) == 0 {
+		return nil
+	}
+
+	if notifier.sink == nil {
+		notifier.sink, err = net.Listen("tcp", "127.0.0.1:0")
+		if err != nil {
+			return NotifyError.Wrap(err)
+		}
+		notifier.sinkDone = make(chan struct{})
+		go func() {
+			defer close(notifier.sinkDone)
+			_ = http.Serve(notifier.sink, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_, _ = io.Copy(ioutil.Discard, r.Body)
+				w.WriteHeader(http.StatusNoContent)
+			}))
+		}()
+	}
+
+	configPath := filepath.Join(minioDir, "config.json")
+	config := map[string]interface{}{}
+
+	data, err := ioutil.ReadFile(configPath)
+	switch {
+	case err == nil:
+		if err := json.Unmarshal(data, &config); err != nil {
+			return NotifyError.Wrap(err)
+		}
+	case os.IsNotExist(err):
+		// minio 2018-05 uses configuration version 23
+		config["version"] = "23"
+	default:
+		return NotifyError.Wrap(err)
+	}
+
+	notify, _ := config["notify"].(map[string]interface{})
+	if notify == nil {
+		notify = map[string]interface{}{}
+		config["notify"] = notify
+	}
+	webhooks, _ := notify["webhook"].(map[string]interface{})
+	if webhooks == nil {
+		webhooks = map[string]interface{}{}
+		notify["webhook"] = webhooks
+	}
+	for id := range notifier.webhook {
+		webhooks[id.ID] = map[string]interface{}{
+			"enable":   true,
+			"endpoint": "http://" + notifier.sink.Addr().String() + "/",
+		}
+	}
+
+	data, err = json.MarshalIndent(config, "", "\t")
+	if err != nil {
+		return NotifyError.Wrap(err)
+	}
+	if err := os.MkdirAll(minioDir, 0700); err != nil {
+		return NotifyError.Wrap(err)
+	}
+	return NotifyError.Wrap(writeFileAtomic(configPath, data))
+}
+
+// Notify queues an event for every target whose rules for bucket match.
+func (notifier *Notifier) Notify(ctx context.Context, name event.Name, bucket string, info minio.ObjectInfo) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	notifier.mu.Lock()
+	targets := notifier.rules[bucket].Match(name, info.Name)
+	notifier.mu.Unlock()
+
+	if len(targets) == 0 {
+		return nil
+	}
+
+	now := time.Now().UTC()
+	uniqueID := fmt.Sprintf("%X", now.UnixNano())
+
+	ev := event.Event{
+		EventVersion: "2.0",
+		EventSource:  "storj:s3",
+		EventTime:    now.Format(event.AMZTimeFormat),
+		EventName:    name,
+		ResponseElements: map[string]string{
+			"x-amz-request-id": uniqueID,
+		},
+		S3: event.Metadata{
+			SchemaVersion:   "1.0",
+			ConfigurationID: "Config",
+			Bucket: event.Bucket{
+				Name: bucket,
+				ARN:  "arn:aws:s3:::" + bucket,
+			},
+			Object: event.Object{
+				Key:       url.QueryEscape(info.Name),
+				VersionID: "1",
+				Sequencer: uniqueID,
+			},
+		},
+	}
+	if name != event.ObjectRemovedDelete {
+		ev.S3.Object.ETag = info.ETag
+		ev.S3.Object.Size = info.Size
+		ev.S3.Object.ContentType = info.ContentType
+		ev.S3.Object.UserMetadata = info.UserDefined
+	}
+
+	var group errs.Group
+	for target := range targets {
+		group.Add(notifier.enqueue(queuedEvent{Target: target, Event: ev}))
+	}
+	return NotifyError.Wrap(group.Err())
+}
+
+// Deliver sends all queued events, oldest first. Events which fail to be
+// delivered stay in the queue for the next attempt.
+func (notifier *Notifier) Deliver(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	names, err := notifier.queued()
+	if err != nil {
+		return err
+	}
+
+	failed := map[event.TargetID]bool{}
+	for _, name := range names {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		path := filepath.Join(notifier.queueDir(), name)
+		data, err := ioutil.ReadFile(path)
+		if err != nil {
+			return NotifyError.Wrap(err)
+		}
+
+		var queued queuedEvent
+		if err := json.Unmarshal(data, &queued); err != nil {
+			notifier.log.Error("dropping corrupted notification", zap.String("file", name), zap.Error(err))
+			if err := os.Remove(path); err != nil {
+				return NotifyError.Wrap(err)
+			}
+			continue
+		}
+
+		// keep the per-target order by not sending newer events after a failure
+		if failed[queued.Target] {
+			continue
+		}
+
+		target, ok := notifier.webhook[queued.Target]
+		if !ok {
+			notifier.log.Warn("dropping notification for unknown target", zap.Stringer("target", queued.Target))
+			if err := os.Remove(path); err != nil {
+				return NotifyError.Wrap(err)
+			}
+			continue
+		}
+
+		if err := target.Send(queued.Event); err != nil {
+			notifier.log.Debug("notification delivery failed", zap.Stringer("target", queued.Target), zap.Error(err))
+			failed[queued.Target] = true
+			continue
+		}
+
+		if err := os.Remove(path); err != nil {
+			return NotifyError.Wrap(err)
+		}
+	}
+
+	return nil
+}
+
+// Pending returns the number of events waiting to be delivered.
+func (notifier *Notifier) Pending() (int, error) {
+	names, err := notifier.queued()
+	return len(names), err
+}
+
+// queuedEvent is an entry of the delivery queue.
+type queuedEvent struct {
+	Target event.TargetID `json:"target"`
+	Event  event.Event    `json:"event"`
+}
+
+func (notifier *Notifier) queueDir() string {
+	return filepath.Join(notifier.dir, "queue")
+}
+
+// bucketDir returns the directory holding the configuration of bucket.
+// Invalid bucket names are rejected, so that they cannot escape the buckets directory.
+func (notifier *Notifier) bucketDir(bucket string) (string, error) {
+	if !minio.IsValidBucketName(bucket) || bucket != filepath.Base(bucket) {
+		return "", minio.BucketNameInvalid{Bucket: bucket}
+	}
+	return filepath.Join(notifier.dir, "buckets", bucket), nil
+}
+
+// enqueue durably writes the event to the queue directory.
+func (notifier *Notifier) enqueue(queued queuedEvent) error {
+	data, err := json.Marshal(queued)
+	if err != nil {
+		return err
+	}
+
+	var suffix [4]byte
+	if _, err := rand.Read(suffix[:]); err != nil {
+		return err
+	}
+
+	// names sort in the order the events were queued
+	name := fmt.Sprintf("%020d-%s.json", time.Now().UnixNano(), hex.EncodeToString(suffix[:]))
+	return writeFileAtomic(filepath.Join(notifier.queueDir(), name), data)
+}
+
+// queued returns the names of the queued events, oldest first.
+func (notifier *Notifier) queued() ([]string, error) {
+	infos, err := ioutil.ReadDir(notifier.queueDir())
+	if err != nil {
+		return nil, NotifyError.Wrap(err)
+	}
+
+	var names []string
+	for _, info := range infos {
+		if info.IsDir() || filepath.Ext(info.Name()) != ".json" {
+			continue
+		}
+		names = append(names, info.Name())
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+// loadRules loads all stored bucket notification configurations.
+func (notifier *Notifier) loadRules() error {
+	infos, err := ioutil.ReadDir(filepath.Join(notifier.dir, "buckets"))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return NotifyError.Wrap(err)
+	}
+
+	for _, info := range infos {
+		if !info.IsDir() {
+			continue
+		}
+		dir, err := notifier.bucketDir(info.Name())
+		if err != nil {
+			notifier.log.Error("invalid bucket configuration directory", zap.String("bucket", info.Name()))
+			continue
+		}
+		data, err := ioutil.ReadFile(filepath.Join(dir, notificationConfigFile))
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return NotifyError.Wrap(err)
+		}
+		if err := notifier.setRules(info.Name(), data); err != nil {
+			notifier.log.Error("invalid notification configuration", zap.String("bucket", info.Name()), zap.Error(err))
+		}
+	}
+	return nil
+}
+
+func (notifier *Notifier) setRules(bucket string, data []byte) error {
+	var rules event.RulesMap
+	if len(data) > 0 {
+		config, err := event.ParseConfig(bytes.NewReader(data), "", notifier.targets)
+		if err != nil {
+			return NotifyError.Wrap(err)
+		}
+		rules = config.ToRulesMap()
+	}
+
+	notifier.mu.Lock()
+	defer notifier.mu.Unlock()
+
+	if len(rules) == 0 {
+		delete(notifier.rules, bucket)
+	} else {
+		notifier.rules[bucket] = rules
+	}
+	return nil
+}
+
+// parseConfigPath splits a minio meta bucket path of the form
+// buckets/<bucket>/notification.xml. ok is false for any other path.
+func parseConfigPath(path string) (bucket string, ok bool) {
+	parts := strings.Split(path, "/")
+	if len(parts) != 3 || parts[0] != "buckets" || parts[2] != notificationConfigFile || parts[1] == "" {
+		return "", false
+	}
+	return parts[1], true
+}
+
+// readConfig returns the stored notification configuration of a meta bucket path.
+func (notifier *Notifier) readConfig(path string) ([]byte, error) {
+	bucket, ok := parseConfigPath(path)
+	if !ok {
+		return nil, minio.ObjectNotFound{Bucket: minioMetaBucket, Object: path}
+	}
+
+	dir, err := notifier.bucketDir(bucket)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := ioutil.ReadFile(filepath.Join(dir, notificationConfigFile))
+	if os.IsNotExist(err) {
+		return nil, minio.ObjectNotFound{Bucket: minioMetaBucket, Object: path}
+	}
+	return data, err
+}
+
+// writeConfig stores the notification configuration of a meta bucket path and applies its rules.
+func (notifier *Notifier) writeConfig(path string, data []byte) error {
+	bucket, ok := parseConfigPath(path)
+	if !ok {
+		return minio.BucketNotFound{Bucket: minioMetaBucket}
+	}
+
+	dir, err := notifier.bucketDir(bucket)
+	if err != nil {
+		return err
+	}
+
+	// validate before storing, so that an invalid configuration is never loaded
+	if err := notifier.setRules(bucket, data); err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return NotifyError.Wrap(err)
+	}
+	return NotifyError.Wrap(writeFileAtomic(filepath.Join(dir, notificationConfigFile), data))
+}
+
+// RemoveBucket drops the notification configuration of bucket.
+func (notifier *Notifier) RemoveBucket(bucket string) error {
+	dir, err := notifier.bucketDir(bucket)
+	if err != nil {
+		return err
+	}
+
+	notifier.mu.Lock()
+	delete(notifier.rules, bucket)
+	notifier.mu.Unlock()
+
+	return NotifyError.Wrap(os.RemoveAll(dir))
+}
+
+// writeFileAtomic writes data to a temporary file, syncs it and renames it to path.
+func writeFileAtomic(path string, data []byte) (err error) {
+	tmp, err := ioutil.TempFile(filepath.Dir(path), ".tmp-")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			_ = os.Remove(tmp.Name())
+		}
+	}()
+
+	if _, err := tmp.Write(data); err != nil {
+		return errs.Combine(err, tmp.Close())
+	}
+	if err := tmp.Sync(); err != nil {
+		return errs.Combine(err, tmp.Close())
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+// webhookTarget posts events to an HTTP endpoint in the same format as minio's webhook target.
+type webhookTarget struct {
+	id       event.TargetID
+	endpoint string
+	client   *http.Client
+}
+
+func newWebhookTarget(id, endpoint string) *webhookTarget {
+	return &webhookTarget{
+		id:       event.TargetID{ID: id, Name: "webhook"},
+		endpoint: endpoint,
+		client:   &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+// ID implements event.Target
+func (target *webhookTarget) ID() event.TargetID { return target.id }
+
+// Close implements event.Target
+func (target *webhookTarget) Close() error { return nil }
+
+// Send implements event.Target
+func (target *webhookTarget) Send(ev event.Event) error {
+	key, err := url.QueryUnescape(ev.S3.Object.Key)
+	if err != nil {
+		return err
+	}
+
+	data, err := json.Marshal(event.Log{
+		EventName: ev.EventName,
+		Key:       ev.S3.Bucket.Name + "/" + key,
+		Records:   []event.Event{ev},
+	})
+	if err != nil {
+		return err
+	}
+
+	resp, err := target.client.Post(target.endpoint, "application/json", bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	_, _ = io.Copy(ioutil.Discard, resp.Body)
+	if err := resp.Body.Close(); err != nil {
+		return err
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("sending event failed with %v", resp.Status)
+	}
+	return nil
+}
+
+// writeRange writes the requested range of a meta bucket object to writer.
+func writeRange(writer io.Writer, data []byte, startOffset, length int64) error {
+	if startOffset < 0 || startOffset > int64(len(data)) {
+		return minio.InvalidRange{OffsetBegin: startOffset, OffsetEnd: startOffset + length, ResourceSize: int64(len(data))}
+	}
+	data = data[startOffset:]
+	if length >= 0 && length < int64(len(data)) {
+		data = data[:length]
+	}
+	_, err := writer.Write(data)
+	return err
+}
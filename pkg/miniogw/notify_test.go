@@ -0,0 +1,125 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package miniogw
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	minio "github.com/minio/minio/cmd"
+	"github.com/minio/minio/pkg/event"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/storj/internal/testcontext"
+)
+
+const testNotificationConfig = `<NotificationConfiguration>
+	<QueueConfiguration>
+		<Queue>arn:minio:sqs::1:webhook</Queue>
+		<Event>s3:ObjectCreated:*</Event>
+		<Filter><S3Key><FilterRule><Name>prefix</Name><Value>images/</Value></FilterRule></S3Key></Filter>
+	</QueueConfiguration>
+</NotificationConfiguration>`
+
+func TestNotifier(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	var mu sync.Mutex
+	var failing bool
+	var received []event.Log
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		if failing {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		var log event.Log
+		if err := json.NewDecoder(r.Body).Decode(&log); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		received = append(received, log)
+	}))
+	defer server.Close()
+
+	config := NotificationConfig{
+		Webhooks:      "1=" + server.URL,
+		Dir:           ctx.Dir("notify"),
+		RetryInterval: time.Hour,
+	}
+
+	notifier, err := NewNotifier(zaptest.NewLogger(t), config)
+	require.NoError(t, err)
+
+	// unknown targets are rejected
+	err = notifier.writeConfig("buckets/bucket/notification.xml", []byte(`<NotificationConfiguration><QueueConfiguration><Queue>arn:minio:sqs::2:webhook</Queue><Event>s3:ObjectCreated:*</Event></QueueConfiguration></NotificationConfiguration>`))
+	assert.Error(t, err)
+
+	// bucket names cannot escape the configuration directory
+	err = notifier.writeConfig("buckets/../notification.xml", []byte(testNotificationConfig))
+	assert.Equal(t, minio.BucketNameInvalid{Bucket: ".."}, err)
+	assert.Error(t, notifier.RemoveBucket(".."))
+
+	err = notifier.writeConfig("buckets/bucket/notification.xml", []byte(testNotificationConfig))
+	require.NoError(t, err)
+
+	// filtered out by prefix and event name
+	require.NoError(t, notifier.Notify(ctx, event.ObjectCreatedPut, "bucket", minio.ObjectInfo{Name: "docs/a"}))
+	require.NoError(t, notifier.Notify(ctx, event.ObjectRemovedDelete, "bucket", minio.ObjectInfo{Name: "images/a"}))
+	require.NoError(t, notifier.Notify(ctx, event.ObjectCreatedPut, "other", minio.ObjectInfo{Name: "images/a"}))
+	pending, err := notifier.Pending()
+	require.NoError(t, err)
+	assert.Equal(t, 0, pending)
+
+	mu.Lock()
+	failing = true
+	mu.Unlock()
+
+	require.NoError(t, notifier.Notify(ctx, event.ObjectCreatedPut, "bucket", minio.ObjectInfo{Name: "images/a", Size: 10}))
+	require.NoError(t, notifier.Notify(ctx, event.ObjectCreatedCopy, "bucket", minio.ObjectInfo{Name: "images/b", Size: 20}))
+
+	// failed deliveries stay queued
+	require.NoError(t, notifier.Deliver(ctx))
+	pending, err = notifier.Pending()
+	require.NoError(t, err)
+	assert.Equal(t, 2, pending)
+
+	// configuration and queue survive a restart
+	notifier, err = NewNotifier(zaptest.NewLogger(t), config)
+	require.NoError(t, err)
+
+	mu.Lock()
+	failing = false
+	mu.Unlock()
+
+	require.NoError(t, notifier.Deliver(ctx))
+	pending, err = notifier.Pending()
+	require.NoError(t, err)
+	assert.Equal(t, 0, pending)
+
+	mu.Lock()
+	defer mu.Unlock()
+	require.Len(t, received, 2)
+	assert.Equal(t, "bucket/images/a", received[0].Key)
+	assert.Equal(t, event.ObjectCreatedPut, received[0].EventName)
+	assert.Equal(t, int64(10), received[0].Records[0].S3.Object.Size)
+	assert.Equal(t, "bucket/images/b", received[1].Key)
+	assert.Equal(t, event.ObjectCreatedCopy, received[1].EventName)
+
+	data, err := notifier.readConfig("buckets/bucket/notification.xml")
+	require.NoError(t, err)
+	assert.Equal(t, testNotificationConfig, string(data))
+
+	require.NoError(t, notifier.RemoveBucket("bucket"))
+	_, err = notifier.readConfig("buckets/bucket/notification.xml")
+	assert.Equal(t, minio.ObjectNotFound{Bucket: minioMetaBucket, Object: "buckets/bucket/notification.xml"}, err)
+}
@@ -22,6 +22,9 @@ var ErrEmptyNode = errs.New("empty node ID")
 // ErrNodeNotFound is returned if a node does not exist in database
 var ErrNodeNotFound = errs.Class("node not found")
 
+// ErrNodeOffline is returned if a node is offline
+var ErrNodeOffline = errs.Class("node is offline")
+
 // ErrBucketNotFound is returned if a bucket is unable to be found in the routing table
 var ErrBucketNotFound = errs.New("bucket not found")
 
@@ -45,16 +48,32 @@ type DB interface {
 	// Paginate will page through the database nodes
 	Paginate(ctx context.Context, offset int64, limit int) ([]*NodeDossier, bool, error)
 
-	// CreateStats initializes the stats for node.
+	// CreateStats initializes the stats for node, the reputation of initial is stored as is, see InitialReputation.
 	CreateStats(ctx context.Context, nodeID storj.NodeID, initial *NodeStats) (stats *NodeStats, err error)
-	// Update updates node address
-	UpdateAddress(ctx context.Context, value *pb.Node) error
+	// UpdateAddress updates node address and the network it was resolved to,
+	// an empty lastNet keeps the network stored for the node. A new node starts
+	// with the initial reputation of defaults.
+	UpdateAddress(ctx context.Context, value *pb.Node, lastNet string, defaults NodeSelectionConfig) error
 	// UpdateStats all parts of single storagenode's stats.
 	UpdateStats(ctx context.Context, request *UpdateRequest) (stats *NodeStats, err error)
 	// UpdateNodeInfo updates node dossier with info requested from the node itself like node type, email, wallet, capacity, and version.
 	UpdateNodeInfo(ctx context.Context, node storj.NodeID, nodeInfo *pb.InfoResponse) (stats *NodeDossier, err error)
 	// UpdateUptime updates a single storagenode's uptime stats.
-	UpdateUptime(ctx context.Context, nodeID storj.NodeID, isUp bool) (stats *NodeStats, err error)
+	UpdateUptime(ctx context.Context, nodeID storj.NodeID, isUp bool, lambda, weight float64) (stats *NodeStats, err error)
+	// UpdateExitStatus updates a single storagenode's graceful exit status.
+	UpdateExitStatus(ctx context.Context, request *ExitStatusRequest) (stats *NodeDossier, err error)
+
+	// UpdateVetted marks the node as vetted, nodes which are already vetted are left unchanged.
+	UpdateVetted(ctx context.Context, nodeID storj.NodeID, vettedAt time.Time) error
+	// KnownUnvetted filters a set of nodes to the nodes which aren't vetted yet.
+	KnownUnvetted(ctx context.Context, nodeIDs storj.NodeIDList) (storj.NodeIDList, error)
+	// UnvettedNodes returns the storage nodes which aren't vetted yet, the nodes closest to being vetted first.
+	UnvettedNodes(ctx context.Context, limit int) ([]*VettingStatus, error)
+
+	// DisqualifyNode disqualifies the node, nodes which are already disqualified are left unchanged.
+	DisqualifyNode(ctx context.Context, nodeID storj.NodeID, disqualifiedAt time.Time) error
+	// DisqualifiedNodes returns the disqualified nodes, the most recently disqualified first.
+	DisqualifiedNodes(ctx context.Context, limit int) ([]*DisqualifiedNode, error)
 }
 
 // FindStorageNodesRequest defines easy request parameters.
@@ -72,12 +91,13 @@ type NodeCriteria struct {
 	FreeBandwidth      int64
 	FreeDisk           int64
 	AuditCount         int64
-	AuditSuccessRatio  float64
+	AuditReputationDQ  float64
 	UptimeCount        int64
-	UptimeSuccessRatio float64
+	UptimeReputationDQ float64
 	Excluded           []storj.NodeID
 	MinimumVersion     string // semver or empty
 	OnlineWindow       time.Duration
+	DistinctNetworks   bool // at most one node per network, and none in the networks of excluded nodes
 }
 
 // UpdateRequest is used to update a node status.
@@ -85,17 +105,58 @@ type UpdateRequest struct {
 	NodeID       storj.NodeID
 	AuditSuccess bool
 	IsUp         bool
+
+	// the forgetting factors and weights of the reputation and the audit
+	// reputation below which the node is disqualified, these are set by the
+	// Cache from the node selection config
+	AuditLambda  float64
+	AuditWeight  float64
+	AuditDQ      float64
+	UptimeLambda float64
+	UptimeWeight float64
 }
 
 // NodeDossier is the complete info that the satellite tracks for a storage node
 type NodeDossier struct {
 	pb.Node
+	LastNet    string
 	Type       pb.NodeType
 	Operator   pb.NodeOperator
 	Capacity   pb.NodeCapacity
 	Reputation NodeStats
 	Version    pb.NodeVersion
 	Contained  bool
+	ExitStatus ExitStatus
+}
+
+// ExitStatus contains the graceful exit status of a node.
+type ExitStatus struct {
+	ExitInitiatedAt *time.Time
+	ExitFinishedAt  *time.Time
+	ExitSuccess     bool
+}
+
+// ExitStatusRequest is used to update the graceful exit status of a node,
+// zero times are left unchanged.
+type ExitStatusRequest struct {
+	NodeID          storj.NodeID
+	ExitInitiatedAt time.Time
+	ExitFinishedAt  time.Time
+	ExitSuccess     bool
+}
+
+// VettingStatus contains the progress of a node towards being vetted.
+type VettingStatus struct {
+	NodeID      storj.NodeID
+	AuditCount  int64
+	UptimeCount int64
+	VettedAt    *time.Time
+}
+
+// DisqualifiedNode is a node which has been disqualified.
+type DisqualifiedNode struct {
+	NodeID       storj.NodeID
+	Disqualified time.Time
 }
 
 // NodeStats contains statistics about a node.
@@ -109,6 +170,62 @@ type NodeStats struct {
 	UptimeCount        int64
 	LastContactSuccess time.Time
 	LastContactFailure time.Time
+	VettedAt           *time.Time
+	Disqualified       *time.Time
+
+	AuditReputationAlpha  float64
+	AuditReputationBeta   float64
+	UptimeReputationAlpha float64
+	UptimeReputationBeta  float64
+}
+
+// AuditReputation returns the audit reputation score of the node, the mean of
+// the beta distribution described by the audit reputation alpha and beta.
+func (stats *NodeStats) AuditReputation() float64 {
+	return ReputationScore(stats.AuditReputationAlpha, stats.AuditReputationBeta)
+}
+
+// UptimeReputation returns the uptime reputation score of the node, the mean of
+// the beta distribution described by the uptime reputation alpha and beta.
+func (stats *NodeStats) UptimeReputation() float64 {
+	return ReputationScore(stats.UptimeReputationAlpha, stats.UptimeReputationBeta)
+}
+
+// ReputationScore returns the mean of the beta distribution described by alpha and beta.
+func ReputationScore(alpha, beta float64) float64 {
+	if alpha+beta <= 0 {
+		return 0
+	}
+	return alpha / (alpha + beta)
+}
+
+// UpdateReputation updates the alpha and beta of a reputation with the outcome
+// of a single audit or uptime check. The previous values are discounted by the
+// forgetting factor lambda, so recent outcomes weigh more than old ones.
+func UpdateReputation(success bool, alpha, beta, lambda, weight float64) (newAlpha, newBeta float64) {
+	v := -1.0
+	if success {
+		v = 1.0
+	}
+	newAlpha = lambda*alpha + weight*(1+v)/2
+	newBeta = lambda*beta + weight*(1-v)/2
+	return newAlpha, newBeta
+}
+
+// InitialReputation returns the reputation alpha and beta of a node with the
+// given number of successes out of total. The history isn't discounted, but
+// its total is limited to weight/(1-lambda), the most that the updates can
+// accumulate, so that the node's ratio of successes is kept and new results
+// affect its reputation as much as they affect the other nodes.
+func InitialReputation(successCount, totalCount int64, lambda, weight float64) (alpha, beta float64) {
+	alpha, beta = 1+float64(successCount), float64(totalCount-successCount)
+	if lambda < 1 {
+		if limit := weight / (1 - lambda); alpha+beta > limit {
+			scale := limit / (alpha + beta)
+			alpha, beta = alpha*scale, beta*scale
+		}
+	}
+	return alpha, beta
 }
 
 // Cache is used to store and handle node information
@@ -186,17 +303,19 @@ func (cache *Cache) FindStorageNodesWithPreferences(ctx context.Context, req Fin
 			FreeBandwidth:     req.FreeBandwidth,
 			FreeDisk:          req.FreeDisk,
 			AuditCount:        preferences.AuditCount,
-			AuditSuccessRatio: preferences.AuditSuccessRatio,
+			AuditReputationDQ: preferences.AuditReputationDQ,
 			Excluded:          excluded,
 			MinimumVersion:    preferences.MinimumVersion,
 			OnlineWindow:      preferences.OnlineWindow,
+			DistinctNetworks:  preferences.DistinctNetworks,
 		})
 		if err != nil {
 			return nil, err
 		}
 	}
 
-	// add selected new nodes to the excluded list for reputable node selection
+	// add selected new nodes to the excluded list for reputable node selection,
+	// which also excludes their networks when distinct networks are required
 	for _, newNode := range newNodes {
 		excluded = append(excluded, newNode.Id)
 	}
@@ -205,12 +324,13 @@ func (cache *Cache) FindStorageNodesWithPreferences(ctx context.Context, req Fin
 		FreeBandwidth:      req.FreeBandwidth,
 		FreeDisk:           req.FreeDisk,
 		AuditCount:         preferences.AuditCount,
-		AuditSuccessRatio:  preferences.AuditSuccessRatio,
+		AuditReputationDQ:  preferences.AuditReputationDQ,
 		UptimeCount:        preferences.UptimeCount,
-		UptimeSuccessRatio: preferences.UptimeRatio,
+		UptimeReputationDQ: preferences.UptimeReputationDQ,
 		Excluded:           excluded,
 		MinimumVersion:     preferences.MinimumVersion,
 		OnlineWindow:       preferences.OnlineWindow,
+		DistinctNetworks:   preferences.DistinctNetworks,
 	}
 	reputableNodes, err := cache.db.SelectStorageNodes(ctx, reputableNodeCount-len(newNodes), &criteria)
 	if err != nil {
@@ -232,10 +352,10 @@ func (cache *Cache) KnownUnreliableOrOffline(ctx context.Context, nodeIds storj.
 	defer mon.Task()(&ctx)(&err)
 	criteria := &NodeCriteria{
 		AuditCount:         cache.preferences.AuditCount,
-		AuditSuccessRatio:  cache.preferences.AuditSuccessRatio,
+		AuditReputationDQ:  cache.preferences.AuditReputationDQ,
 		OnlineWindow:       cache.preferences.OnlineWindow,
 		UptimeCount:        cache.preferences.UptimeCount,
-		UptimeSuccessRatio: cache.preferences.UptimeRatio,
+		UptimeReputationDQ: cache.preferences.UptimeReputationDQ,
 	}
 	return cache.db.KnownUnreliableOrOffline(ctx, criteria, nodeIds)
 }
@@ -243,6 +363,13 @@ func (cache *Cache) KnownUnreliableOrOffline(ctx context.Context, nodeIds storj.
 // Put adds a node id and proto definition into the overlay cache
 func (cache *Cache) Put(ctx context.Context, nodeID storj.NodeID, value pb.Node) (err error) {
 	defer mon.Task()(&ctx)(&err)
+	return cache.put(ctx, nodeID, value, true)
+}
+
+// put stores the node address, resolving the network it is in when resolve
+// is set. Otherwise the network stored for the node is kept.
+func (cache *Cache) put(ctx context.Context, nodeID storj.NodeID, value pb.Node, resolve bool) (err error) {
+	defer mon.Task()(&ctx)(&err)
 
 	// If we get a Node without an ID (i.e. bootstrap node)
 	// we don't want to add to the routing tbale
@@ -252,19 +379,57 @@ func (cache *Cache) Put(ctx context.Context, nodeID storj.NodeID, value pb.Node)
 	if nodeID != value.Id {
 		return errors.New("invalid request")
 	}
-	return cache.db.UpdateAddress(ctx, &value)
+
+	var lastNet string
+	if address := value.GetAddress().GetAddress(); resolve && address != "" {
+		resolveCtx, cancel := context.WithTimeout(ctx, resolveTimeout)
+		lastNet, err = ResolveNetwork(resolveCtx, address, cache.preferences.NetworkPrefixV4, cache.preferences.NetworkPrefixV6)
+		cancel()
+		if err != nil {
+			// the node keeps the network it was last resolved to until the next check-in
+			cache.log.Debug("error resolving node network", zap.Stringer("node", nodeID), zap.Error(err))
+		}
+	}
+
+	return cache.db.UpdateAddress(ctx, &value, lastNet, cache.preferences)
 }
 
-// Create adds a new stats entry for node.
+// Create adds a new stats entry for node, the reputation is seeded from the
+// initial counts.
 func (cache *Cache) Create(ctx context.Context, nodeID storj.NodeID, initial *NodeStats) (stats *NodeStats, err error) {
 	defer mon.Task()(&ctx)(&err)
-	return cache.db.CreateStats(ctx, nodeID, initial)
+	if initial != nil {
+		seeded := *initial
+		seeded.AuditReputationAlpha, seeded.AuditReputationBeta = InitialReputation(initial.AuditSuccessCount, initial.AuditCount,
+			cache.preferences.AuditReputationLambda, cache.preferences.AuditReputationWeight)
+		seeded.UptimeReputationAlpha, seeded.UptimeReputationBeta = InitialReputation(initial.UptimeSuccessCount, initial.UptimeCount,
+			cache.preferences.UptimeReputationLambda, cache.preferences.UptimeReputationWeight)
+		initial = &seeded
+	}
+
+	stats, err = cache.db.CreateStats(ctx, nodeID, initial)
+	if err != nil {
+		return nil, err
+	}
+	return stats, cache.vet(ctx, nodeID, stats)
 }
 
 // UpdateStats all parts of single storagenode's stats.
 func (cache *Cache) UpdateStats(ctx context.Context, request *UpdateRequest) (stats *NodeStats, err error) {
 	defer mon.Task()(&ctx)(&err)
-	return cache.db.UpdateStats(ctx, request)
+
+	update := *request
+	update.AuditLambda = cache.preferences.AuditReputationLambda
+	update.AuditWeight = cache.preferences.AuditReputationWeight
+	update.AuditDQ = cache.preferences.AuditReputationDQ
+	update.UptimeLambda = cache.preferences.UptimeReputationLambda
+	update.UptimeWeight = cache.preferences.UptimeReputationWeight
+
+	stats, err = cache.db.UpdateStats(ctx, &update)
+	if err != nil {
+		return nil, err
+	}
+	return stats, cache.vet(ctx, request.NodeID, stats)
 }
 
 // UpdateNodeInfo updates node dossier with info requested from the node itself like node type, email, wallet, capacity, and version.
@@ -276,7 +441,72 @@ func (cache *Cache) UpdateNodeInfo(ctx context.Context, node storj.NodeID, nodeI
 // UpdateUptime updates a single storagenode's uptime stats.
 func (cache *Cache) UpdateUptime(ctx context.Context, nodeID storj.NodeID, isUp bool) (stats *NodeStats, err error) {
 	defer mon.Task()(&ctx)(&err)
-	return cache.db.UpdateUptime(ctx, nodeID, isUp)
+	stats, err = cache.db.UpdateUptime(ctx, nodeID, isUp,
+		cache.preferences.UptimeReputationLambda, cache.preferences.UptimeReputationWeight)
+	if err != nil {
+		return nil, err
+	}
+	return stats, cache.vet(ctx, nodeID, stats)
+}
+
+// vet marks the node as vetted once it has been audited and checked for
+// uptime as many times as the node selection config requires.
+func (cache *Cache) vet(ctx context.Context, nodeID storj.NodeID, stats *NodeStats) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	if stats.VettedAt != nil {
+		return nil
+	}
+	if stats.AuditCount < cache.preferences.AuditCount || stats.UptimeCount < cache.preferences.UptimeCount {
+		return nil
+	}
+
+	vettedAt := time.Now().UTC()
+	if err := cache.db.UpdateVetted(ctx, nodeID, vettedAt); err != nil {
+		return err
+	}
+	stats.VettedAt = &vettedAt
+	cache.log.Debug("node vetted", zap.Stringer("node", nodeID),
+		zap.Int64("audits", stats.AuditCount), zap.Int64("uptime checks", stats.UptimeCount))
+	return nil
+}
+
+// IsVetted returns whether the node has been vetted.
+func (cache *Cache) IsVetted(node *NodeDossier) bool {
+	return node.Reputation.VettedAt != nil
+}
+
+// KnownUnvetted filters a set of nodes to the nodes which aren't vetted yet.
+func (cache *Cache) KnownUnvetted(ctx context.Context, nodeIDs storj.NodeIDList) (unvetted storj.NodeIDList, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if len(nodeIDs) == 0 {
+		return nil, nil
+	}
+	return cache.db.KnownUnvetted(ctx, nodeIDs)
+}
+
+// UnvettedNodes returns the storage nodes in the vetting pipeline, the nodes closest to being vetted first.
+func (cache *Cache) UnvettedNodes(ctx context.Context, limit int) (_ []*VettingStatus, err error) {
+	defer mon.Task()(&ctx)(&err)
+	return cache.db.UnvettedNodes(ctx, limit)
+}
+
+// Disqualify disqualifies the node, it won't be selected or paid anymore and
+// its pieces are considered lost.
+func (cache *Cache) Disqualify(ctx context.Context, nodeID storj.NodeID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	return cache.db.DisqualifyNode(ctx, nodeID, time.Now().UTC())
+}
+
+// DisqualifiedNodes returns the disqualified nodes, the most recently disqualified first.
+func (cache *Cache) DisqualifiedNodes(ctx context.Context, limit int) (_ []*DisqualifiedNode, err error) {
+	defer mon.Task()(&ctx)(&err)
+	return cache.db.DisqualifiedNodes(ctx, limit)
+}
+
+// UpdateExitStatus updates the graceful exit status of a single storagenode.
+func (cache *Cache) UpdateExitStatus(ctx context.Context, request *ExitStatusRequest) (stats *NodeDossier, err error) {
+	defer mon.Task()(&ctx)(&err)
+	return cache.db.UpdateExitStatus(ctx, request)
 }
 
 // ConnFailure implements the Transport Observer `ConnFailure` function
@@ -287,7 +517,7 @@ func (cache *Cache) ConnFailure(ctx context.Context, node *pb.Node, failureError
 	// TODO: Kademlia paper specifies 5 unsuccessful PINGs before removing the node
 	// from our routing table, but this is the cache so maybe we want to treat
 	// it differently.
-	_, err = cache.db.UpdateUptime(ctx, node.Id, false)
+	_, err = cache.UpdateUptime(ctx, node.Id, false)
 	if err != nil {
 		zap.L().Debug("error updating uptime for node", zap.Error(err))
 	}
@@ -298,11 +528,12 @@ func (cache *Cache) ConnSuccess(ctx context.Context, node *pb.Node) {
 	var err error
 	defer mon.Task()(&ctx)(&err)
 
-	err = cache.Put(ctx, node.Id, *node)
+	// the network is resolved when the node checks in, not on every connection
+	err = cache.put(ctx, node.Id, *node, false)
 	if err != nil {
 		zap.L().Debug("error updating uptime for node", zap.Error(err))
 	}
-	_, err = cache.db.UpdateUptime(ctx, node.Id, true)
+	_, err = cache.UpdateUptime(ctx, node.Id, true)
 	if err != nil {
 		zap.L().Debug("error updating node connection info", zap.Error(err))
 	}
@@ -41,7 +41,11 @@ func testCache(ctx context.Context, t *testing.T, store overlay.DB) {
 	_, _ = rand.Read(valid2ID[:])
 	_, _ = rand.Read(missingID[:])
 
-	cache := overlay.NewCache(zaptest.NewLogger(t), store, overlay.NodeSelectionConfig{OnlineWindow: time.Hour})
+	cache := overlay.NewCache(zaptest.NewLogger(t), store, overlay.NodeSelectionConfig{
+		OnlineWindow:           time.Hour,
+		AuditReputationAlpha0:  1,
+		UptimeReputationAlpha0: 1,
+	})
 
 	{ // Put
 		err := cache.Put(ctx, valid1ID, pb.Node{Id: valid1ID})
@@ -114,14 +118,14 @@ func TestRandomizedSelection(t *testing.T) {
 		for i := 0; i < totalNodes; i++ {
 			newID := storj.NodeID{}
 			_, _ = rand.Read(newID[:])
-			err := cache.UpdateAddress(ctx, &pb.Node{Id: newID})
+			err := cache.UpdateAddress(ctx, &pb.Node{Id: newID}, "", newNodeDefaults)
 			require.NoError(t, err)
 			_, err = cache.UpdateNodeInfo(ctx, newID, &pb.InfoResponse{
 				Type:     pb.NodeType_STORAGE,
 				Capacity: &pb.NodeCapacity{},
 			})
 			require.NoError(t, err)
-			_, err = cache.UpdateUptime(ctx, newID, true)
+			_, err = cache.UpdateUptime(ctx, newID, true, 1, 1)
 			require.NoError(t, err)
 			allIDs[i] = newID
 			nodeCounts[newID] = 0
@@ -173,3 +177,158 @@ func TestRandomizedSelection(t *testing.T) {
 		}
 	})
 }
+
+func TestVetting(t *testing.T) {
+	satellitedbtest.Run(t, func(t *testing.T, db satellite.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		cache := overlay.NewCache(zaptest.NewLogger(t), db.OverlayCache(), overlay.NodeSelectionConfig{
+			OnlineWindow:           time.Hour,
+			AuditCount:             2,
+			UptimeCount:            1,
+			AuditReputationAlpha0:  1,
+			UptimeReputationAlpha0: 1,
+		})
+
+		nodeID := storj.NodeID{1}
+		otherID := storj.NodeID{2}
+		for _, id := range []storj.NodeID{nodeID, otherID} {
+			require.NoError(t, cache.Put(ctx, id, pb.Node{Id: id, Address: &pb.NodeAddress{Address: "127.0.0.1:0"}}))
+			_, err := cache.UpdateNodeInfo(ctx, id, &pb.InfoResponse{Type: pb.NodeType_STORAGE})
+			require.NoError(t, err)
+		}
+
+		unvetted, err := cache.KnownUnvetted(ctx, storj.NodeIDList{nodeID, otherID})
+		require.NoError(t, err)
+		assert.ElementsMatch(t, storj.NodeIDList{nodeID, otherID}, unvetted)
+
+		// one audit isn't enough to be vetted
+		stats, err := cache.UpdateStats(ctx, &overlay.UpdateRequest{NodeID: nodeID, AuditSuccess: true, IsUp: true})
+		require.NoError(t, err)
+		assert.Nil(t, stats.VettedAt)
+
+		pipeline, err := cache.UnvettedNodes(ctx, 10)
+		require.NoError(t, err)
+		require.Len(t, pipeline, 2)
+		assert.Equal(t, nodeID, pipeline[0].NodeID)
+		assert.Equal(t, int64(1), pipeline[0].AuditCount)
+
+		stats, err = cache.UpdateStats(ctx, &overlay.UpdateRequest{NodeID: nodeID, AuditSuccess: true, IsUp: true})
+		require.NoError(t, err)
+		require.NotNil(t, stats.VettedAt)
+
+		node, err := cache.Get(ctx, nodeID)
+		require.NoError(t, err)
+		assert.True(t, cache.IsVetted(node))
+
+		unvetted, err = cache.KnownUnvetted(ctx, storj.NodeIDList{nodeID, otherID})
+		require.NoError(t, err)
+		assert.Equal(t, storj.NodeIDList{otherID}, unvetted)
+
+		pipeline, err = cache.UnvettedNodes(ctx, 10)
+		require.NoError(t, err)
+		require.Len(t, pipeline, 1)
+		assert.Equal(t, otherID, pipeline[0].NodeID)
+	})
+}
+
+func TestDistinctNetworks(t *testing.T) {
+	satellitedbtest.Run(t, func(t *testing.T, db satellite.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		cache := overlay.NewCache(zaptest.NewLogger(t), db.OverlayCache(), overlay.NodeSelectionConfig{
+			OnlineWindow:           time.Hour,
+			DistinctNetworks:       true,
+			NetworkPrefixV4:        24,
+			NetworkPrefixV6:        64,
+			AuditReputationAlpha0:  1,
+			UptimeReputationAlpha0: 1,
+			UptimeReputationLambda: 0.99,
+			UptimeReputationWeight: 1,
+		})
+
+		addresses := map[storj.NodeID]string{
+			{1}: "10.0.0.1:7777",
+			{2}: "10.0.0.2:7777",
+			{3}: "10.0.0.3:7777",
+			{4}: "10.0.1.1:7777",
+			{5}: "10.0.1.2:7777",
+			{6}: "10.0.2.1:7777",
+			{7}: "[2001:db8:0:1::1]:7777",
+			{8}: "[2001:db8:0:1::2]:7777",
+		}
+		for id, address := range addresses {
+			require.NoError(t, cache.Put(ctx, id, pb.Node{Id: id, Address: &pb.NodeAddress{Address: address}}))
+			_, err := cache.UpdateNodeInfo(ctx, id, &pb.InfoResponse{
+				Type:     pb.NodeType_STORAGE,
+				Capacity: &pb.NodeCapacity{},
+			})
+			require.NoError(t, err)
+			_, err = cache.UpdateUptime(ctx, id, true)
+			require.NoError(t, err)
+		}
+
+		networkOf := func(id storj.NodeID) string {
+			node, err := cache.Get(ctx, id)
+			require.NoError(t, err)
+			return node.LastNet
+		}
+		require.Equal(t, "10.0.0.0", networkOf(storj.NodeID{2}))
+		require.Equal(t, "2001:db8:0:1::", networkOf(storj.NodeID{8}))
+
+		// a network that can't be resolved doesn't replace the stored one
+		require.NoError(t, cache.Put(ctx, storj.NodeID{2}, pb.Node{Id: storj.NodeID{2}, Address: &pb.NodeAddress{Address: "10.0.0.2"}}))
+		require.Equal(t, "10.0.0.0", networkOf(storj.NodeID{2}))
+		require.NoError(t, cache.Put(ctx, storj.NodeID{2}, pb.Node{Id: storj.NodeID{2}, Address: &pb.NodeAddress{Address: "10.0.0.2:7777"}}))
+
+		// connections don't resolve the network, it's kept until the node is stored again
+		cache.ConnSuccess(ctx, &pb.Node{Id: storj.NodeID{6}, Address: &pb.NodeAddress{Address: "10.0.9.1:7777"}})
+		node, err := cache.Get(ctx, storj.NodeID{6})
+		require.NoError(t, err)
+		require.Equal(t, "10.0.9.1:7777", node.Address.Address)
+		require.Equal(t, "10.0.2.0", node.LastNet)
+		require.NoError(t, cache.Put(ctx, storj.NodeID{6}, pb.Node{Id: storj.NodeID{6}, Address: &pb.NodeAddress{Address: "10.0.2.1:7777"}}))
+
+		// any node of a network may be selected
+		selected := make(map[storj.NodeID]bool)
+		for i := 0; i < 50; i++ {
+			nodes, err := cache.FindStorageNodes(ctx, overlay.FindStorageNodesRequest{RequestedCount: 4})
+			require.NoError(t, err)
+			require.Len(t, nodes, 4)
+
+			networks := make(map[string]bool)
+			for _, node := range nodes {
+				networks[networkOf(node.Id)] = true
+				selected[node.Id] = true
+			}
+			require.Len(t, networks, 4)
+		}
+		for _, id := range []storj.NodeID{{1}, {2}, {3}} {
+			assert.True(t, selected[id], "node %v of network 10.0.0.0 was never selected", id)
+		}
+
+		_, err = cache.FindStorageNodes(ctx, overlay.FindStorageNodesRequest{RequestedCount: 5})
+		require.True(t, overlay.ErrNotEnoughNodes.Has(err))
+
+		// the networks of excluded nodes, like the healthy pieces during repair, aren't selected either
+		for i := 0; i < 10; i++ {
+			nodes, err := cache.FindStorageNodes(ctx, overlay.FindStorageNodesRequest{
+				RequestedCount: 3,
+				ExcludedNodes:  []storj.NodeID{{1}},
+			})
+			require.NoError(t, err)
+			require.Len(t, nodes, 3)
+			for _, node := range nodes {
+				require.NotEqual(t, "10.0.0.0", networkOf(node.Id))
+			}
+		}
+
+		_, err = cache.FindStorageNodes(ctx, overlay.FindStorageNodesRequest{
+			RequestedCount: 4,
+			ExcludedNodes:  []storj.NodeID{{1}},
+		})
+		require.True(t, overlay.ErrNotEnoughNodes.Has(err))
+	})
+}
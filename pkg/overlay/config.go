@@ -34,13 +34,27 @@ type LookupConfig struct {
 // NodeSelectionConfig is a configuration struct to determine the minimum
 // values for nodes to select
 type NodeSelectionConfig struct {
-	UptimeRatio       float64       `help:"a node's ratio of being up/online vs. down/offline" releaseDefault:"0.9" devDefault:"0"`
+	UptimeRatio       float64       `help:"deprecated, the uptime reputation is used instead" releaseDefault:"0.9" devDefault:"0"`
 	UptimeCount       int64         `help:"the number of times a node's uptime has been checked to not be considered a New Node" releaseDefault:"500" devDefault:"0"`
-	AuditSuccessRatio float64       `help:"a node's ratio of successful audits" releaseDefault:"0.4" devDefault:"0"` // TODO: update after beta
+	AuditSuccessRatio float64       `help:"deprecated, the audit reputation is used instead" releaseDefault:"0.4" devDefault:"0"`
 	AuditCount        int64         `help:"the number of times a node has been audited to not be considered a New Node" releaseDefault:"500" devDefault:"0"`
 	NewNodePercentage float64       `help:"the percentage of new nodes allowed per request" default:"0.05"` // TODO: fix, this is not percentage, it's ratio
 	MinimumVersion    string        `help:"the minimum node software version for node selection queries" default:""`
 	OnlineWindow      time.Duration `help:"the amount of time without seeing a node before its considered offline" default:"1h"`
+	DistinctNetworks  bool          `help:"require the nodes selected for a segment to be in distinct networks" releaseDefault:"true" devDefault:"false"`
+	NetworkPrefixV4   int           `help:"the length of the IPv4 prefix identifying the network of a node" default:"24"`
+	NetworkPrefixV6   int           `help:"the length of the IPv6 prefix identifying the network of a node" default:"64"`
+
+	AuditReputationAlpha0  float64 `help:"the initial alpha of the audit reputation of a new node, weight/(1-lambda) counts as a long history of successes" default:"20"`
+	AuditReputationBeta0   float64 `help:"the initial beta of the audit reputation of a new node" default:"0"`
+	AuditReputationLambda  float64 `help:"the forgetting factor used to calculate the audit reputation of a node" default:"0.95"`
+	AuditReputationWeight  float64 `help:"the normalization weight used to calculate the audit reputation of a node" default:"1.0"`
+	AuditReputationDQ      float64 `help:"the audit reputation below which a node is disqualified" releaseDefault:"0.6" devDefault:"0"`
+	UptimeReputationAlpha0 float64 `help:"the initial alpha of the uptime reputation of a new node, weight/(1-lambda) counts as a long history of successes" default:"100"`
+	UptimeReputationBeta0  float64 `help:"the initial beta of the uptime reputation of a new node" default:"0"`
+	UptimeReputationLambda float64 `help:"the forgetting factor used to calculate the uptime reputation of a node" default:"0.99"`
+	UptimeReputationWeight float64 `help:"the normalization weight used to calculate the uptime reputation of a node" default:"1.0"`
+	UptimeReputationDQ     float64 `help:"the uptime reputation below which a node isn't selected, unlike the audit reputation it doesn't disqualify the node" releaseDefault:"0.6" devDefault:"0"`
 }
 
 // ParseIDs converts the base58check encoded node ID strings from the config into node IDs
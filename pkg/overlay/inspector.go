@@ -6,6 +6,7 @@ package overlay
 import (
 	"context"
 
+	"github.com/golang/protobuf/ptypes"
 	"github.com/zeebo/errs"
 
 	"storj.io/storj/pkg/pb"
@@ -69,3 +70,56 @@ func (srv *Inspector) CreateStats(ctx context.Context, req *pb.CreateStatsReques
 
 	return &pb.CreateStatsResponse{}, nil
 }
+
+// VettingPipeline returns the nodes that aren't vetted yet together with the vetting criteria
+func (srv *Inspector) VettingPipeline(ctx context.Context, req *pb.VettingPipelineRequest) (*pb.VettingPipelineResponse, error) {
+	unvetted, err := srv.cache.UnvettedNodes(ctx, int(req.Limit))
+	if err != nil {
+		return nil, err
+	}
+
+	nodes := make([]*pb.UnvettedNode, 0, len(unvetted))
+	for _, status := range unvetted {
+		nodes = append(nodes, &pb.UnvettedNode{
+			NodeId:      status.NodeID,
+			AuditCount:  status.AuditCount,
+			UptimeCount: status.UptimeCount,
+		})
+	}
+
+	return &pb.VettingPipelineResponse{
+		RequiredAuditCount:  srv.cache.preferences.AuditCount,
+		RequiredUptimeCount: srv.cache.preferences.UptimeCount,
+		Nodes:               nodes,
+	}, nil
+}
+
+// DisqualifyNode disqualifies a node manually
+func (srv *Inspector) DisqualifyNode(ctx context.Context, req *pb.DisqualifyNodeRequest) (*pb.DisqualifyNodeResponse, error) {
+	if err := srv.cache.Disqualify(ctx, req.NodeId); err != nil {
+		return nil, err
+	}
+	return &pb.DisqualifyNodeResponse{}, nil
+}
+
+// DisqualifiedNodes returns the disqualified nodes
+func (srv *Inspector) DisqualifiedNodes(ctx context.Context, req *pb.DisqualifiedNodesRequest) (*pb.DisqualifiedNodesResponse, error) {
+	disqualified, err := srv.cache.DisqualifiedNodes(ctx, int(req.Limit))
+	if err != nil {
+		return nil, err
+	}
+
+	nodes := make([]*pb.DisqualifiedNode, 0, len(disqualified))
+	for _, node := range disqualified {
+		timestamp, err := ptypes.TimestampProto(node.Disqualified)
+		if err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, &pb.DisqualifiedNode{
+			NodeId:       node.NodeID,
+			Disqualified: timestamp,
+		})
+	}
+
+	return &pb.DisqualifiedNodesResponse{Nodes: nodes}, nil
+}
@@ -0,0 +1,42 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package overlay
+
+import (
+	"context"
+	"net"
+	"time"
+)
+
+// resolveTimeout is how long resolving the network of a node may take when
+// the node is stored.
+const resolveTimeout = 5 * time.Second
+
+// ResolveNetwork resolves the host of address and returns the network it is in,
+// which is its IP masked to the IPv4 or IPv6 prefix length.
+func ResolveNetwork(ctx context.Context, address string, ipv4Prefix, ipv6Prefix int) (_ string, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return "", Error.Wrap(err)
+	}
+
+	ip := net.ParseIP(host)
+	if ip == nil {
+		addrs, err := net.DefaultResolver.LookupIPAddr(ctx, host)
+		if err != nil {
+			return "", Error.Wrap(err)
+		}
+		if len(addrs) == 0 {
+			return "", Error.New("no IP found for %q", host)
+		}
+		ip = addrs[0].IP
+	}
+
+	if ipv4 := ip.To4(); ipv4 != nil {
+		return ipv4.Mask(net.CIDRMask(ipv4Prefix, 8*net.IPv4len)).String(), nil
+	}
+	return ip.Mask(net.CIDRMask(ipv6Prefix, 8*net.IPv6len)).String(), nil
+}
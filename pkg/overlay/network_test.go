@@ -0,0 +1,41 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package overlay_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/pkg/overlay"
+)
+
+func TestResolveNetwork(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	for _, tt := range []struct {
+		address    string
+		ipv4Prefix int
+		ipv6Prefix int
+		network    string
+		err        bool
+	}{
+		{address: "1.2.3.4:7777", ipv4Prefix: 24, ipv6Prefix: 64, network: "1.2.3.0"},
+		{address: "1.2.3.4:7777", ipv4Prefix: 16, ipv6Prefix: 64, network: "1.2.0.0"},
+		{address: "[2001:db8:1:2:3:4:5:6]:7777", ipv4Prefix: 24, ipv6Prefix: 64, network: "2001:db8:1:2::"},
+		{address: "[2001:db8:1:2:3:4:5:6]:7777", ipv4Prefix: 24, ipv6Prefix: 48, network: "2001:db8:1::"},
+		{address: "[::ffff:1.2.3.4]:7777", ipv4Prefix: 24, ipv6Prefix: 64, network: "1.2.3.0"},
+		{address: "1.2.3.4", ipv4Prefix: 24, ipv6Prefix: 64, err: true},
+	} {
+		network, err := overlay.ResolveNetwork(ctx, tt.address, tt.ipv4Prefix, tt.ipv6Prefix)
+		if tt.err {
+			assert.Error(t, err, tt.address)
+			continue
+		}
+		assert.NoError(t, err, tt.address)
+		assert.Equal(t, tt.network, network, tt.address)
+	}
+}
@@ -216,3 +216,39 @@ func TestNodeSelection(t *testing.T) {
 		}
 	})
 }
+
+func TestDisqualifiedNodes(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 0,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		service := planet.Satellites[0].Overlay.Service
+		disqualified := planet.StorageNodes[0].ID()
+
+		err := service.Disqualify(ctx, disqualified)
+		require.NoError(t, err)
+
+		result, err := service.KnownUnreliableOrOffline(ctx, []storj.NodeID{
+			planet.StorageNodes[0].ID(),
+			planet.StorageNodes[1].ID(),
+		})
+		require.NoError(t, err)
+		require.Equal(t, storj.NodeIDList{disqualified}, result)
+
+		for i := 0; i < 10; i++ {
+			nodes, err := service.FindStorageNodes(ctx, overlay.FindStorageNodesRequest{RequestedCount: 3})
+			require.NoError(t, err)
+			require.Len(t, nodes, 3)
+			for _, node := range nodes {
+				require.NotEqual(t, disqualified, node.Id)
+			}
+		}
+
+		_, err = service.FindStorageNodes(ctx, overlay.FindStorageNodesRequest{RequestedCount: 4})
+		require.True(t, overlay.ErrNotEnoughNodes.Has(err))
+
+		list, err := service.DisqualifiedNodes(ctx, 10)
+		require.NoError(t, err)
+		require.Len(t, list, 1)
+		require.Equal(t, disqualified, list[0].NodeID)
+	})
+}
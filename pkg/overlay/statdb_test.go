@@ -19,11 +19,41 @@ import (
 	"storj.io/storj/satellite/satellitedb/satellitedbtest"
 )
 
+// newNodeDefaults is the initial reputation of new nodes for lambda 0.95 and weight 1.
+var newNodeDefaults = overlay.NodeSelectionConfig{
+	AuditReputationAlpha0:  20,
+	UptimeReputationAlpha0: 20,
+}
+
 func getRatio(success, total int64) (ratio float64) {
 	ratio = float64(success) / float64(total)
 	return ratio
 }
 
+// seedReputation sets the reputation of stats from its counts, the way cache.Create does.
+func seedReputation(stats *overlay.NodeStats, lambda, weight float64) *overlay.NodeStats {
+	stats.AuditReputationAlpha, stats.AuditReputationBeta = overlay.InitialReputation(stats.AuditSuccessCount, stats.AuditCount, lambda, weight)
+	stats.UptimeReputationAlpha, stats.UptimeReputationBeta = overlay.InitialReputation(stats.UptimeSuccessCount, stats.UptimeCount, lambda, weight)
+	return stats
+}
+
+func TestInitialReputation(t *testing.T) {
+	// a short history is kept as is
+	alpha, beta := overlay.InitialReputation(4, 10, 0.95, 1)
+	assert.EqualValues(t, 5, alpha)
+	assert.EqualValues(t, 6, beta)
+
+	// a long history is limited to weight/(1-lambda) keeping the ratio
+	alpha, beta = overlay.InitialReputation(299, 399, 0.95, 1)
+	assert.InDelta(t, 15, alpha, 1e-9)
+	assert.InDelta(t, 5, beta, 1e-9)
+
+	// without forgetting there's no limit
+	alpha, beta = overlay.InitialReputation(299, 399, 1, 1)
+	assert.EqualValues(t, 300, alpha)
+	assert.EqualValues(t, 100, beta)
+}
+
 func TestStatDB(t *testing.T) {
 	satellitedbtest.Run(t, func(t *testing.T, db satellite.DB) {
 		ctx := testcontext.New(t)
@@ -53,10 +83,10 @@ func testDatabase(ctx context.Context, t *testing.T, cache overlay.DB) {
 			UptimeSuccessCount: currUptimeSuccess,
 		}
 
-		err := cache.UpdateAddress(ctx, &pb.Node{Id: nodeID})
+		err := cache.UpdateAddress(ctx, &pb.Node{Id: nodeID}, "", newNodeDefaults)
 		require.NoError(t, err)
 
-		stats, err := cache.CreateStats(ctx, nodeID, nodeStats)
+		stats, err := cache.CreateStats(ctx, nodeID, seedReputation(nodeStats, 0.95, 1))
 		require.NoError(t, err)
 		assert.EqualValues(t, auditSuccessRatio, stats.AuditSuccessRatio)
 		assert.EqualValues(t, uptimeRatio, stats.UptimeRatio)
@@ -92,8 +122,8 @@ func testDatabase(ctx context.Context, t *testing.T, cache overlay.DB) {
 			{storj.NodeID{1}, 20, 20, 1.0, 20, 20, 1.0}, // good ratios => good
 			{storj.NodeID{2}, 5, 20, 0.25, 20, 20, 1},   // bad audit success, good uptime => bad
 			{storj.NodeID{3}, 20, 20, 1.0, 5, 20, 0.25}, // good audit success, bad uptime => bad
-			{storj.NodeID{4}, 0, 0, 0.0, 20, 20, 1.0},   // "bad" audit success, no audits => now considered bad
-			{storj.NodeID{5}, 20, 20, 1.0, 0, 0, 0.25},  // "bad" uptime success, no checks => now considered bad
+			{storj.NodeID{4}, 0, 0, 0.0, 20, 20, 1.0},   // "bad" audit success, no audits => good reputation
+			{storj.NodeID{5}, 20, 20, 1.0, 0, 0, 0.25},  // "bad" uptime success, no checks => good reputation
 			{storj.NodeID{6}, 0, 1, 0.0, 5, 5, .01},     // bad audit success exactly one audit => bad
 			{storj.NodeID{7}, 0, 20, 0.0, 20, 20, 1.0},  // impossible math, but good ratios => good
 		} {
@@ -106,10 +136,10 @@ func testDatabase(ctx context.Context, t *testing.T, cache overlay.DB) {
 				UptimeSuccessCount: tt.uptimeSuccessCount,
 			}
 
-			err := cache.UpdateAddress(ctx, &pb.Node{Id: tt.nodeID})
+			err := cache.UpdateAddress(ctx, &pb.Node{Id: tt.nodeID}, "", newNodeDefaults)
 			require.NoError(t, err)
 
-			_, err = cache.CreateStats(ctx, tt.nodeID, nodeStats)
+			_, err = cache.CreateStats(ctx, tt.nodeID, seedReputation(nodeStats, 0.95, 1))
 			require.NoError(t, err)
 		}
 
@@ -119,8 +149,8 @@ func testDatabase(ctx context.Context, t *testing.T, cache overlay.DB) {
 			storj.NodeID{5}, storj.NodeID{6},
 		}
 		criteria := &overlay.NodeCriteria{
-			AuditSuccessRatio:  0.5,
-			UptimeSuccessRatio: 0.5,
+			AuditReputationDQ:  0.6,
+			UptimeReputationDQ: 0.6,
 			OnlineWindow:       time.Hour,
 		}
 
@@ -129,15 +159,151 @@ func testDatabase(ctx context.Context, t *testing.T, cache overlay.DB) {
 
 		assert.Contains(t, invalid, storj.NodeID{2})
 		assert.Contains(t, invalid, storj.NodeID{3})
-		assert.Contains(t, invalid, storj.NodeID{4})
-		assert.Contains(t, invalid, storj.NodeID{5})
 		assert.Contains(t, invalid, storj.NodeID{6})
-		assert.Len(t, invalid, 5)
+		assert.Len(t, invalid, 3)
+	}
+
+	{ // TestReputationDecay
+		nodeID := storj.NodeID{11}
+		lambda, weight := 0.95, 1.0
+
+		err := cache.UpdateAddress(ctx, &pb.Node{Id: nodeID}, "", newNodeDefaults)
+		require.NoError(t, err)
+
+		stats, err := cache.CreateStats(ctx, nodeID, seedReputation(&overlay.NodeStats{
+			AuditCount:         100,
+			AuditSuccessCount:  100,
+			UptimeCount:        100,
+			UptimeSuccessCount: 100,
+		}, lambda, weight))
+		require.NoError(t, err)
+		assert.InDelta(t, 20, stats.AuditReputationAlpha, 1e-9)
+		assert.EqualValues(t, 0, stats.AuditReputationBeta)
+
+		alpha, beta := stats.AuditReputationAlpha, stats.AuditReputationBeta
+		for i := 0; i < 30; i++ {
+			stats, err = cache.UpdateStats(ctx, &overlay.UpdateRequest{
+				NodeID:       nodeID,
+				AuditSuccess: false,
+				IsUp:         true,
+				AuditLambda:  lambda,
+				AuditWeight:  weight,
+				UptimeLambda: lambda,
+				UptimeWeight: weight,
+			})
+			require.NoError(t, err)
+			alpha, beta = overlay.UpdateReputation(false, alpha, beta, lambda, weight)
+		}
+
+		assert.InDelta(t, alpha, stats.AuditReputationAlpha, 1e-9)
+		assert.InDelta(t, beta, stats.AuditReputationBeta, 1e-9)
+
+		// the recent failures outweigh the long history of successes
+		assert.True(t, stats.AuditSuccessRatio > 0.6)
+		assert.True(t, stats.AuditReputation() < 0.6)
+		assert.True(t, stats.UptimeReputation() > 0.99)
+
+		invalid, err := cache.KnownUnreliableOrOffline(ctx, &overlay.NodeCriteria{
+			AuditReputationDQ:  0.6,
+			UptimeReputationDQ: 0.6,
+			OnlineWindow:       time.Hour,
+		}, storj.NodeIDList{nodeID})
+		require.NoError(t, err)
+		assert.Equal(t, storj.NodeIDList{nodeID}, invalid)
+	}
+
+	{ // TestDisqualification
+		nodeID := storj.NodeID{12}
+		request := &overlay.UpdateRequest{
+			NodeID:       nodeID,
+			IsUp:         true,
+			AuditLambda:  0.95,
+			AuditWeight:  1,
+			AuditDQ:      0.6,
+			UptimeLambda: 0.95,
+			UptimeWeight: 1,
+		}
+
+		err := cache.UpdateAddress(ctx, &pb.Node{Id: nodeID}, "", newNodeDefaults)
+		require.NoError(t, err)
+
+		// a new node isn't disqualified by a single failed audit
+		request.AuditSuccess = false
+		stats, err := cache.UpdateStats(ctx, request)
+		require.NoError(t, err)
+		assert.Nil(t, stats.Disqualified)
+		assert.True(t, stats.AuditReputation() > 0.9)
+
+		for stats.Disqualified == nil {
+			require.True(t, stats.AuditReputation() >= 0.6)
+			stats, err = cache.UpdateStats(ctx, request)
+			require.NoError(t, err)
+		}
+		assert.True(t, stats.AuditReputation() < 0.6)
+		assert.EqualValues(t, 10, stats.AuditCount)
+		disqualified := *stats.Disqualified
+
+		// the node stays disqualified when its reputation recovers
+		request.AuditSuccess = true
+		for i := 0; i < 10; i++ {
+			stats, err = cache.UpdateStats(ctx, request)
+			require.NoError(t, err)
+		}
+		assert.True(t, stats.AuditReputation() > 0.6)
+		require.NotNil(t, stats.Disqualified)
+		assert.True(t, disqualified.Equal(*stats.Disqualified))
+
+		invalid, err := cache.KnownUnreliableOrOffline(ctx, &overlay.NodeCriteria{
+			OnlineWindow: time.Hour,
+		}, storj.NodeIDList{nodeID})
+		require.NoError(t, err)
+		assert.Equal(t, storj.NodeIDList{nodeID}, invalid)
+
+		nodes, err := cache.DisqualifiedNodes(ctx, 10)
+		require.NoError(t, err)
+		require.Len(t, nodes, 1)
+		assert.Equal(t, nodeID, nodes[0].NodeID)
+
+		err = cache.DisqualifyNode(ctx, storj.NodeID{255, 254}, time.Now())
+		assert.True(t, overlay.ErrNodeNotFound.Has(err))
+	}
+
+	{ // TestUptimeDoesNotDisqualify
+		nodeID := storj.NodeID{13}
+
+		err := cache.UpdateAddress(ctx, &pb.Node{Id: nodeID}, "", newNodeDefaults)
+		require.NoError(t, err)
+
+		for i := 0; i < 10; i++ {
+			_, err = cache.UpdateUptime(ctx, nodeID, false, 0.95, 1)
+			require.NoError(t, err)
+		}
+		stats, err := cache.UpdateStats(ctx, &overlay.UpdateRequest{
+			NodeID:       nodeID,
+			AuditSuccess: true,
+			IsUp:         false,
+			AuditLambda:  0.95,
+			AuditWeight:  1,
+			AuditDQ:      0.6,
+			UptimeLambda: 0.95,
+			UptimeWeight: 1,
+		})
+		require.NoError(t, err)
+		assert.True(t, stats.UptimeReputation() < 0.6)
+		assert.Nil(t, stats.Disqualified)
+
+		// the node isn't selected until its uptime reputation recovers
+		invalid, err := cache.KnownUnreliableOrOffline(ctx, &overlay.NodeCriteria{
+			UptimeReputationDQ: 0.6,
+			OnlineWindow:       time.Hour,
+		}, storj.NodeIDList{nodeID})
+		require.NoError(t, err)
+		assert.Equal(t, storj.NodeIDList{nodeID}, invalid)
 	}
 
 	{ // TestUpdateOperator
 		nodeID := storj.NodeID{10}
-		err := cache.UpdateAddress(ctx, &pb.Node{Id: nodeID})
+		err := cache.UpdateAddress(ctx, &pb.Node{Id: nodeID}, "", newNodeDefaults)
 		require.NoError(t, err)
 
 		update, err := cache.UpdateNodeInfo(ctx, nodeID, &pb.InfoResponse{
@@ -228,7 +394,7 @@ func testDatabase(ctx context.Context, t *testing.T, cache overlay.DB) {
 		assert.EqualValues(t, currUptimeSuccess, node.Reputation.UptimeSuccessCount)
 		assert.EqualValues(t, uptimeRatio, node.Reputation.UptimeRatio)
 
-		stats, err := cache.UpdateUptime(ctx, nodeID, false)
+		stats, err := cache.UpdateUptime(ctx, nodeID, false, 0.99, 1)
 		require.NoError(t, err)
 
 		currUptimeCount++
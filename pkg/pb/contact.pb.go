@@ -0,0 +1,361 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: contact.proto
+
+package pb
+
+import (
+	context "context"
+	fmt "fmt"
+	proto "github.com/gogo/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
+
+type CheckinRequest struct {
+	Address              string        `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Capacity             *NodeCapacity `protobuf:"bytes,2,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	Operator             *NodeOperator `protobuf:"bytes,3,opt,name=operator,proto3" json:"operator,omitempty"`
+	Version              *NodeVersion  `protobuf:"bytes,4,opt,name=version,proto3" json:"version,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *CheckinRequest) Reset()         { *m = CheckinRequest{} }
+func (m *CheckinRequest) String() string { return proto.CompactTextString(m) }
+func (*CheckinRequest) ProtoMessage()    {}
+func (*CheckinRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5036fff2565fb15, []int{0}
+}
+func (m *CheckinRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CheckinRequest.Unmarshal(m, b)
+}
+func (m *CheckinRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CheckinRequest.Marshal(b, m, deterministic)
+}
+func (m *CheckinRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckinRequest.Merge(m, src)
+}
+func (m *CheckinRequest) XXX_Size() int {
+	return xxx_messageInfo_CheckinRequest.Size(m)
+}
+func (m *CheckinRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckinRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CheckinRequest proto.InternalMessageInfo
+
+func (m *CheckinRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *CheckinRequest) GetCapacity() *NodeCapacity {
+	if m != nil {
+		return m.Capacity
+	}
+	return nil
+}
+
+func (m *CheckinRequest) GetOperator() *NodeOperator {
+	if m != nil {
+		return m.Operator
+	}
+	return nil
+}
+
+func (m *CheckinRequest) GetVersion() *NodeVersion {
+	if m != nil {
+		return m.Version
+	}
+	return nil
+}
+
+type CheckinResponse struct {
+	PingNodeSuccess      bool     `protobuf:"varint,1,opt,name=ping_node_success,json=pingNodeSuccess,proto3" json:"ping_node_success,omitempty"`
+	PingErrorMessage     string   `protobuf:"bytes,2,opt,name=ping_error_message,json=pingErrorMessage,proto3" json:"ping_error_message,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CheckinResponse) Reset()         { *m = CheckinResponse{} }
+func (m *CheckinResponse) String() string { return proto.CompactTextString(m) }
+func (*CheckinResponse) ProtoMessage()    {}
+func (*CheckinResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5036fff2565fb15, []int{1}
+}
+func (m *CheckinResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CheckinResponse.Unmarshal(m, b)
+}
+func (m *CheckinResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CheckinResponse.Marshal(b, m, deterministic)
+}
+func (m *CheckinResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckinResponse.Merge(m, src)
+}
+func (m *CheckinResponse) XXX_Size() int {
+	return xxx_messageInfo_CheckinResponse.Size(m)
+}
+func (m *CheckinResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckinResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CheckinResponse proto.InternalMessageInfo
+
+func (m *CheckinResponse) GetPingNodeSuccess() bool {
+	if m != nil {
+		return m.PingNodeSuccess
+	}
+	return false
+}
+
+func (m *CheckinResponse) GetPingErrorMessage() string {
+	if m != nil {
+		return m.PingErrorMessage
+	}
+	return ""
+}
+
+type ContactPingRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ContactPingRequest) Reset()         { *m = ContactPingRequest{} }
+func (m *ContactPingRequest) String() string { return proto.CompactTextString(m) }
+func (*ContactPingRequest) ProtoMessage()    {}
+func (*ContactPingRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5036fff2565fb15, []int{2}
+}
+func (m *ContactPingRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ContactPingRequest.Unmarshal(m, b)
+}
+func (m *ContactPingRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ContactPingRequest.Marshal(b, m, deterministic)
+}
+func (m *ContactPingRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContactPingRequest.Merge(m, src)
+}
+func (m *ContactPingRequest) XXX_Size() int {
+	return xxx_messageInfo_ContactPingRequest.Size(m)
+}
+func (m *ContactPingRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContactPingRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContactPingRequest proto.InternalMessageInfo
+
+type ContactPingResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ContactPingResponse) Reset()         { *m = ContactPingResponse{} }
+func (m *ContactPingResponse) String() string { return proto.CompactTextString(m) }
+func (*ContactPingResponse) ProtoMessage()    {}
+func (*ContactPingResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5036fff2565fb15, []int{3}
+}
+func (m *ContactPingResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ContactPingResponse.Unmarshal(m, b)
+}
+func (m *ContactPingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ContactPingResponse.Marshal(b, m, deterministic)
+}
+func (m *ContactPingResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContactPingResponse.Merge(m, src)
+}
+func (m *ContactPingResponse) XXX_Size() int {
+	return xxx_messageInfo_ContactPingResponse.Size(m)
+}
+func (m *ContactPingResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContactPingResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContactPingResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*CheckinRequest)(nil), "contact.CheckinRequest")
+	proto.RegisterType((*CheckinResponse)(nil), "contact.CheckinResponse")
+	proto.RegisterType((*ContactPingRequest)(nil), "contact.ContactPingRequest")
+	proto.RegisterType((*ContactPingResponse)(nil), "contact.ContactPingResponse")
+}
+
+func init() { proto.RegisterFile("contact.proto", fileDescriptor_a5036fff2565fb15) }
+
+var fileDescriptor_a5036fff2565fb15 = []byte{
+	// 304 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0x41, 0x4f, 0x02, 0x31,
+	0x10, 0x85, 0xb3, 0x48, 0x5c, 0x18, 0xa3, 0xc8, 0xa8, 0x71, 0x83, 0x1e, 0xc8, 0x9e, 0x88, 0x9a,
+	0x3d, 0xe0, 0xd5, 0x93, 0xc8, 0x51, 0x25, 0x35, 0xf1, 0xe0, 0x85, 0x2c, 0xdd, 0x09, 0x6e, 0x88,
+	0x6d, 0x6d, 0x8b, 0x89, 0xbf, 0xcc, 0xbf, 0x67, 0xba, 0xed, 0x2e, 0x41, 0x3c, 0xf6, 0xbd, 0xaf,
+	0xaf, 0xaf, 0x33, 0x70, 0xc8, 0xa5, 0xb0, 0x39, 0xb7, 0x99, 0xd2, 0xd2, 0x4a, 0x8c, 0xc3, 0x71,
+	0x00, 0x42, 0x16, 0xe4, 0xc5, 0xf4, 0x27, 0x82, 0xa3, 0xc9, 0x3b, 0xf1, 0x55, 0x29, 0x18, 0x7d,
+	0xae, 0xc9, 0x58, 0x4c, 0x20, 0xce, 0x8b, 0x42, 0x93, 0x31, 0x49, 0x34, 0x8c, 0x46, 0x5d, 0x56,
+	0x1f, 0x31, 0x83, 0x0e, 0xcf, 0x55, 0xce, 0x4b, 0xfb, 0x9d, 0xb4, 0x86, 0xd1, 0xe8, 0x60, 0x8c,
+	0x59, 0x95, 0xf5, 0x24, 0x0b, 0x9a, 0x04, 0x87, 0x35, 0x8c, 0xe3, 0xa5, 0x22, 0x9d, 0x5b, 0xa9,
+	0x93, 0xbd, 0xbf, 0xfc, 0x73, 0x70, 0x58, 0xc3, 0xe0, 0x35, 0xc4, 0x5f, 0xa4, 0x4d, 0x29, 0x45,
+	0xd2, 0xae, 0xf0, 0xfe, 0x06, 0x7f, 0xf5, 0x06, 0xab, 0x89, 0x74, 0x05, 0xbd, 0xa6, 0xb8, 0x51,
+	0x52, 0x18, 0xc2, 0x2b, 0xe8, 0xab, 0x52, 0x2c, 0xe7, 0xee, 0xd2, 0xdc, 0xac, 0x39, 0xaf, 0xff,
+	0xd0, 0x61, 0x3d, 0x67, 0xb8, 0x9c, 0x17, 0x2f, 0xe3, 0x0d, 0x60, 0xc5, 0x92, 0xd6, 0x52, 0xcf,
+	0x3f, 0xc8, 0x98, 0x7c, 0x49, 0xd5, 0xaf, 0xba, 0xec, 0xd8, 0x39, 0x53, 0x67, 0x3c, 0x7a, 0x3d,
+	0x3d, 0x05, 0x9c, 0xf8, 0xe9, 0xcd, 0x4a, 0xb1, 0x0c, 0x93, 0x4a, 0xcf, 0xe0, 0x64, 0x4b, 0xf5,
+	0x35, 0xc6, 0x0f, 0xd0, 0x76, 0x2f, 0xe1, 0x1d, 0xc4, 0xa1, 0x21, 0x9e, 0x67, 0xf5, 0x2e, 0xb6,
+	0x87, 0x3d, 0x48, 0x76, 0x8d, 0x90, 0x32, 0x83, 0x38, 0x84, 0xe3, 0x14, 0x3a, 0xb3, 0x50, 0x1f,
+	0x2f, 0x36, 0x17, 0x76, 0x0a, 0x0d, 0x2e, 0xff, 0x37, 0x7d, 0xe2, 0x7d, 0xfb, 0xad, 0xa5, 0x16,
+	0x8b, 0xfd, 0x6a, 0xf1, 0xb7, 0xbf, 0x03, 0x00, 0x66, 0xf6, 0x9b, 0x27, 0x1e, 0x02, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// NodeClient is the client API for Node service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type NodeClient interface {
+	// Checkin reports the node info, the satellite pings the node back to verify that it's reachable.
+	Checkin(ctx context.Context, in *CheckinRequest, opts ...grpc.CallOption) (*CheckinResponse, error)
+}
+
+type nodeClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewNodeClient(cc *grpc.ClientConn) NodeClient {
+	return &nodeClient{cc}
+}
+
+func (c *nodeClient) Checkin(ctx context.Context, in *CheckinRequest, opts ...grpc.CallOption) (*CheckinResponse, error) {
+	out := new(CheckinResponse)
+	err := c.cc.Invoke(ctx, "/contact.Node/Checkin", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// NodeServer is the server API for Node service.
+type NodeServer interface {
+	// Checkin reports the node info, the satellite pings the node back to verify that it's reachable.
+	Checkin(context.Context, *CheckinRequest) (*CheckinResponse, error)
+}
+
+func RegisterNodeServer(s *grpc.Server, srv NodeServer) {
+	s.RegisterService(&_Node_serviceDesc, srv)
+}
+
+func _Node_Checkin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckinRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServer).Checkin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/contact.Node/Checkin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServer).Checkin(ctx, req.(*CheckinRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Node_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "contact.Node",
+	HandlerType: (*NodeServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Checkin",
+			Handler:    _Node_Checkin_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "contact.proto",
+}
+
+// ContactClient is the client API for Contact service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ContactClient interface {
+	PingNode(ctx context.Context, in *ContactPingRequest, opts ...grpc.CallOption) (*ContactPingResponse, error)
+}
+
+type contactClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewContactClient(cc *grpc.ClientConn) ContactClient {
+	return &contactClient{cc}
+}
+
+func (c *contactClient) PingNode(ctx context.Context, in *ContactPingRequest, opts ...grpc.CallOption) (*ContactPingResponse, error) {
+	out := new(ContactPingResponse)
+	err := c.cc.Invoke(ctx, "/contact.Contact/PingNode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ContactServer is the server API for Contact service.
+type ContactServer interface {
+	PingNode(context.Context, *ContactPingRequest) (*ContactPingResponse, error)
+}
+
+func RegisterContactServer(s *grpc.Server, srv ContactServer) {
+	s.RegisterService(&_Contact_serviceDesc, srv)
+}
+
+func _Contact_PingNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ContactPingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContactServer).PingNode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/contact.Contact/PingNode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContactServer).PingNode(ctx, req.(*ContactPingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Contact_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "contact.Contact",
+	HandlerType: (*ContactServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "PingNode",
+			Handler:    _Contact_PingNode_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "contact.proto",
+}
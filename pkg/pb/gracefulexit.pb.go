@@ -0,0 +1,925 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: gracefulexit.proto
+
+package pb
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
+
+type ExitFailed_Reason int32
+
+const (
+	ExitFailed_OVERALL_FAILURE_PERCENTAGE_EXCEEDED ExitFailed_Reason = 0
+	ExitFailed_INACTIVE_TIMEFRAME_EXCEEDED         ExitFailed_Reason = 1
+)
+
+var ExitFailed_Reason_name = map[int32]string{
+	0: "OVERALL_FAILURE_PERCENTAGE_EXCEEDED",
+	1: "INACTIVE_TIMEFRAME_EXCEEDED",
+}
+
+var ExitFailed_Reason_value = map[string]int32{
+	"OVERALL_FAILURE_PERCENTAGE_EXCEEDED": 0,
+	"INACTIVE_TIMEFRAME_EXCEEDED":         1,
+}
+
+func (x ExitFailed_Reason) String() string {
+	return proto.EnumName(ExitFailed_Reason_name, int32(x))
+}
+
+func (ExitFailed_Reason) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_8f0acbf2ce5fa631, []int{7, 0}
+}
+
+type TransferFailed_Error int32
+
+const (
+	TransferFailed_NOT_FOUND                TransferFailed_Error = 0
+	TransferFailed_STORAGE_NODE_UNAVAILABLE TransferFailed_Error = 1
+	TransferFailed_HASH_VERIFICATION        TransferFailed_Error = 2
+	TransferFailed_UNKNOWN                  TransferFailed_Error = 3
+)
+
+var TransferFailed_Error_name = map[int32]string{
+	0: "NOT_FOUND",
+	1: "STORAGE_NODE_UNAVAILABLE",
+	2: "HASH_VERIFICATION",
+	3: "UNKNOWN",
+}
+
+var TransferFailed_Error_value = map[string]int32{
+	"NOT_FOUND":                0,
+	"STORAGE_NODE_UNAVAILABLE": 1,
+	"HASH_VERIFICATION":        2,
+	"UNKNOWN":                  3,
+}
+
+func (x TransferFailed_Error) String() string {
+	return proto.EnumName(TransferFailed_Error_name, int32(x))
+}
+
+func (TransferFailed_Error) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_8f0acbf2ce5fa631, []int{10, 0}
+}
+
+type InitiateGracefulExitRequest struct {
+	NodeId               NodeID   `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3,customtype=NodeID" json:"node_id"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *InitiateGracefulExitRequest) Reset()         { *m = InitiateGracefulExitRequest{} }
+func (m *InitiateGracefulExitRequest) String() string { return proto.CompactTextString(m) }
+func (*InitiateGracefulExitRequest) ProtoMessage()    {}
+func (*InitiateGracefulExitRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8f0acbf2ce5fa631, []int{0}
+}
+func (m *InitiateGracefulExitRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InitiateGracefulExitRequest.Unmarshal(m, b)
+}
+func (m *InitiateGracefulExitRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InitiateGracefulExitRequest.Marshal(b, m, deterministic)
+}
+func (m *InitiateGracefulExitRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InitiateGracefulExitRequest.Merge(m, src)
+}
+func (m *InitiateGracefulExitRequest) XXX_Size() int {
+	return xxx_messageInfo_InitiateGracefulExitRequest.Size(m)
+}
+func (m *InitiateGracefulExitRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_InitiateGracefulExitRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InitiateGracefulExitRequest proto.InternalMessageInfo
+
+type GetExitProgressRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetExitProgressRequest) Reset()         { *m = GetExitProgressRequest{} }
+func (m *GetExitProgressRequest) String() string { return proto.CompactTextString(m) }
+func (*GetExitProgressRequest) ProtoMessage()    {}
+func (*GetExitProgressRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8f0acbf2ce5fa631, []int{1}
+}
+func (m *GetExitProgressRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetExitProgressRequest.Unmarshal(m, b)
+}
+func (m *GetExitProgressRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetExitProgressRequest.Marshal(b, m, deterministic)
+}
+func (m *GetExitProgressRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetExitProgressRequest.Merge(m, src)
+}
+func (m *GetExitProgressRequest) XXX_Size() int {
+	return xxx_messageInfo_GetExitProgressRequest.Size(m)
+}
+func (m *GetExitProgressRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetExitProgressRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetExitProgressRequest proto.InternalMessageInfo
+
+type GetExitProgressResponse struct {
+	Progress             []*ExitProgress `protobuf:"bytes,1,rep,name=progress,proto3" json:"progress,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *GetExitProgressResponse) Reset()         { *m = GetExitProgressResponse{} }
+func (m *GetExitProgressResponse) String() string { return proto.CompactTextString(m) }
+func (*GetExitProgressResponse) ProtoMessage()    {}
+func (*GetExitProgressResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8f0acbf2ce5fa631, []int{2}
+}
+func (m *GetExitProgressResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetExitProgressResponse.Unmarshal(m, b)
+}
+func (m *GetExitProgressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetExitProgressResponse.Marshal(b, m, deterministic)
+}
+func (m *GetExitProgressResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetExitProgressResponse.Merge(m, src)
+}
+func (m *GetExitProgressResponse) XXX_Size() int {
+	return xxx_messageInfo_GetExitProgressResponse.Size(m)
+}
+func (m *GetExitProgressResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetExitProgressResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetExitProgressResponse proto.InternalMessageInfo
+
+func (m *GetExitProgressResponse) GetProgress() []*ExitProgress {
+	if m != nil {
+		return m.Progress
+	}
+	return nil
+}
+
+type ExitProgress struct {
+	SatelliteId          NodeID   `protobuf:"bytes,1,opt,name=satellite_id,json=satelliteId,proto3,customtype=NodeID" json:"satellite_id"`
+	BytesTransferred     int64    `protobuf:"varint,2,opt,name=bytes_transferred,json=bytesTransferred,proto3" json:"bytes_transferred,omitempty"`
+	PiecesTransferred    int64    `protobuf:"varint,3,opt,name=pieces_transferred,json=piecesTransferred,proto3" json:"pieces_transferred,omitempty"`
+	PiecesFailed         int64    `protobuf:"varint,4,opt,name=pieces_failed,json=piecesFailed,proto3" json:"pieces_failed,omitempty"`
+	Finished             bool     `protobuf:"varint,5,opt,name=finished,proto3" json:"finished,omitempty"`
+	Successful           bool     `protobuf:"varint,6,opt,name=successful,proto3" json:"successful,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExitProgress) Reset()         { *m = ExitProgress{} }
+func (m *ExitProgress) String() string { return proto.CompactTextString(m) }
+func (*ExitProgress) ProtoMessage()    {}
+func (*ExitProgress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8f0acbf2ce5fa631, []int{3}
+}
+func (m *ExitProgress) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExitProgress.Unmarshal(m, b)
+}
+func (m *ExitProgress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExitProgress.Marshal(b, m, deterministic)
+}
+func (m *ExitProgress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExitProgress.Merge(m, src)
+}
+func (m *ExitProgress) XXX_Size() int {
+	return xxx_messageInfo_ExitProgress.Size(m)
+}
+func (m *ExitProgress) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExitProgress.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExitProgress proto.InternalMessageInfo
+
+func (m *ExitProgress) GetBytesTransferred() int64 {
+	if m != nil {
+		return m.BytesTransferred
+	}
+	return 0
+}
+
+func (m *ExitProgress) GetPiecesTransferred() int64 {
+	if m != nil {
+		return m.PiecesTransferred
+	}
+	return 0
+}
+
+func (m *ExitProgress) GetPiecesFailed() int64 {
+	if m != nil {
+		return m.PiecesFailed
+	}
+	return 0
+}
+
+func (m *ExitProgress) GetFinished() bool {
+	if m != nil {
+		return m.Finished
+	}
+	return false
+}
+
+func (m *ExitProgress) GetSuccessful() bool {
+	if m != nil {
+		return m.Successful
+	}
+	return false
+}
+
+// Expected order of messages from the satellite:
+//
+//	repeated
+//	   <- TransferPiece
+//	   StorageNodeMessage ->
+//	   <- DeletePiece, when the transfer succeeded
+//	<- ExitCompleted or ExitFailed
+type TransferPiece struct {
+	// piece id on the exiting storage node
+	PieceId PieceID `protobuf:"bytes,1,opt,name=piece_id,json=pieceId,proto3,customtype=PieceID" json:"piece_id"`
+	// order limit for uploading the piece to the replacement node
+	AddressedOrderLimit  *AddressedOrderLimit `protobuf:"bytes,2,opt,name=addressed_order_limit,json=addressedOrderLimit,proto3" json:"addressed_order_limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *TransferPiece) Reset()         { *m = TransferPiece{} }
+func (m *TransferPiece) String() string { return proto.CompactTextString(m) }
+func (*TransferPiece) ProtoMessage()    {}
+func (*TransferPiece) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8f0acbf2ce5fa631, []int{4}
+}
+func (m *TransferPiece) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TransferPiece.Unmarshal(m, b)
+}
+func (m *TransferPiece) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TransferPiece.Marshal(b, m, deterministic)
+}
+func (m *TransferPiece) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransferPiece.Merge(m, src)
+}
+func (m *TransferPiece) XXX_Size() int {
+	return xxx_messageInfo_TransferPiece.Size(m)
+}
+func (m *TransferPiece) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransferPiece.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransferPiece proto.InternalMessageInfo
+
+func (m *TransferPiece) GetAddressedOrderLimit() *AddressedOrderLimit {
+	if m != nil {
+		return m.AddressedOrderLimit
+	}
+	return nil
+}
+
+// DeletePiece is sent after the satellite has recorded a successful transfer
+type DeletePiece struct {
+	PieceId              PieceID  `protobuf:"bytes,1,opt,name=piece_id,json=pieceId,proto3,customtype=PieceID" json:"piece_id"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeletePiece) Reset()         { *m = DeletePiece{} }
+func (m *DeletePiece) String() string { return proto.CompactTextString(m) }
+func (*DeletePiece) ProtoMessage()    {}
+func (*DeletePiece) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8f0acbf2ce5fa631, []int{5}
+}
+func (m *DeletePiece) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeletePiece.Unmarshal(m, b)
+}
+func (m *DeletePiece) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeletePiece.Marshal(b, m, deterministic)
+}
+func (m *DeletePiece) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeletePiece.Merge(m, src)
+}
+func (m *DeletePiece) XXX_Size() int {
+	return xxx_messageInfo_DeletePiece.Size(m)
+}
+func (m *DeletePiece) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeletePiece.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeletePiece proto.InternalMessageInfo
+
+type ExitCompleted struct {
+	PiecesTransferred    int64    `protobuf:"varint,1,opt,name=pieces_transferred,json=piecesTransferred,proto3" json:"pieces_transferred,omitempty"`
+	PiecesFailed         int64    `protobuf:"varint,2,opt,name=pieces_failed,json=piecesFailed,proto3" json:"pieces_failed,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExitCompleted) Reset()         { *m = ExitCompleted{} }
+func (m *ExitCompleted) String() string { return proto.CompactTextString(m) }
+func (*ExitCompleted) ProtoMessage()    {}
+func (*ExitCompleted) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8f0acbf2ce5fa631, []int{6}
+}
+func (m *ExitCompleted) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExitCompleted.Unmarshal(m, b)
+}
+func (m *ExitCompleted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExitCompleted.Marshal(b, m, deterministic)
+}
+func (m *ExitCompleted) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExitCompleted.Merge(m, src)
+}
+func (m *ExitCompleted) XXX_Size() int {
+	return xxx_messageInfo_ExitCompleted.Size(m)
+}
+func (m *ExitCompleted) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExitCompleted.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExitCompleted proto.InternalMessageInfo
+
+func (m *ExitCompleted) GetPiecesTransferred() int64 {
+	if m != nil {
+		return m.PiecesTransferred
+	}
+	return 0
+}
+
+func (m *ExitCompleted) GetPiecesFailed() int64 {
+	if m != nil {
+		return m.PiecesFailed
+	}
+	return 0
+}
+
+type ExitFailed struct {
+	Reason               ExitFailed_Reason `protobuf:"varint,1,opt,name=reason,proto3,enum=gracefulexit.ExitFailed_Reason" json:"reason,omitempty"`
+	PiecesTransferred    int64             `protobuf:"varint,2,opt,name=pieces_transferred,json=piecesTransferred,proto3" json:"pieces_transferred,omitempty"`
+	PiecesFailed         int64             `protobuf:"varint,3,opt,name=pieces_failed,json=piecesFailed,proto3" json:"pieces_failed,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ExitFailed) Reset()         { *m = ExitFailed{} }
+func (m *ExitFailed) String() string { return proto.CompactTextString(m) }
+func (*ExitFailed) ProtoMessage()    {}
+func (*ExitFailed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8f0acbf2ce5fa631, []int{7}
+}
+func (m *ExitFailed) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExitFailed.Unmarshal(m, b)
+}
+func (m *ExitFailed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExitFailed.Marshal(b, m, deterministic)
+}
+func (m *ExitFailed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExitFailed.Merge(m, src)
+}
+func (m *ExitFailed) XXX_Size() int {
+	return xxx_messageInfo_ExitFailed.Size(m)
+}
+func (m *ExitFailed) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExitFailed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExitFailed proto.InternalMessageInfo
+
+func (m *ExitFailed) GetReason() ExitFailed_Reason {
+	if m != nil {
+		return m.Reason
+	}
+	return ExitFailed_OVERALL_FAILURE_PERCENTAGE_EXCEEDED
+}
+
+func (m *ExitFailed) GetPiecesTransferred() int64 {
+	if m != nil {
+		return m.PiecesTransferred
+	}
+	return 0
+}
+
+func (m *ExitFailed) GetPiecesFailed() int64 {
+	if m != nil {
+		return m.PiecesFailed
+	}
+	return 0
+}
+
+type SatelliteMessage struct {
+	TransferPiece        *TransferPiece `protobuf:"bytes,1,opt,name=transfer_piece,json=transferPiece,proto3" json:"transfer_piece,omitempty"`
+	ExitCompleted        *ExitCompleted `protobuf:"bytes,2,opt,name=exit_completed,json=exitCompleted,proto3" json:"exit_completed,omitempty"`
+	ExitFailed           *ExitFailed    `protobuf:"bytes,3,opt,name=exit_failed,json=exitFailed,proto3" json:"exit_failed,omitempty"`
+	DeletePiece          *DeletePiece   `protobuf:"bytes,4,opt,name=delete_piece,json=deletePiece,proto3" json:"delete_piece,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *SatelliteMessage) Reset()         { *m = SatelliteMessage{} }
+func (m *SatelliteMessage) String() string { return proto.CompactTextString(m) }
+func (*SatelliteMessage) ProtoMessage()    {}
+func (*SatelliteMessage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8f0acbf2ce5fa631, []int{8}
+}
+func (m *SatelliteMessage) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SatelliteMessage.Unmarshal(m, b)
+}
+func (m *SatelliteMessage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SatelliteMessage.Marshal(b, m, deterministic)
+}
+func (m *SatelliteMessage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SatelliteMessage.Merge(m, src)
+}
+func (m *SatelliteMessage) XXX_Size() int {
+	return xxx_messageInfo_SatelliteMessage.Size(m)
+}
+func (m *SatelliteMessage) XXX_DiscardUnknown() {
+	xxx_messageInfo_SatelliteMessage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SatelliteMessage proto.InternalMessageInfo
+
+func (m *SatelliteMessage) GetTransferPiece() *TransferPiece {
+	if m != nil {
+		return m.TransferPiece
+	}
+	return nil
+}
+
+func (m *SatelliteMessage) GetExitCompleted() *ExitCompleted {
+	if m != nil {
+		return m.ExitCompleted
+	}
+	return nil
+}
+
+func (m *SatelliteMessage) GetExitFailed() *ExitFailed {
+	if m != nil {
+		return m.ExitFailed
+	}
+	return nil
+}
+
+func (m *SatelliteMessage) GetDeletePiece() *DeletePiece {
+	if m != nil {
+		return m.DeletePiece
+	}
+	return nil
+}
+
+type TransferSucceeded struct {
+	PieceId PieceID `protobuf:"bytes,1,opt,name=piece_id,json=pieceId,proto3,customtype=PieceID" json:"piece_id"`
+	// piece hash signed by the original uploader
+	OriginalPieceHash *PieceHash `protobuf:"bytes,2,opt,name=original_piece_hash,json=originalPieceHash,proto3" json:"original_piece_hash,omitempty"`
+	// piece hash signed by the replacement node
+	ReplacementPieceHash *PieceHash `protobuf:"bytes,3,opt,name=replacement_piece_hash,json=replacementPieceHash,proto3" json:"replacement_piece_hash,omitempty"`
+	// order limit the original piece was uploaded with
+	OriginalOrderLimit   *OrderLimit2 `protobuf:"bytes,4,opt,name=original_order_limit,json=originalOrderLimit,proto3" json:"original_order_limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *TransferSucceeded) Reset()         { *m = TransferSucceeded{} }
+func (m *TransferSucceeded) String() string { return proto.CompactTextString(m) }
+func (*TransferSucceeded) ProtoMessage()    {}
+func (*TransferSucceeded) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8f0acbf2ce5fa631, []int{9}
+}
+func (m *TransferSucceeded) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TransferSucceeded.Unmarshal(m, b)
+}
+func (m *TransferSucceeded) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TransferSucceeded.Marshal(b, m, deterministic)
+}
+func (m *TransferSucceeded) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransferSucceeded.Merge(m, src)
+}
+func (m *TransferSucceeded) XXX_Size() int {
+	return xxx_messageInfo_TransferSucceeded.Size(m)
+}
+func (m *TransferSucceeded) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransferSucceeded.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransferSucceeded proto.InternalMessageInfo
+
+func (m *TransferSucceeded) GetOriginalPieceHash() *PieceHash {
+	if m != nil {
+		return m.OriginalPieceHash
+	}
+	return nil
+}
+
+func (m *TransferSucceeded) GetReplacementPieceHash() *PieceHash {
+	if m != nil {
+		return m.ReplacementPieceHash
+	}
+	return nil
+}
+
+func (m *TransferSucceeded) GetOriginalOrderLimit() *OrderLimit2 {
+	if m != nil {
+		return m.OriginalOrderLimit
+	}
+	return nil
+}
+
+type TransferFailed struct {
+	PieceId              PieceID              `protobuf:"bytes,1,opt,name=piece_id,json=pieceId,proto3,customtype=PieceID" json:"piece_id"`
+	Error                TransferFailed_Error `protobuf:"varint,2,opt,name=error,proto3,enum=gracefulexit.TransferFailed_Error" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *TransferFailed) Reset()         { *m = TransferFailed{} }
+func (m *TransferFailed) String() string { return proto.CompactTextString(m) }
+func (*TransferFailed) ProtoMessage()    {}
+func (*TransferFailed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8f0acbf2ce5fa631, []int{10}
+}
+func (m *TransferFailed) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TransferFailed.Unmarshal(m, b)
+}
+func (m *TransferFailed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TransferFailed.Marshal(b, m, deterministic)
+}
+func (m *TransferFailed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransferFailed.Merge(m, src)
+}
+func (m *TransferFailed) XXX_Size() int {
+	return xxx_messageInfo_TransferFailed.Size(m)
+}
+func (m *TransferFailed) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransferFailed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransferFailed proto.InternalMessageInfo
+
+func (m *TransferFailed) GetError() TransferFailed_Error {
+	if m != nil {
+		return m.Error
+	}
+	return TransferFailed_NOT_FOUND
+}
+
+type StorageNodeMessage struct {
+	Succeeded            *TransferSucceeded `protobuf:"bytes,1,opt,name=succeeded,proto3" json:"succeeded,omitempty"`
+	Failed               *TransferFailed    `protobuf:"bytes,2,opt,name=failed,proto3" json:"failed,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *StorageNodeMessage) Reset()         { *m = StorageNodeMessage{} }
+func (m *StorageNodeMessage) String() string { return proto.CompactTextString(m) }
+func (*StorageNodeMessage) ProtoMessage()    {}
+func (*StorageNodeMessage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8f0acbf2ce5fa631, []int{11}
+}
+func (m *StorageNodeMessage) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StorageNodeMessage.Unmarshal(m, b)
+}
+func (m *StorageNodeMessage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StorageNodeMessage.Marshal(b, m, deterministic)
+}
+func (m *StorageNodeMessage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StorageNodeMessage.Merge(m, src)
+}
+func (m *StorageNodeMessage) XXX_Size() int {
+	return xxx_messageInfo_StorageNodeMessage.Size(m)
+}
+func (m *StorageNodeMessage) XXX_DiscardUnknown() {
+	xxx_messageInfo_StorageNodeMessage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StorageNodeMessage proto.InternalMessageInfo
+
+func (m *StorageNodeMessage) GetSucceeded() *TransferSucceeded {
+	if m != nil {
+		return m.Succeeded
+	}
+	return nil
+}
+
+func (m *StorageNodeMessage) GetFailed() *TransferFailed {
+	if m != nil {
+		return m.Failed
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("gracefulexit.ExitFailed_Reason", ExitFailed_Reason_name, ExitFailed_Reason_value)
+	proto.RegisterEnum("gracefulexit.TransferFailed_Error", TransferFailed_Error_name, TransferFailed_Error_value)
+	proto.RegisterType((*InitiateGracefulExitRequest)(nil), "gracefulexit.InitiateGracefulExitRequest")
+	proto.RegisterType((*GetExitProgressRequest)(nil), "gracefulexit.GetExitProgressRequest")
+	proto.RegisterType((*GetExitProgressResponse)(nil), "gracefulexit.GetExitProgressResponse")
+	proto.RegisterType((*ExitProgress)(nil), "gracefulexit.ExitProgress")
+	proto.RegisterType((*TransferPiece)(nil), "gracefulexit.TransferPiece")
+	proto.RegisterType((*DeletePiece)(nil), "gracefulexit.DeletePiece")
+	proto.RegisterType((*ExitCompleted)(nil), "gracefulexit.ExitCompleted")
+	proto.RegisterType((*ExitFailed)(nil), "gracefulexit.ExitFailed")
+	proto.RegisterType((*SatelliteMessage)(nil), "gracefulexit.SatelliteMessage")
+	proto.RegisterType((*TransferSucceeded)(nil), "gracefulexit.TransferSucceeded")
+	proto.RegisterType((*TransferFailed)(nil), "gracefulexit.TransferFailed")
+	proto.RegisterType((*StorageNodeMessage)(nil), "gracefulexit.StorageNodeMessage")
+}
+
+func init() { proto.RegisterFile("gracefulexit.proto", fileDescriptor_8f0acbf2ce5fa631) }
+
+var fileDescriptor_8f0acbf2ce5fa631 = []byte{
+	// 948 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x56, 0xcf, 0x6e, 0xe3, 0xd4,
+	0x17, 0x8e, 0x93, 0x36, 0xe9, 0x1c, 0x27, 0x99, 0xe4, 0xb6, 0x9d, 0x9f, 0x7f, 0xe9, 0x30, 0x89,
+	0x3c, 0xa0, 0x09, 0x20, 0x22, 0x30, 0x08, 0x18, 0x09, 0x16, 0x6e, 0xe2, 0xb4, 0x16, 0xa9, 0xd3,
+	0xde, 0xa4, 0x65, 0xc4, 0xc6, 0xb8, 0xf1, 0x49, 0x6a, 0x94, 0xda, 0xc1, 0xd7, 0x95, 0x86, 0x47,
+	0x60, 0xc3, 0x02, 0x5e, 0x8a, 0x25, 0x1b, 0x36, 0x2c, 0xe6, 0x0d, 0x78, 0x05, 0x84, 0x7c, 0x6d,
+	0x27, 0x76, 0x93, 0x96, 0xe9, 0xf2, 0x9e, 0xf3, 0x9d, 0xcf, 0xe7, 0xcf, 0x77, 0xef, 0x31, 0x90,
+	0x99, 0x6f, 0x4d, 0x70, 0x7a, 0x33, 0xc7, 0xd7, 0x4e, 0xd0, 0x59, 0xf8, 0x5e, 0xe0, 0x91, 0x72,
+	0xda, 0xd6, 0x80, 0x99, 0x37, 0xf3, 0x22, 0x4f, 0xa3, 0x7a, 0x8d, 0x81, 0xe5, 0xb8, 0xd3, 0xe4,
+	0x5c, 0xf6, 0x7c, 0x1b, 0x7d, 0x16, 0x9d, 0xe4, 0x3e, 0x1c, 0xe8, 0xae, 0x13, 0x38, 0x56, 0x80,
+	0x47, 0x31, 0x83, 0xf6, 0xda, 0x09, 0x28, 0xfe, 0x78, 0x83, 0x2c, 0x20, 0x2f, 0xa0, 0xe4, 0x7a,
+	0x36, 0x9a, 0x8e, 0x2d, 0x09, 0x2d, 0xa1, 0x5d, 0x3e, 0xac, 0xfe, 0xfe, 0xa6, 0x99, 0xfb, 0xeb,
+	0x4d, 0xb3, 0x68, 0x78, 0x36, 0xea, 0x3d, 0x5a, 0x0c, 0xdd, 0xba, 0x2d, 0x4b, 0xf0, 0xe4, 0x08,
+	0x83, 0x30, 0xf4, 0xd4, 0xf7, 0x66, 0x3e, 0x32, 0x16, 0x53, 0xc8, 0x67, 0xf0, 0xbf, 0x35, 0x0f,
+	0x5b, 0x78, 0x2e, 0x43, 0xf2, 0x39, 0xec, 0x2c, 0x62, 0x9b, 0x24, 0xb4, 0x0a, 0x6d, 0x51, 0x69,
+	0x74, 0x32, 0xb5, 0x65, 0xa2, 0x96, 0x58, 0xf9, 0x1f, 0x01, 0xca, 0x69, 0x17, 0xf9, 0x04, 0xca,
+	0xcc, 0x0a, 0x70, 0x3e, 0x77, 0x82, 0x7b, 0x72, 0x15, 0x97, 0x18, 0xdd, 0x26, 0x1f, 0x42, 0xfd,
+	0xf2, 0xa7, 0x00, 0x99, 0x19, 0xf8, 0x96, 0xcb, 0xa6, 0xe8, 0xfb, 0x68, 0x4b, 0xf9, 0x96, 0xd0,
+	0x2e, 0xd0, 0x1a, 0x77, 0x8c, 0x57, 0x76, 0xf2, 0x11, 0x90, 0x85, 0x83, 0x93, 0x5b, 0xe8, 0x02,
+	0x47, 0xd7, 0x23, 0x4f, 0x1a, 0xfe, 0x1c, 0x2a, 0x31, 0x7c, 0x6a, 0x39, 0x73, 0xb4, 0xa5, 0x2d,
+	0x8e, 0x2c, 0x47, 0xc6, 0x3e, 0xb7, 0x91, 0x06, 0xec, 0x4c, 0x1d, 0xd7, 0x61, 0x57, 0x68, 0x4b,
+	0xdb, 0x2d, 0xa1, 0xbd, 0x43, 0x97, 0x67, 0xf2, 0x0c, 0x80, 0xdd, 0x4c, 0x26, 0xc8, 0xd8, 0xf4,
+	0x66, 0x2e, 0x15, 0xb9, 0x37, 0x65, 0x91, 0x7f, 0x11, 0xa0, 0x92, 0x7c, 0xf0, 0x34, 0x24, 0x25,
+	0x1f, 0xc0, 0x0e, 0x67, 0x5f, 0x55, 0xff, 0x38, 0xae, 0xbe, 0xc4, 0x01, 0x7a, 0x8f, 0x96, 0x38,
+	0x40, 0xb7, 0xc9, 0x19, 0xec, 0x5b, 0xb6, 0x1d, 0x36, 0x0e, 0x6d, 0x93, 0xab, 0xc1, 0x9c, 0x3b,
+	0xd7, 0x4e, 0xc0, 0xcb, 0x17, 0x95, 0x77, 0x3a, 0x4b, 0xc5, 0xa8, 0x09, 0x6c, 0x18, 0xa2, 0x06,
+	0x21, 0x88, 0xee, 0x5a, 0xeb, 0x46, 0xf9, 0x25, 0x88, 0x3d, 0x9c, 0x63, 0x80, 0x0f, 0xce, 0x46,
+	0x9e, 0x40, 0x25, 0x9c, 0x65, 0xd7, 0xbb, 0x5e, 0x84, 0x04, 0x77, 0x35, 0x5b, 0x78, 0xeb, 0x66,
+	0xe7, 0xd7, 0x9b, 0x2d, 0xff, 0x2d, 0x00, 0x84, 0x5f, 0x89, 0x7b, 0xff, 0x05, 0x14, 0x7d, 0xb4,
+	0x98, 0xe7, 0x72, 0xda, 0xaa, 0xd2, 0x5c, 0x97, 0x5d, 0x84, 0xec, 0x50, 0x0e, 0xa3, 0x31, 0xfc,
+	0x8e, 0xdc, 0xf2, 0x6f, 0x9d, 0x5b, 0x61, 0x43, 0x6e, 0x14, 0x8a, 0xd1, 0x57, 0xc8, 0x0b, 0x78,
+	0x3e, 0xbc, 0xd0, 0xa8, 0x3a, 0x18, 0x98, 0x7d, 0x55, 0x1f, 0x9c, 0x53, 0xcd, 0x3c, 0xd5, 0x68,
+	0x57, 0x33, 0xc6, 0xea, 0x91, 0x66, 0x6a, 0xaf, 0xba, 0x9a, 0xd6, 0xd3, 0x7a, 0xb5, 0x1c, 0x69,
+	0xc2, 0x81, 0x6e, 0xa8, 0xdd, 0xb1, 0x7e, 0xa1, 0x99, 0x63, 0xfd, 0x44, 0xeb, 0x53, 0xf5, 0x24,
+	0x05, 0x10, 0xe4, 0xdf, 0xf2, 0x50, 0x1b, 0x25, 0x6a, 0x3f, 0x41, 0xc6, 0xac, 0x19, 0x92, 0x43,
+	0xa8, 0x26, 0x59, 0x9b, 0x3c, 0x03, 0x5e, 0xbd, 0xa8, 0x1c, 0x64, 0xab, 0xcf, 0x08, 0x8b, 0x56,
+	0x82, 0xf4, 0x31, 0xe4, 0x08, 0x41, 0xe6, 0x24, 0x19, 0x97, 0x94, 0xdf, 0xc4, 0x91, 0x99, 0x28,
+	0xad, 0x60, 0x66, 0xc0, 0x2f, 0x41, 0xe4, 0x1c, 0xa9, 0x9e, 0x88, 0x8a, 0x74, 0xd7, 0x08, 0x28,
+	0xe0, 0x6a, 0x70, 0x5f, 0x41, 0xd9, 0xe6, 0x3a, 0x8b, 0x0b, 0xd8, 0xe2, 0xb1, 0xff, 0xcf, 0xc6,
+	0xa6, 0x94, 0x48, 0x45, 0x7b, 0x75, 0x90, 0x7f, 0xcd, 0x43, 0x3d, 0xa9, 0x6e, 0x14, 0xde, 0x26,
+	0xb4, 0xd1, 0x7e, 0xd0, 0xd5, 0x51, 0x61, 0xd7, 0xf3, 0x9d, 0x99, 0xe3, 0x5a, 0xf3, 0x28, 0x03,
+	0xf3, 0xca, 0x62, 0x57, 0x71, 0x0f, 0xea, 0x9d, 0xf8, 0x69, 0xe5, 0x61, 0xc7, 0x16, 0xbb, 0xa2,
+	0xf5, 0x04, 0xbd, 0x34, 0x91, 0x23, 0x78, 0xe2, 0xe3, 0x62, 0x6e, 0x4d, 0xf0, 0x1a, 0xdd, 0x20,
+	0xcd, 0x52, 0xb8, 0x8b, 0x65, 0x2f, 0x15, 0xb0, 0x22, 0xd2, 0x60, 0x6f, 0x99, 0x4b, 0xfa, 0x16,
+	0x47, 0x3d, 0xd9, 0x4d, 0x68, 0x56, 0xb7, 0x54, 0xa1, 0x24, 0x09, 0x48, 0x5d, 0xdd, 0x3f, 0x04,
+	0xa8, 0x26, 0x4d, 0x89, 0xbb, 0xfc, 0x90, 0x8e, 0x7c, 0x09, 0xdb, 0xe8, 0xfb, 0x9e, 0xcf, 0x7b,
+	0x50, 0x55, 0xe4, 0xcd, 0x5a, 0x8a, 0x6f, 0x93, 0x16, 0x22, 0x69, 0x14, 0x20, 0xbf, 0x82, 0x6d,
+	0x7e, 0x26, 0x15, 0x78, 0x64, 0x0c, 0xc7, 0x66, 0x7f, 0x78, 0x6e, 0x84, 0xe2, 0x7e, 0x0a, 0xd2,
+	0x68, 0x3c, 0xa4, 0xa1, 0xe4, 0x8d, 0x61, 0x4f, 0x33, 0xcf, 0x0d, 0xf5, 0x42, 0xd5, 0x07, 0xea,
+	0xe1, 0x40, 0xab, 0x09, 0x64, 0x1f, 0xea, 0xc7, 0xea, 0xe8, 0xd8, 0xbc, 0xd0, 0xa8, 0xde, 0xd7,
+	0xbb, 0xea, 0x58, 0x1f, 0x1a, 0xb5, 0x3c, 0x11, 0xa1, 0x74, 0x6e, 0x7c, 0x63, 0x0c, 0xbf, 0x35,
+	0x6a, 0x05, 0xf9, 0x67, 0x01, 0xc8, 0x28, 0xf0, 0x7c, 0x6b, 0x86, 0xe1, 0xd3, 0x9f, 0xe8, 0xff,
+	0x6b, 0x78, 0xc4, 0x92, 0xa9, 0xc7, 0xd2, 0x6f, 0x6e, 0x4e, 0x77, 0x29, 0x0e, 0xba, 0x8a, 0x20,
+	0x9f, 0x41, 0x31, 0xf5, 0xc2, 0x88, 0xca, 0xd3, 0xfb, 0x4a, 0xa5, 0x31, 0x56, 0xf9, 0x53, 0x80,
+	0x5a, 0x98, 0x44, 0x7a, 0xbb, 0x12, 0x13, 0xf6, 0x36, 0x6d, 0x5d, 0xf2, 0x7e, 0x96, 0xf2, 0x9e,
+	0xcd, 0xdc, 0xb8, 0x67, 0x53, 0xca, 0x39, 0xf2, 0x3d, 0x3c, 0xbe, 0xb5, 0x74, 0xc9, 0xbb, 0xd9,
+	0x80, 0xcd, 0xdb, 0xba, 0xf1, 0xde, 0x7f, 0xa0, 0xa2, 0xcd, 0x2d, 0xe7, 0x94, 0x1f, 0x60, 0x7f,
+	0xf9, 0xc0, 0x64, 0x6a, 0x38, 0x83, 0xd2, 0xa9, 0xef, 0x4d, 0xc2, 0x4f, 0xb6, 0xb2, 0x64, 0xeb,
+	0x23, 0x69, 0x3c, 0xbb, 0x85, 0xb8, 0xf5, 0x64, 0xc9, 0xb9, 0xb6, 0xf0, 0xb1, 0x70, 0xb8, 0xf5,
+	0x5d, 0x7e, 0x71, 0x79, 0x59, 0xe4, 0x7f, 0x2c, 0x9f, 0xfe, 0x3b, 0x00, 0x0f, 0x32, 0x3a, 0x70,
+	0xff, 0x08, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// NodeGracefulExitClient is the client API for NodeGracefulExit service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type NodeGracefulExitClient interface {
+	// InitiateGracefulExit initiates the graceful exit from a satellite
+	InitiateGracefulExit(ctx context.Context, in *InitiateGracefulExitRequest, opts ...grpc.CallOption) (*ExitProgress, error)
+	// GetExitProgress returns the progress of all the graceful exits
+	GetExitProgress(ctx context.Context, in *GetExitProgressRequest, opts ...grpc.CallOption) (*GetExitProgressResponse, error)
+}
+
+type nodeGracefulExitClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewNodeGracefulExitClient(cc *grpc.ClientConn) NodeGracefulExitClient {
+	return &nodeGracefulExitClient{cc}
+}
+
+func (c *nodeGracefulExitClient) InitiateGracefulExit(ctx context.Context, in *InitiateGracefulExitRequest, opts ...grpc.CallOption) (*ExitProgress, error) {
+	out := new(ExitProgress)
+	err := c.cc.Invoke(ctx, "/gracefulexit.NodeGracefulExit/InitiateGracefulExit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeGracefulExitClient) GetExitProgress(ctx context.Context, in *GetExitProgressRequest, opts ...grpc.CallOption) (*GetExitProgressResponse, error) {
+	out := new(GetExitProgressResponse)
+	err := c.cc.Invoke(ctx, "/gracefulexit.NodeGracefulExit/GetExitProgress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// NodeGracefulExitServer is the server API for NodeGracefulExit service.
+type NodeGracefulExitServer interface {
+	// InitiateGracefulExit initiates the graceful exit from a satellite
+	InitiateGracefulExit(context.Context, *InitiateGracefulExitRequest) (*ExitProgress, error)
+	// GetExitProgress returns the progress of all the graceful exits
+	GetExitProgress(context.Context, *GetExitProgressRequest) (*GetExitProgressResponse, error)
+}
+
+func RegisterNodeGracefulExitServer(s *grpc.Server, srv NodeGracefulExitServer) {
+	s.RegisterService(&_NodeGracefulExit_serviceDesc, srv)
+}
+
+func _NodeGracefulExit_InitiateGracefulExit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InitiateGracefulExitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeGracefulExitServer).InitiateGracefulExit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gracefulexit.NodeGracefulExit/InitiateGracefulExit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeGracefulExitServer).InitiateGracefulExit(ctx, req.(*InitiateGracefulExitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NodeGracefulExit_GetExitProgress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetExitProgressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeGracefulExitServer).GetExitProgress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gracefulexit.NodeGracefulExit/GetExitProgress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeGracefulExitServer).GetExitProgress(ctx, req.(*GetExitProgressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _NodeGracefulExit_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "gracefulexit.NodeGracefulExit",
+	HandlerType: (*NodeGracefulExitServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "InitiateGracefulExit",
+			Handler:    _NodeGracefulExit_InitiateGracefulExit_Handler,
+		},
+		{
+			MethodName: "GetExitProgress",
+			Handler:    _NodeGracefulExit_GetExitProgress_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "gracefulexit.proto",
+}
+
+// SatelliteGracefulExitClient is the client API for SatelliteGracefulExit service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type SatelliteGracefulExitClient interface {
+	// Process is called by storage nodes to receive the pieces to transfer and report the transfers
+	Process(ctx context.Context, opts ...grpc.CallOption) (SatelliteGracefulExit_ProcessClient, error)
+}
+
+type satelliteGracefulExitClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewSatelliteGracefulExitClient(cc *grpc.ClientConn) SatelliteGracefulExitClient {
+	return &satelliteGracefulExitClient{cc}
+}
+
+func (c *satelliteGracefulExitClient) Process(ctx context.Context, opts ...grpc.CallOption) (SatelliteGracefulExit_ProcessClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_SatelliteGracefulExit_serviceDesc.Streams[0], "/gracefulexit.SatelliteGracefulExit/Process", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &satelliteGracefulExitProcessClient{stream}
+	return x, nil
+}
+
+type SatelliteGracefulExit_ProcessClient interface {
+	Send(*StorageNodeMessage) error
+	Recv() (*SatelliteMessage, error)
+	grpc.ClientStream
+}
+
+type satelliteGracefulExitProcessClient struct {
+	grpc.ClientStream
+}
+
+func (x *satelliteGracefulExitProcessClient) Send(m *StorageNodeMessage) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *satelliteGracefulExitProcessClient) Recv() (*SatelliteMessage, error) {
+	m := new(SatelliteMessage)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// SatelliteGracefulExitServer is the server API for SatelliteGracefulExit service.
+type SatelliteGracefulExitServer interface {
+	// Process is called by storage nodes to receive the pieces to transfer and report the transfers
+	Process(SatelliteGracefulExit_ProcessServer) error
+}
+
+func RegisterSatelliteGracefulExitServer(s *grpc.Server, srv SatelliteGracefulExitServer) {
+	s.RegisterService(&_SatelliteGracefulExit_serviceDesc, srv)
+}
+
+func _SatelliteGracefulExit_Process_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(SatelliteGracefulExitServer).Process(&satelliteGracefulExitProcessServer{stream})
+}
+
+type SatelliteGracefulExit_ProcessServer interface {
+	Send(*SatelliteMessage) error
+	Recv() (*StorageNodeMessage, error)
+	grpc.ServerStream
+}
+
+type satelliteGracefulExitProcessServer struct {
+	grpc.ServerStream
+}
+
+func (x *satelliteGracefulExitProcessServer) Send(m *SatelliteMessage) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *satelliteGracefulExitProcessServer) Recv() (*StorageNodeMessage, error) {
+	m := new(StorageNodeMessage)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+var _SatelliteGracefulExit_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "gracefulexit.SatelliteGracefulExit",
+	HandlerType: (*SatelliteGracefulExitServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Process",
+			Handler:       _SatelliteGracefulExit_Process_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "gracefulexit.proto",
+}
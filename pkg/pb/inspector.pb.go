@@ -368,6 +368,324 @@ func (m *CreateStatsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_CreateStatsResponse proto.InternalMessageInfo
 
+// VettingPipeline
+type VettingPipelineRequest struct {
+	Limit                int32    `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *VettingPipelineRequest) Reset()         { *m = VettingPipelineRequest{} }
+func (m *VettingPipelineRequest) String() string { return proto.CompactTextString(m) }
+func (*VettingPipelineRequest) ProtoMessage()    {}
+func (*VettingPipelineRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{7}
+}
+func (m *VettingPipelineRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VettingPipelineRequest.Unmarshal(m, b)
+}
+func (m *VettingPipelineRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VettingPipelineRequest.Marshal(b, m, deterministic)
+}
+func (m *VettingPipelineRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VettingPipelineRequest.Merge(m, src)
+}
+func (m *VettingPipelineRequest) XXX_Size() int {
+	return xxx_messageInfo_VettingPipelineRequest.Size(m)
+}
+func (m *VettingPipelineRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_VettingPipelineRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VettingPipelineRequest proto.InternalMessageInfo
+
+func (m *VettingPipelineRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type VettingPipelineResponse struct {
+	RequiredAuditCount   int64           `protobuf:"varint,1,opt,name=required_audit_count,json=requiredAuditCount,proto3" json:"required_audit_count,omitempty"`
+	RequiredUptimeCount  int64           `protobuf:"varint,2,opt,name=required_uptime_count,json=requiredUptimeCount,proto3" json:"required_uptime_count,omitempty"`
+	Nodes                []*UnvettedNode `protobuf:"bytes,3,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *VettingPipelineResponse) Reset()         { *m = VettingPipelineResponse{} }
+func (m *VettingPipelineResponse) String() string { return proto.CompactTextString(m) }
+func (*VettingPipelineResponse) ProtoMessage()    {}
+func (*VettingPipelineResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{8}
+}
+func (m *VettingPipelineResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VettingPipelineResponse.Unmarshal(m, b)
+}
+func (m *VettingPipelineResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VettingPipelineResponse.Marshal(b, m, deterministic)
+}
+func (m *VettingPipelineResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VettingPipelineResponse.Merge(m, src)
+}
+func (m *VettingPipelineResponse) XXX_Size() int {
+	return xxx_messageInfo_VettingPipelineResponse.Size(m)
+}
+func (m *VettingPipelineResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_VettingPipelineResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VettingPipelineResponse proto.InternalMessageInfo
+
+func (m *VettingPipelineResponse) GetRequiredAuditCount() int64 {
+	if m != nil {
+		return m.RequiredAuditCount
+	}
+	return 0
+}
+
+func (m *VettingPipelineResponse) GetRequiredUptimeCount() int64 {
+	if m != nil {
+		return m.RequiredUptimeCount
+	}
+	return 0
+}
+
+func (m *VettingPipelineResponse) GetNodes() []*UnvettedNode {
+	if m != nil {
+		return m.Nodes
+	}
+	return nil
+}
+
+type UnvettedNode struct {
+	NodeId               NodeID   `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3,customtype=NodeID" json:"node_id"`
+	AuditCount           int64    `protobuf:"varint,2,opt,name=audit_count,json=auditCount,proto3" json:"audit_count,omitempty"`
+	UptimeCount          int64    `protobuf:"varint,3,opt,name=uptime_count,json=uptimeCount,proto3" json:"uptime_count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UnvettedNode) Reset()         { *m = UnvettedNode{} }
+func (m *UnvettedNode) String() string { return proto.CompactTextString(m) }
+func (*UnvettedNode) ProtoMessage()    {}
+func (*UnvettedNode) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{9}
+}
+func (m *UnvettedNode) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UnvettedNode.Unmarshal(m, b)
+}
+func (m *UnvettedNode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UnvettedNode.Marshal(b, m, deterministic)
+}
+func (m *UnvettedNode) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnvettedNode.Merge(m, src)
+}
+func (m *UnvettedNode) XXX_Size() int {
+	return xxx_messageInfo_UnvettedNode.Size(m)
+}
+func (m *UnvettedNode) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnvettedNode.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnvettedNode proto.InternalMessageInfo
+
+func (m *UnvettedNode) GetAuditCount() int64 {
+	if m != nil {
+		return m.AuditCount
+	}
+	return 0
+}
+
+func (m *UnvettedNode) GetUptimeCount() int64 {
+	if m != nil {
+		return m.UptimeCount
+	}
+	return 0
+}
+
+// DisqualifyNode
+type DisqualifyNodeRequest struct {
+	NodeId               NodeID   `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3,customtype=NodeID" json:"node_id"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DisqualifyNodeRequest) Reset()         { *m = DisqualifyNodeRequest{} }
+func (m *DisqualifyNodeRequest) String() string { return proto.CompactTextString(m) }
+func (*DisqualifyNodeRequest) ProtoMessage()    {}
+func (*DisqualifyNodeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{10}
+}
+func (m *DisqualifyNodeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DisqualifyNodeRequest.Unmarshal(m, b)
+}
+func (m *DisqualifyNodeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DisqualifyNodeRequest.Marshal(b, m, deterministic)
+}
+func (m *DisqualifyNodeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DisqualifyNodeRequest.Merge(m, src)
+}
+func (m *DisqualifyNodeRequest) XXX_Size() int {
+	return xxx_messageInfo_DisqualifyNodeRequest.Size(m)
+}
+func (m *DisqualifyNodeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DisqualifyNodeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DisqualifyNodeRequest proto.InternalMessageInfo
+
+type DisqualifyNodeResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DisqualifyNodeResponse) Reset()         { *m = DisqualifyNodeResponse{} }
+func (m *DisqualifyNodeResponse) String() string { return proto.CompactTextString(m) }
+func (*DisqualifyNodeResponse) ProtoMessage()    {}
+func (*DisqualifyNodeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{11}
+}
+func (m *DisqualifyNodeResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DisqualifyNodeResponse.Unmarshal(m, b)
+}
+func (m *DisqualifyNodeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DisqualifyNodeResponse.Marshal(b, m, deterministic)
+}
+func (m *DisqualifyNodeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DisqualifyNodeResponse.Merge(m, src)
+}
+func (m *DisqualifyNodeResponse) XXX_Size() int {
+	return xxx_messageInfo_DisqualifyNodeResponse.Size(m)
+}
+func (m *DisqualifyNodeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DisqualifyNodeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DisqualifyNodeResponse proto.InternalMessageInfo
+
+// DisqualifiedNodes
+type DisqualifiedNodesRequest struct {
+	Limit                int32    `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DisqualifiedNodesRequest) Reset()         { *m = DisqualifiedNodesRequest{} }
+func (m *DisqualifiedNodesRequest) String() string { return proto.CompactTextString(m) }
+func (*DisqualifiedNodesRequest) ProtoMessage()    {}
+func (*DisqualifiedNodesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{12}
+}
+func (m *DisqualifiedNodesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DisqualifiedNodesRequest.Unmarshal(m, b)
+}
+func (m *DisqualifiedNodesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DisqualifiedNodesRequest.Marshal(b, m, deterministic)
+}
+func (m *DisqualifiedNodesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DisqualifiedNodesRequest.Merge(m, src)
+}
+func (m *DisqualifiedNodesRequest) XXX_Size() int {
+	return xxx_messageInfo_DisqualifiedNodesRequest.Size(m)
+}
+func (m *DisqualifiedNodesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DisqualifiedNodesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DisqualifiedNodesRequest proto.InternalMessageInfo
+
+func (m *DisqualifiedNodesRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type DisqualifiedNodesResponse struct {
+	Nodes                []*DisqualifiedNode `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *DisqualifiedNodesResponse) Reset()         { *m = DisqualifiedNodesResponse{} }
+func (m *DisqualifiedNodesResponse) String() string { return proto.CompactTextString(m) }
+func (*DisqualifiedNodesResponse) ProtoMessage()    {}
+func (*DisqualifiedNodesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{13}
+}
+func (m *DisqualifiedNodesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DisqualifiedNodesResponse.Unmarshal(m, b)
+}
+func (m *DisqualifiedNodesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DisqualifiedNodesResponse.Marshal(b, m, deterministic)
+}
+func (m *DisqualifiedNodesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DisqualifiedNodesResponse.Merge(m, src)
+}
+func (m *DisqualifiedNodesResponse) XXX_Size() int {
+	return xxx_messageInfo_DisqualifiedNodesResponse.Size(m)
+}
+func (m *DisqualifiedNodesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DisqualifiedNodesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DisqualifiedNodesResponse proto.InternalMessageInfo
+
+func (m *DisqualifiedNodesResponse) GetNodes() []*DisqualifiedNode {
+	if m != nil {
+		return m.Nodes
+	}
+	return nil
+}
+
+type DisqualifiedNode struct {
+	NodeId               NodeID               `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3,customtype=NodeID" json:"node_id"`
+	Disqualified         *timestamp.Timestamp `protobuf:"bytes,2,opt,name=disqualified,proto3" json:"disqualified,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *DisqualifiedNode) Reset()         { *m = DisqualifiedNode{} }
+func (m *DisqualifiedNode) String() string { return proto.CompactTextString(m) }
+func (*DisqualifiedNode) ProtoMessage()    {}
+func (*DisqualifiedNode) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{14}
+}
+func (m *DisqualifiedNode) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DisqualifiedNode.Unmarshal(m, b)
+}
+func (m *DisqualifiedNode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DisqualifiedNode.Marshal(b, m, deterministic)
+}
+func (m *DisqualifiedNode) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DisqualifiedNode.Merge(m, src)
+}
+func (m *DisqualifiedNode) XXX_Size() int {
+	return xxx_messageInfo_DisqualifiedNode.Size(m)
+}
+func (m *DisqualifiedNode) XXX_DiscardUnknown() {
+	xxx_messageInfo_DisqualifiedNode.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DisqualifiedNode proto.InternalMessageInfo
+
+func (m *DisqualifiedNode) GetDisqualified() *timestamp.Timestamp {
+	if m != nil {
+		return m.Disqualified
+	}
+	return nil
+}
+
 // CountNodes
 type CountNodesResponse struct {
 	Count                int64    `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
@@ -380,7 +698,7 @@ func (m *CountNodesResponse) Reset()         { *m = CountNodesResponse{} }
 func (m *CountNodesResponse) String() string { return proto.CompactTextString(m) }
 func (*CountNodesResponse) ProtoMessage()    {}
 func (*CountNodesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a07d9034b2dd9d26, []int{7}
+	return fileDescriptor_a07d9034b2dd9d26, []int{15}
 }
 func (m *CountNodesResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CountNodesResponse.Unmarshal(m, b)
@@ -417,7 +735,7 @@ func (m *CountNodesRequest) Reset()         { *m = CountNodesRequest{} }
 func (m *CountNodesRequest) String() string { return proto.CompactTextString(m) }
 func (*CountNodesRequest) ProtoMessage()    {}
 func (*CountNodesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a07d9034b2dd9d26, []int{8}
+	return fileDescriptor_a07d9034b2dd9d26, []int{16}
 }
 func (m *CountNodesRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CountNodesRequest.Unmarshal(m, b)
@@ -447,7 +765,7 @@ func (m *GetBucketListRequest) Reset()         { *m = GetBucketListRequest{} }
 func (m *GetBucketListRequest) String() string { return proto.CompactTextString(m) }
 func (*GetBucketListRequest) ProtoMessage()    {}
 func (*GetBucketListRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a07d9034b2dd9d26, []int{9}
+	return fileDescriptor_a07d9034b2dd9d26, []int{17}
 }
 func (m *GetBucketListRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetBucketListRequest.Unmarshal(m, b)
@@ -478,7 +796,7 @@ func (m *GetBucketListResponse) Reset()         { *m = GetBucketListResponse{} }
 func (m *GetBucketListResponse) String() string { return proto.CompactTextString(m) }
 func (*GetBucketListResponse) ProtoMessage()    {}
 func (*GetBucketListResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a07d9034b2dd9d26, []int{10}
+	return fileDescriptor_a07d9034b2dd9d26, []int{18}
 }
 func (m *GetBucketListResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetBucketListResponse.Unmarshal(m, b)
@@ -518,7 +836,7 @@ func (m *GetBucketListResponse_Bucket) Reset()         { *m = GetBucketListRespo
 func (m *GetBucketListResponse_Bucket) String() string { return proto.CompactTextString(m) }
 func (*GetBucketListResponse_Bucket) ProtoMessage()    {}
 func (*GetBucketListResponse_Bucket) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a07d9034b2dd9d26, []int{10, 0}
+	return fileDescriptor_a07d9034b2dd9d26, []int{18, 0}
 }
 func (m *GetBucketListResponse_Bucket) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetBucketListResponse_Bucket.Unmarshal(m, b)
@@ -563,7 +881,7 @@ func (m *GetBucketsRequest) Reset()         { *m = GetBucketsRequest{} }
 func (m *GetBucketsRequest) String() string { return proto.CompactTextString(m) }
 func (*GetBucketsRequest) ProtoMessage()    {}
 func (*GetBucketsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a07d9034b2dd9d26, []int{11}
+	return fileDescriptor_a07d9034b2dd9d26, []int{19}
 }
 func (m *GetBucketsRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetBucketsRequest.Unmarshal(m, b)
@@ -595,7 +913,7 @@ func (m *GetBucketsResponse) Reset()         { *m = GetBucketsResponse{} }
 func (m *GetBucketsResponse) String() string { return proto.CompactTextString(m) }
 func (*GetBucketsResponse) ProtoMessage()    {}
 func (*GetBucketsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a07d9034b2dd9d26, []int{12}
+	return fileDescriptor_a07d9034b2dd9d26, []int{20}
 }
 func (m *GetBucketsResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetBucketsResponse.Unmarshal(m, b)
@@ -634,7 +952,7 @@ func (m *GetBucketRequest) Reset()         { *m = GetBucketRequest{} }
 func (m *GetBucketRequest) String() string { return proto.CompactTextString(m) }
 func (*GetBucketRequest) ProtoMessage()    {}
 func (*GetBucketRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a07d9034b2dd9d26, []int{13}
+	return fileDescriptor_a07d9034b2dd9d26, []int{21}
 }
 func (m *GetBucketRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetBucketRequest.Unmarshal(m, b)
@@ -666,7 +984,7 @@ func (m *GetBucketResponse) Reset()         { *m = GetBucketResponse{} }
 func (m *GetBucketResponse) String() string { return proto.CompactTextString(m) }
 func (*GetBucketResponse) ProtoMessage()    {}
 func (*GetBucketResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a07d9034b2dd9d26, []int{14}
+	return fileDescriptor_a07d9034b2dd9d26, []int{22}
 }
 func (m *GetBucketResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetBucketResponse.Unmarshal(m, b)
@@ -704,7 +1022,7 @@ func (m *Bucket) Reset()         { *m = Bucket{} }
 func (m *Bucket) String() string { return proto.CompactTextString(m) }
 func (*Bucket) ProtoMessage()    {}
 func (*Bucket) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a07d9034b2dd9d26, []int{15}
+	return fileDescriptor_a07d9034b2dd9d26, []int{23}
 }
 func (m *Bucket) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Bucket.Unmarshal(m, b)
@@ -742,7 +1060,7 @@ func (m *BucketList) Reset()         { *m = BucketList{} }
 func (m *BucketList) String() string { return proto.CompactTextString(m) }
 func (*BucketList) ProtoMessage()    {}
 func (*BucketList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a07d9034b2dd9d26, []int{16}
+	return fileDescriptor_a07d9034b2dd9d26, []int{24}
 }
 func (m *BucketList) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_BucketList.Unmarshal(m, b)
@@ -782,7 +1100,7 @@ func (m *PingNodeRequest) Reset()         { *m = PingNodeRequest{} }
 func (m *PingNodeRequest) String() string { return proto.CompactTextString(m) }
 func (*PingNodeRequest) ProtoMessage()    {}
 func (*PingNodeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a07d9034b2dd9d26, []int{17}
+	return fileDescriptor_a07d9034b2dd9d26, []int{25}
 }
 func (m *PingNodeRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PingNodeRequest.Unmarshal(m, b)
@@ -820,7 +1138,7 @@ func (m *PingNodeResponse) Reset()         { *m = PingNodeResponse{} }
 func (m *PingNodeResponse) String() string { return proto.CompactTextString(m) }
 func (*PingNodeResponse) ProtoMessage()    {}
 func (*PingNodeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a07d9034b2dd9d26, []int{18}
+	return fileDescriptor_a07d9034b2dd9d26, []int{26}
 }
 func (m *PingNodeResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PingNodeResponse.Unmarshal(m, b)
@@ -859,7 +1177,7 @@ func (m *LookupNodeRequest) Reset()         { *m = LookupNodeRequest{} }
 func (m *LookupNodeRequest) String() string { return proto.CompactTextString(m) }
 func (*LookupNodeRequest) ProtoMessage()    {}
 func (*LookupNodeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a07d9034b2dd9d26, []int{19}
+	return fileDescriptor_a07d9034b2dd9d26, []int{27}
 }
 func (m *LookupNodeRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_LookupNodeRequest.Unmarshal(m, b)
@@ -905,7 +1223,7 @@ func (m *LookupNodeResponse) Reset()         { *m = LookupNodeResponse{} }
 func (m *LookupNodeResponse) String() string { return proto.CompactTextString(m) }
 func (*LookupNodeResponse) ProtoMessage()    {}
 func (*LookupNodeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a07d9034b2dd9d26, []int{20}
+	return fileDescriptor_a07d9034b2dd9d26, []int{28}
 }
 func (m *LookupNodeResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_LookupNodeResponse.Unmarshal(m, b)
@@ -951,7 +1269,7 @@ func (m *NodeInfoRequest) Reset()         { *m = NodeInfoRequest{} }
 func (m *NodeInfoRequest) String() string { return proto.CompactTextString(m) }
 func (*NodeInfoRequest) ProtoMessage()    {}
 func (*NodeInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a07d9034b2dd9d26, []int{21}
+	return fileDescriptor_a07d9034b2dd9d26, []int{29}
 }
 func (m *NodeInfoRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_NodeInfoRequest.Unmarshal(m, b)
@@ -992,7 +1310,7 @@ func (m *NodeInfoResponse) Reset()         { *m = NodeInfoResponse{} }
 func (m *NodeInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*NodeInfoResponse) ProtoMessage()    {}
 func (*NodeInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a07d9034b2dd9d26, []int{22}
+	return fileDescriptor_a07d9034b2dd9d26, []int{30}
 }
 func (m *NodeInfoResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_NodeInfoResponse.Unmarshal(m, b)
@@ -1053,7 +1371,7 @@ func (m *FindNearRequest) Reset()         { *m = FindNearRequest{} }
 func (m *FindNearRequest) String() string { return proto.CompactTextString(m) }
 func (*FindNearRequest) ProtoMessage()    {}
 func (*FindNearRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a07d9034b2dd9d26, []int{23}
+	return fileDescriptor_a07d9034b2dd9d26, []int{31}
 }
 func (m *FindNearRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_FindNearRequest.Unmarshal(m, b)
@@ -1091,7 +1409,7 @@ func (m *FindNearResponse) Reset()         { *m = FindNearResponse{} }
 func (m *FindNearResponse) String() string { return proto.CompactTextString(m) }
 func (*FindNearResponse) ProtoMessage()    {}
 func (*FindNearResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a07d9034b2dd9d26, []int{24}
+	return fileDescriptor_a07d9034b2dd9d26, []int{32}
 }
 func (m *FindNearResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_FindNearResponse.Unmarshal(m, b)
@@ -1128,7 +1446,7 @@ func (m *DumpNodesRequest) Reset()         { *m = DumpNodesRequest{} }
 func (m *DumpNodesRequest) String() string { return proto.CompactTextString(m) }
 func (*DumpNodesRequest) ProtoMessage()    {}
 func (*DumpNodesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a07d9034b2dd9d26, []int{25}
+	return fileDescriptor_a07d9034b2dd9d26, []int{33}
 }
 func (m *DumpNodesRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DumpNodesRequest.Unmarshal(m, b)
@@ -1159,7 +1477,7 @@ func (m *DumpNodesResponse) Reset()         { *m = DumpNodesResponse{} }
 func (m *DumpNodesResponse) String() string { return proto.CompactTextString(m) }
 func (*DumpNodesResponse) ProtoMessage()    {}
 func (*DumpNodesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a07d9034b2dd9d26, []int{26}
+	return fileDescriptor_a07d9034b2dd9d26, []int{34}
 }
 func (m *DumpNodesResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DumpNodesResponse.Unmarshal(m, b)
@@ -1196,7 +1514,7 @@ func (m *StatsRequest) Reset()         { *m = StatsRequest{} }
 func (m *StatsRequest) String() string { return proto.CompactTextString(m) }
 func (*StatsRequest) ProtoMessage()    {}
 func (*StatsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a07d9034b2dd9d26, []int{27}
+	return fileDescriptor_a07d9034b2dd9d26, []int{35}
 }
 func (m *StatsRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StatsRequest.Unmarshal(m, b)
@@ -1232,7 +1550,7 @@ func (m *StatSummaryResponse) Reset()         { *m = StatSummaryResponse{} }
 func (m *StatSummaryResponse) String() string { return proto.CompactTextString(m) }
 func (*StatSummaryResponse) ProtoMessage()    {}
 func (*StatSummaryResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a07d9034b2dd9d26, []int{28}
+	return fileDescriptor_a07d9034b2dd9d26, []int{36}
 }
 func (m *StatSummaryResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StatSummaryResponse.Unmarshal(m, b)
@@ -1304,7 +1622,7 @@ func (m *DashboardRequest) Reset()         { *m = DashboardRequest{} }
 func (m *DashboardRequest) String() string { return proto.CompactTextString(m) }
 func (*DashboardRequest) ProtoMessage()    {}
 func (*DashboardRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a07d9034b2dd9d26, []int{29}
+	return fileDescriptor_a07d9034b2dd9d26, []int{37}
 }
 func (m *DashboardRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DashboardRequest.Unmarshal(m, b)
@@ -1334,6 +1652,8 @@ type DashboardResponse struct {
 	Uptime               *duration.Duration   `protobuf:"bytes,7,opt,name=uptime,proto3" json:"uptime,omitempty"`
 	LastPinged           *timestamp.Timestamp `protobuf:"bytes,8,opt,name=last_pinged,json=lastPinged,proto3" json:"last_pinged,omitempty"`
 	LastQueried          *timestamp.Timestamp `protobuf:"bytes,9,opt,name=last_queried,json=lastQueried,proto3" json:"last_queried,omitempty"`
+	CorruptPieces        int64                `protobuf:"varint,10,opt,name=corrupt_pieces,json=corruptPieces,proto3" json:"corrupt_pieces,omitempty"`
+	LastScrubbed         *timestamp.Timestamp `protobuf:"bytes,11,opt,name=last_scrubbed,json=lastScrubbed,proto3" json:"last_scrubbed,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
 	XXX_unrecognized     []byte               `json:"-"`
 	XXX_sizecache        int32                `json:"-"`
@@ -1343,7 +1663,7 @@ func (m *DashboardResponse) Reset()         { *m = DashboardResponse{} }
 func (m *DashboardResponse) String() string { return proto.CompactTextString(m) }
 func (*DashboardResponse) ProtoMessage()    {}
 func (*DashboardResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a07d9034b2dd9d26, []int{30}
+	return fileDescriptor_a07d9034b2dd9d26, []int{38}
 }
 func (m *DashboardResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DashboardResponse.Unmarshal(m, b)
@@ -1419,6 +1739,137 @@ func (m *DashboardResponse) GetLastQueried() *timestamp.Timestamp {
 	return nil
 }
 
+func (m *DashboardResponse) GetCorruptPieces() int64 {
+	if m != nil {
+		return m.CorruptPieces
+	}
+	return 0
+}
+
+func (m *DashboardResponse) GetLastScrubbed() *timestamp.Timestamp {
+	if m != nil {
+		return m.LastScrubbed
+	}
+	return nil
+}
+
+type CorruptPiecesRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CorruptPiecesRequest) Reset()         { *m = CorruptPiecesRequest{} }
+func (m *CorruptPiecesRequest) String() string { return proto.CompactTextString(m) }
+func (*CorruptPiecesRequest) ProtoMessage()    {}
+func (*CorruptPiecesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{39}
+}
+func (m *CorruptPiecesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CorruptPiecesRequest.Unmarshal(m, b)
+}
+func (m *CorruptPiecesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CorruptPiecesRequest.Marshal(b, m, deterministic)
+}
+func (m *CorruptPiecesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CorruptPiecesRequest.Merge(m, src)
+}
+func (m *CorruptPiecesRequest) XXX_Size() int {
+	return xxx_messageInfo_CorruptPiecesRequest.Size(m)
+}
+func (m *CorruptPiecesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CorruptPiecesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CorruptPiecesRequest proto.InternalMessageInfo
+
+type CorruptPiece struct {
+	SatelliteId NodeID  `protobuf:"bytes,1,opt,name=satellite_id,json=satelliteId,proto3,customtype=NodeID" json:"satellite_id"`
+	PieceId     PieceID `protobuf:"bytes,2,opt,name=piece_id,json=pieceId,proto3,customtype=PieceID" json:"piece_id"`
+	// missing is set when the piece file doesn't exist
+	Missing              bool                 `protobuf:"varint,3,opt,name=missing,proto3" json:"missing,omitempty"`
+	DetectedAt           *timestamp.Timestamp `protobuf:"bytes,4,opt,name=detected_at,json=detectedAt,proto3" json:"detected_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *CorruptPiece) Reset()         { *m = CorruptPiece{} }
+func (m *CorruptPiece) String() string { return proto.CompactTextString(m) }
+func (*CorruptPiece) ProtoMessage()    {}
+func (*CorruptPiece) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{40}
+}
+func (m *CorruptPiece) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CorruptPiece.Unmarshal(m, b)
+}
+func (m *CorruptPiece) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CorruptPiece.Marshal(b, m, deterministic)
+}
+func (m *CorruptPiece) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CorruptPiece.Merge(m, src)
+}
+func (m *CorruptPiece) XXX_Size() int {
+	return xxx_messageInfo_CorruptPiece.Size(m)
+}
+func (m *CorruptPiece) XXX_DiscardUnknown() {
+	xxx_messageInfo_CorruptPiece.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CorruptPiece proto.InternalMessageInfo
+
+func (m *CorruptPiece) GetMissing() bool {
+	if m != nil {
+		return m.Missing
+	}
+	return false
+}
+
+func (m *CorruptPiece) GetDetectedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.DetectedAt
+	}
+	return nil
+}
+
+type CorruptPiecesResponse struct {
+	Pieces               []*CorruptPiece `protobuf:"bytes,1,rep,name=pieces,proto3" json:"pieces,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *CorruptPiecesResponse) Reset()         { *m = CorruptPiecesResponse{} }
+func (m *CorruptPiecesResponse) String() string { return proto.CompactTextString(m) }
+func (*CorruptPiecesResponse) ProtoMessage()    {}
+func (*CorruptPiecesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{41}
+}
+func (m *CorruptPiecesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CorruptPiecesResponse.Unmarshal(m, b)
+}
+func (m *CorruptPiecesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CorruptPiecesResponse.Marshal(b, m, deterministic)
+}
+func (m *CorruptPiecesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CorruptPiecesResponse.Merge(m, src)
+}
+func (m *CorruptPiecesResponse) XXX_Size() int {
+	return xxx_messageInfo_CorruptPiecesResponse.Size(m)
+}
+func (m *CorruptPiecesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CorruptPiecesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CorruptPiecesResponse proto.InternalMessageInfo
+
+func (m *CorruptPiecesResponse) GetPieces() []*CorruptPiece {
+	if m != nil {
+		return m.Pieces
+	}
+	return nil
+}
+
 type SegmentHealthRequest struct {
 	Bucket               []byte   `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
 	EncryptedPath        []byte   `protobuf:"bytes,2,opt,name=encrypted_path,json=encryptedPath,proto3" json:"encrypted_path,omitempty"`
@@ -1433,7 +1884,7 @@ func (m *SegmentHealthRequest) Reset()         { *m = SegmentHealthRequest{} }
 func (m *SegmentHealthRequest) String() string { return proto.CompactTextString(m) }
 func (*SegmentHealthRequest) ProtoMessage()    {}
 func (*SegmentHealthRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a07d9034b2dd9d26, []int{31}
+	return fileDescriptor_a07d9034b2dd9d26, []int{42}
 }
 func (m *SegmentHealthRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SegmentHealthRequest.Unmarshal(m, b)
@@ -1493,7 +1944,7 @@ func (m *SegmentHealth) Reset()         { *m = SegmentHealth{} }
 func (m *SegmentHealth) String() string { return proto.CompactTextString(m) }
 func (*SegmentHealth) ProtoMessage()    {}
 func (*SegmentHealth) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a07d9034b2dd9d26, []int{32}
+	return fileDescriptor_a07d9034b2dd9d26, []int{43}
 }
 func (m *SegmentHealth) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SegmentHealth.Unmarshal(m, b)
@@ -1539,7 +1990,7 @@ func (m *SegmentHealthResponse) Reset()         { *m = SegmentHealthResponse{} }
 func (m *SegmentHealthResponse) String() string { return proto.CompactTextString(m) }
 func (*SegmentHealthResponse) ProtoMessage()    {}
 func (*SegmentHealthResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a07d9034b2dd9d26, []int{33}
+	return fileDescriptor_a07d9034b2dd9d26, []int{44}
 }
 func (m *SegmentHealthResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SegmentHealthResponse.Unmarshal(m, b)
@@ -1589,7 +2040,7 @@ func (m *ObjectHealthRequest) Reset()         { *m = ObjectHealthRequest{} }
 func (m *ObjectHealthRequest) String() string { return proto.CompactTextString(m) }
 func (*ObjectHealthRequest) ProtoMessage()    {}
 func (*ObjectHealthRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a07d9034b2dd9d26, []int{34}
+	return fileDescriptor_a07d9034b2dd9d26, []int{45}
 }
 func (m *ObjectHealthRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ObjectHealthRequest.Unmarshal(m, b)
@@ -1663,7 +2114,7 @@ func (m *ObjectHealthResponse) Reset()         { *m = ObjectHealthResponse{} }
 func (m *ObjectHealthResponse) String() string { return proto.CompactTextString(m) }
 func (*ObjectHealthResponse) ProtoMessage()    {}
 func (*ObjectHealthResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a07d9034b2dd9d26, []int{35}
+	return fileDescriptor_a07d9034b2dd9d26, []int{46}
 }
 func (m *ObjectHealthResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ObjectHealthResponse.Unmarshal(m, b)
@@ -1705,6 +2156,14 @@ func init() {
 	proto.RegisterType((*GetStatsResponse)(nil), "inspector.GetStatsResponse")
 	proto.RegisterType((*CreateStatsRequest)(nil), "inspector.CreateStatsRequest")
 	proto.RegisterType((*CreateStatsResponse)(nil), "inspector.CreateStatsResponse")
+	proto.RegisterType((*VettingPipelineRequest)(nil), "inspector.VettingPipelineRequest")
+	proto.RegisterType((*VettingPipelineResponse)(nil), "inspector.VettingPipelineResponse")
+	proto.RegisterType((*UnvettedNode)(nil), "inspector.UnvettedNode")
+	proto.RegisterType((*DisqualifyNodeRequest)(nil), "inspector.DisqualifyNodeRequest")
+	proto.RegisterType((*DisqualifyNodeResponse)(nil), "inspector.DisqualifyNodeResponse")
+	proto.RegisterType((*DisqualifiedNodesRequest)(nil), "inspector.DisqualifiedNodesRequest")
+	proto.RegisterType((*DisqualifiedNodesResponse)(nil), "inspector.DisqualifiedNodesResponse")
+	proto.RegisterType((*DisqualifiedNode)(nil), "inspector.DisqualifiedNode")
 	proto.RegisterType((*CountNodesResponse)(nil), "inspector.CountNodesResponse")
 	proto.RegisterType((*CountNodesRequest)(nil), "inspector.CountNodesRequest")
 	proto.RegisterType((*GetBucketListRequest)(nil), "inspector.GetBucketListRequest")
@@ -1730,6 +2189,9 @@ func init() {
 	proto.RegisterType((*StatSummaryResponse)(nil), "inspector.StatSummaryResponse")
 	proto.RegisterType((*DashboardRequest)(nil), "inspector.DashboardRequest")
 	proto.RegisterType((*DashboardResponse)(nil), "inspector.DashboardResponse")
+	proto.RegisterType((*CorruptPiecesRequest)(nil), "inspector.CorruptPiecesRequest")
+	proto.RegisterType((*CorruptPiece)(nil), "inspector.CorruptPiece")
+	proto.RegisterType((*CorruptPiecesResponse)(nil), "inspector.CorruptPiecesResponse")
 	proto.RegisterType((*SegmentHealthRequest)(nil), "inspector.SegmentHealthRequest")
 	proto.RegisterType((*SegmentHealth)(nil), "inspector.SegmentHealth")
 	proto.RegisterType((*SegmentHealthResponse)(nil), "inspector.SegmentHealthResponse")
@@ -1740,119 +2202,142 @@ func init() {
 func init() { proto.RegisterFile("inspector.proto", fileDescriptor_a07d9034b2dd9d26) }
 
 var fileDescriptor_a07d9034b2dd9d26 = []byte{
-	// 1782 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0x4b, 0x93, 0x1b, 0x49,
-	0x11, 0x76, 0xeb, 0x35, 0x52, 0x4a, 0xa3, 0x47, 0x49, 0xf6, 0x0a, 0xad, 0x3d, 0x1a, 0x9a, 0x87,
-	0xbd, 0x36, 0xc8, 0x46, 0x98, 0xc3, 0xb2, 0xb1, 0x07, 0x8f, 0xcc, 0xae, 0x15, 0x6b, 0xec, 0xd9,
-	0x1e, 0xc3, 0x81, 0xd8, 0x40, 0x51, 0xea, 0xae, 0xd1, 0x34, 0x96, 0xba, 0x7a, 0xbb, 0x4b, 0x66,
-	0xe7, 0x0f, 0x10, 0x70, 0xe2, 0xc4, 0x01, 0xae, 0xfc, 0x09, 0x82, 0x2b, 0x17, 0x6e, 0xdc, 0x39,
-	0xf8, 0x42, 0x04, 0xdc, 0xb9, 0x71, 0x23, 0x2a, 0xab, 0xfa, 0x2d, 0x31, 0x13, 0xc0, 0xde, 0xd4,
-	0x5f, 0x7e, 0x95, 0x95, 0xf9, 0xd5, 0x23, 0xb3, 0x04, 0x1d, 0xd7, 0x0b, 0x7d, 0x66, 0x0b, 0x1e,
-	0x4c, 0xfc, 0x80, 0x0b, 0x4e, 0x1a, 0x31, 0x30, 0x82, 0x15, 0x5f, 0x71, 0x05, 0x8f, 0xc0, 0xe3,
-	0x0e, 0xd3, 0xbf, 0x3b, 0x3e, 0x77, 0x3d, 0xc1, 0x02, 0x67, 0xa9, 0x81, 0xa3, 0x15, 0xe7, 0xab,
-	0x35, 0x7b, 0x88, 0x5f, 0xcb, 0xed, 0xf9, 0x43, 0x67, 0x1b, 0x50, 0xe1, 0x72, 0x4f, 0xdb, 0xc7,
-	0x79, 0xbb, 0x70, 0x37, 0x2c, 0x14, 0x74, 0xe3, 0x2b, 0x82, 0xf9, 0x02, 0x8e, 0x9e, 0xbb, 0xa1,
-	0x98, 0x07, 0x01, 0xf3, 0x69, 0x40, 0x97, 0x6b, 0x76, 0xc6, 0x56, 0x1b, 0xe6, 0x89, 0xd0, 0x62,
-	0x9f, 0x6f, 0x59, 0x28, 0xc8, 0x00, 0xaa, 0x6b, 0x77, 0xe3, 0x8a, 0xa1, 0x71, 0x6c, 0xdc, 0xab,
-	0x5a, 0xea, 0x83, 0xdc, 0x82, 0x1a, 0x3f, 0x3f, 0x0f, 0x99, 0x18, 0x96, 0x10, 0xd6, 0x5f, 0xe6,
-	0xdf, 0x0d, 0x20, 0x45, 0x67, 0x84, 0x40, 0xc5, 0xa7, 0xe2, 0x02, 0x7d, 0xb4, 0x2c, 0xfc, 0x4d,
-	0xde, 0x87, 0x76, 0xa8, 0xcc, 0x0b, 0x87, 0x09, 0xea, 0xae, 0xd1, 0x55, 0x73, 0x4a, 0x26, 0x49,
-	0x96, 0xa7, 0xea, 0x97, 0x75, 0xa8, 0x99, 0x4f, 0x91, 0x48, 0xc6, 0xd0, 0x5c, 0xf3, 0x50, 0x2c,
-	0x7c, 0x97, 0xd9, 0x2c, 0x1c, 0x96, 0x31, 0x04, 0x90, 0xd0, 0x29, 0x22, 0x64, 0x02, 0xfd, 0x35,
-	0x0d, 0xc5, 0x42, 0x06, 0xe2, 0x06, 0x0b, 0x2a, 0x04, 0xdb, 0xf8, 0x62, 0x58, 0x39, 0x36, 0xee,
-	0x95, 0xad, 0x9e, 0x34, 0x59, 0x68, 0x79, 0xa2, 0x0c, 0xe4, 0x11, 0x0c, 0xb2, 0xd4, 0x85, 0xcd,
-	0xb7, 0x9e, 0x18, 0x56, 0x71, 0x00, 0x09, 0xd2, 0xe4, 0x99, 0xb4, 0x98, 0x9f, 0xc1, 0x78, 0xaf,
-	0x70, 0xa1, 0xcf, 0xbd, 0x90, 0x91, 0xf7, 0xa1, 0xae, 0xc3, 0x0e, 0x87, 0xc6, 0x71, 0xf9, 0x5e,
-	0x73, 0x7a, 0x67, 0x92, 0x2c, 0x7a, 0x71, 0xa4, 0x15, 0xd3, 0xcd, 0xef, 0x43, 0xe7, 0x63, 0x26,
-	0xce, 0x04, 0x4d, 0xd6, 0xe1, 0x2e, 0x1c, 0xc8, 0x9d, 0xb0, 0x70, 0x1d, 0xa5, 0xe2, 0x49, 0xfb,
-	0xcf, 0x6f, 0xc7, 0x37, 0xfe, 0xfa, 0x76, 0x5c, 0x7b, 0xc1, 0x1d, 0x36, 0x7f, 0x6a, 0xd5, 0xa4,
-	0x79, 0xee, 0x98, 0xbf, 0x33, 0xa0, 0x9b, 0x0c, 0xd6, 0xb1, 0x8c, 0xa1, 0x49, 0xb7, 0x8e, 0x1b,
-	0xe5, 0x65, 0x60, 0x5e, 0x80, 0x10, 0xe6, 0x93, 0x10, 0x70, 0xff, 0xe0, 0x52, 0x18, 0x9a, 0x60,
-	0x49, 0x84, 0x7c, 0x15, 0x5a, 0x5b, 0x5f, 0x6e, 0x1f, 0xed, 0xa2, 0x8c, 0x2e, 0x9a, 0x0a, 0x53,
-	0x3e, 0x12, 0x8a, 0x72, 0x52, 0x41, 0x27, 0x9a, 0x82, 0x5e, 0xcc, 0xbf, 0x19, 0x40, 0x66, 0x01,
-	0xa3, 0x82, 0xfd, 0x57, 0xc9, 0xe5, 0xf3, 0x28, 0x15, 0xf2, 0x98, 0x40, 0x5f, 0x11, 0xc2, 0xad,
-	0x6d, 0xb3, 0x30, 0xcc, 0x44, 0xdb, 0x43, 0xd3, 0x99, 0xb2, 0xe4, 0x63, 0x56, 0xc4, 0x4a, 0x31,
-	0xad, 0x47, 0x30, 0xd0, 0x94, 0xac, 0x4f, 0xbd, 0x39, 0x94, 0x2d, 0xed, 0xd4, 0xbc, 0x09, 0xfd,
-	0x4c, 0x92, 0x6a, 0x11, 0xcc, 0xfb, 0x40, 0xd0, 0x2e, 0x73, 0x4a, 0x96, 0x66, 0x00, 0xd5, 0xf4,
-	0xa2, 0xa8, 0x0f, 0xb3, 0x0f, 0xbd, 0x34, 0x17, 0x65, 0x32, 0x6f, 0xc1, 0xe0, 0x63, 0x26, 0x4e,
-	0xb6, 0xf6, 0x6b, 0x26, 0xe4, 0xee, 0x8b, 0xf0, 0x7f, 0x1a, 0x70, 0x33, 0x67, 0xd0, 0xce, 0x9f,
-	0xc0, 0xc1, 0x12, 0xd1, 0x68, 0x0b, 0xde, 0x4d, 0x6d, 0xc1, 0x9d, 0x43, 0x26, 0x0a, 0xb2, 0xa2,
-	0x71, 0xa3, 0xdf, 0x18, 0x50, 0x53, 0x18, 0x79, 0x00, 0x0d, 0x85, 0xee, 0x5f, 0xa8, 0xba, 0x22,
-	0xcc, 0x1d, 0xf2, 0x10, 0x0e, 0x03, 0xbe, 0x15, 0xae, 0xb7, 0x5a, 0xc8, 0xc5, 0x0b, 0x87, 0x25,
-	0x0c, 0x00, 0x26, 0x78, 0xa1, 0x49, 0xba, 0xd5, 0xd2, 0x04, 0x4c, 0x92, 0x7c, 0x1b, 0x5a, 0x36,
-	0xb5, 0x2f, 0x98, 0xa3, 0xf9, 0xe5, 0x02, 0xbf, 0xa9, 0xec, 0x48, 0x97, 0x0a, 0xc5, 0x09, 0xc4,
-	0x0a, 0x3d, 0x03, 0x92, 0x06, 0x13, 0x89, 0x05, 0x17, 0x74, 0x1d, 0x49, 0x8c, 0x1f, 0xe4, 0x36,
-	0x94, 0x5d, 0x47, 0x85, 0xd5, 0x3a, 0x81, 0x54, 0x0e, 0x12, 0x36, 0xa7, 0x78, 0x8a, 0xb4, 0x18,
-	0x7a, 0x9b, 0x1e, 0x41, 0x69, 0x6f, 0xe2, 0x25, 0xd7, 0x31, 0x7f, 0x94, 0x0a, 0x29, 0x9e, 0xfc,
-	0x8a, 0x41, 0xe4, 0x18, 0xaa, 0xfb, 0xf4, 0x51, 0x06, 0xf3, 0x7e, 0xbc, 0x00, 0x57, 0x73, 0x27,
-	0x00, 0xc9, 0x9a, 0x26, 0x7c, 0x63, 0x1f, 0xff, 0x13, 0xe8, 0x9c, 0xea, 0x15, 0xb8, 0x66, 0x96,
-	0x64, 0x08, 0x07, 0xd4, 0x71, 0x02, 0x16, 0x86, 0x78, 0xfe, 0x1a, 0x56, 0xf4, 0x69, 0x9a, 0xd0,
-	0x4d, 0x9c, 0xe9, 0xf4, 0xdb, 0x50, 0xe2, 0xaf, 0xd1, 0x5b, 0xdd, 0x2a, 0xf1, 0xd7, 0xe6, 0x87,
-	0xd0, 0x7b, 0xce, 0xf9, 0xeb, 0xad, 0x9f, 0x9e, 0xb2, 0x1d, 0x4f, 0xd9, 0xb8, 0x62, 0x8a, 0xcf,
-	0x80, 0xa4, 0x87, 0xc7, 0x1a, 0x57, 0x64, 0x3a, 0xe8, 0x21, 0x9b, 0x26, 0xe2, 0xe4, 0x9b, 0x50,
-	0xd9, 0x30, 0x41, 0xe3, 0x0a, 0x13, 0xdb, 0x7f, 0xc8, 0x04, 0x75, 0xa8, 0xa0, 0x16, 0xda, 0xcd,
-	0x9f, 0x42, 0x07, 0x13, 0xf5, 0xce, 0xf9, 0x75, 0xd5, 0x78, 0x90, 0x0d, 0xb5, 0x39, 0xed, 0x25,
-	0xde, 0x9f, 0x28, 0x43, 0x12, 0xfd, 0x9f, 0x0c, 0xe8, 0x26, 0x13, 0xe8, 0xe0, 0x4d, 0xa8, 0x88,
-	0x4b, 0x5f, 0x05, 0xdf, 0x9e, 0xb6, 0x93, 0xe1, 0xaf, 0x2e, 0x7d, 0x66, 0xa1, 0x8d, 0x4c, 0xa0,
-	0xce, 0x7d, 0x16, 0x50, 0xc1, 0x83, 0x62, 0x12, 0x2f, 0xb5, 0xc5, 0x8a, 0x39, 0x92, 0x6f, 0x53,
-	0x9f, 0xda, 0xae, 0xb8, 0xc4, 0xbb, 0x2f, 0xc3, 0x9f, 0x69, 0x8b, 0x15, 0x73, 0x64, 0x16, 0x6f,
-	0x58, 0x10, 0xba, 0xdc, 0xc3, 0x1b, 0x30, 0x93, 0xc5, 0x8f, 0x95, 0xc1, 0x8a, 0x18, 0xe6, 0x06,
-	0x3a, 0x1f, 0xb9, 0x9e, 0xf3, 0x82, 0xd1, 0xe0, 0xba, 0x2a, 0x7d, 0x1d, 0xaa, 0xa1, 0xa0, 0x81,
-	0xba, 0xb1, 0x8b, 0x14, 0x65, 0x4c, 0x7a, 0x0d, 0x75, 0x5d, 0xab, 0x0f, 0xf3, 0x31, 0x74, 0x93,
-	0xe9, 0xb4, 0x66, 0x57, 0x1f, 0x04, 0x02, 0xdd, 0xa7, 0xdb, 0x8d, 0x9f, 0xb9, 0x3f, 0xbf, 0x07,
-	0xbd, 0x14, 0x96, 0x77, 0xb5, 0xf7, 0x8c, 0xb4, 0xa1, 0x95, 0xae, 0x56, 0xe6, 0xbf, 0x0c, 0xe8,
-	0x4b, 0xe0, 0x6c, 0xbb, 0xd9, 0xd0, 0xe0, 0x32, 0xf6, 0x74, 0x07, 0x60, 0x1b, 0x32, 0x67, 0x11,
-	0xfa, 0xd4, 0x66, 0xfa, 0xae, 0x69, 0x48, 0xe4, 0x4c, 0x02, 0xe4, 0x2e, 0x74, 0xe8, 0x1b, 0xea,
-	0xae, 0x65, 0xc9, 0xd7, 0x1c, 0x55, 0xbf, 0xda, 0x31, 0xac, 0x88, 0xb2, 0x26, 0x49, 0x3f, 0xae,
-	0xb7, 0xc2, 0x7d, 0x15, 0x95, 0xda, 0x90, 0x39, 0x73, 0x05, 0xc9, 0x3a, 0x88, 0x14, 0xa6, 0x18,
-	0xaa, 0x6a, 0xe1, 0xec, 0x3f, 0x50, 0x84, 0x6f, 0x40, 0x1b, 0x09, 0x4b, 0xea, 0x39, 0x3f, 0x77,
-	0x1d, 0x71, 0xa1, 0xcb, 0xd5, 0xa1, 0x44, 0x4f, 0x22, 0x90, 0x3c, 0x84, 0x7e, 0x12, 0x53, 0xc2,
-	0xad, 0xa9, 0xd2, 0x16, 0x9b, 0xe2, 0x01, 0x28, 0x2b, 0x0d, 0x2f, 0x96, 0x9c, 0x06, 0x4e, 0xa4,
-	0xc7, 0x5f, 0xca, 0xd0, 0x4b, 0x81, 0x5a, 0x8d, 0x6b, 0xd7, 0xf4, 0xf7, 0xa0, 0x8b, 0x44, 0x9b,
-	0x7b, 0x1e, 0xb3, 0x65, 0xf7, 0x1a, 0x6a, 0x61, 0x3a, 0x12, 0x9f, 0x25, 0x30, 0x79, 0x00, 0xbd,
-	0x25, 0xe7, 0x22, 0x14, 0x01, 0xf5, 0x17, 0xd1, 0xb1, 0x2b, 0xe3, 0x0d, 0xd1, 0x8d, 0x0d, 0xfa,
-	0xd4, 0x49, 0xbf, 0xd8, 0x3d, 0x7a, 0x74, 0x1d, 0x73, 0x2b, 0xc8, 0xed, 0x44, 0x78, 0x8a, 0xca,
-	0xbe, 0xc8, 0x51, 0xab, 0x8a, 0x1a, 0xe1, 0x11, 0xf5, 0x31, 0xee, 0x64, 0x11, 0xa2, 0x46, 0xcd,
-	0xe9, 0x51, 0xaa, 0x9e, 0xee, 0xd8, 0x13, 0x96, 0x22, 0x93, 0xef, 0x40, 0x4d, 0xf5, 0x09, 0xc3,
-	0x03, 0x1c, 0xf6, 0x95, 0x89, 0xea, 0xcc, 0x27, 0x51, 0x67, 0x3e, 0x79, 0xaa, 0x3b, 0x77, 0x4b,
-	0x13, 0xc9, 0x07, 0xd0, 0xc4, 0x1e, 0xd6, 0x77, 0xbd, 0x15, 0x73, 0x86, 0x75, 0x1c, 0x37, 0x2a,
-	0x8c, 0x7b, 0x15, 0x75, 0xf4, 0x16, 0x48, 0xfa, 0x29, 0xb2, 0xc9, 0x87, 0xd0, 0xc2, 0xc1, 0x9f,
-	0x6f, 0x59, 0xe0, 0x32, 0x67, 0xd8, 0xb8, 0x72, 0x34, 0x4e, 0xf6, 0xa9, 0xa2, 0x9b, 0xbf, 0x35,
-	0x60, 0xa0, 0xbb, 0xd2, 0x67, 0x8c, 0xae, 0xc5, 0x45, 0x74, 0xce, 0x6f, 0x41, 0x4d, 0x15, 0x78,
-	0xdd, 0xca, 0xeb, 0x2f, 0xb9, 0xdd, 0x98, 0x67, 0x07, 0x97, 0xbe, 0x60, 0xce, 0x02, 0x5b, 0x7d,
-	0x3c, 0xe8, 0xd6, 0x61, 0x8c, 0x9e, 0xca, 0x9e, 0xff, 0x6b, 0x10, 0x75, 0xf2, 0x0b, 0xd7, 0x73,
-	0xd8, 0x17, 0x7a, 0x6b, 0xb7, 0x34, 0x38, 0x97, 0x98, 0x3c, 0x46, 0x7e, 0xc0, 0x7f, 0xc6, 0x6c,
-	0x6c, 0x33, 0x2a, 0xe8, 0xa7, 0xa1, 0x91, 0xb9, 0x63, 0x3e, 0x87, 0xc3, 0x4c, 0x68, 0xf2, 0xb8,
-	0x70, 0x6f, 0xed, 0x7a, 0x6c, 0x11, 0x9d, 0x63, 0xf9, 0x1c, 0x68, 0x2a, 0x4c, 0xb5, 0x16, 0x43,
-	0x38, 0xd0, 0x53, 0xe8, 0xb8, 0xa2, 0x4f, 0xf3, 0x17, 0x06, 0xdc, 0xcc, 0x65, 0xaa, 0xf7, 0xef,
-	0x23, 0xa8, 0x5d, 0x20, 0xa2, 0xab, 0xca, 0x30, 0xbd, 0xd2, 0x99, 0x11, 0x9a, 0x47, 0x3e, 0x00,
-	0x08, 0x98, 0xb3, 0xf5, 0x1c, 0xea, 0xd9, 0x97, 0xfa, 0x9a, 0x7e, 0x37, 0xf5, 0x9a, 0xb1, 0x62,
-	0xe3, 0x99, 0x7d, 0xc1, 0x36, 0xcc, 0x4a, 0xd1, 0xcd, 0x7f, 0x18, 0xd0, 0x7f, 0xb9, 0x94, 0x39,
-	0x66, 0x15, 0x2f, 0x2a, 0x6b, 0xec, 0x52, 0x36, 0x59, 0x98, 0x52, 0x66, 0x61, 0xb2, 0x62, 0x96,
-	0x73, 0x62, 0xca, 0x76, 0x19, 0xaf, 0xde, 0x05, 0x3d, 0x17, 0x2c, 0x58, 0x44, 0x22, 0xe9, 0x87,
-	0x12, 0x9a, 0x9e, 0x48, 0x4b, 0xf4, 0x90, 0xfb, 0x16, 0x10, 0xe6, 0x39, 0x8b, 0x25, 0x3b, 0xe7,
-	0x01, 0x8b, 0xe9, 0xea, 0x6a, 0xe9, 0x32, 0xcf, 0x39, 0x41, 0x43, 0xc4, 0x8e, 0xef, 0xf3, 0x5a,
-	0xea, 0xed, 0x68, 0xfe, 0xca, 0x80, 0x41, 0x36, 0x53, 0xad, 0xf8, 0xe3, 0xc2, 0x83, 0x69, 0xbf,
-	0xe6, 0x31, 0xf3, 0x7f, 0x52, 0x7d, 0xfa, 0xeb, 0x0a, 0xb4, 0x3e, 0xa1, 0xce, 0x3c, 0x9a, 0x85,
-	0xcc, 0x01, 0x92, 0xbe, 0x9b, 0xdc, 0x4e, 0xcd, 0x5f, 0x68, 0xc7, 0x47, 0x77, 0xf6, 0x58, 0x75,
-	0x3a, 0x33, 0xa8, 0x47, 0xdd, 0x10, 0x19, 0xa5, 0xa8, 0xb9, 0x7e, 0x6b, 0xf4, 0xee, 0x4e, 0x9b,
-	0x76, 0x32, 0x07, 0x48, 0xfa, 0x9d, 0x4c, 0x3c, 0x85, 0x2e, 0x2a, 0x13, 0xcf, 0x8e, 0x26, 0x69,
-	0x06, 0xf5, 0xa8, 0xf7, 0xc8, 0xc4, 0x93, 0xeb, 0x78, 0x32, 0xf1, 0x14, 0x9a, 0x95, 0x19, 0xd4,
-	0xa3, 0x62, 0x9c, 0x71, 0x92, 0x6b, 0x08, 0x32, 0x4e, 0x0a, 0xd5, 0xfb, 0x23, 0x68, 0xc4, 0x75,
-	0x98, 0xa4, 0x99, 0xf9, 0x8a, 0x3d, 0xba, 0xbd, 0xdb, 0xa8, 0xfd, 0x58, 0x70, 0x98, 0x79, 0xc3,
-	0x90, 0xf1, 0xfe, 0xd7, 0x8d, 0xf2, 0x77, 0x7c, 0xd5, 0xf3, 0x67, 0xfa, 0x87, 0x12, 0x74, 0x5f,
-	0xbe, 0x61, 0xc1, 0x9a, 0x5e, 0x7e, 0x29, 0xbb, 0xe2, 0xff, 0x95, 0xfb, 0x0c, 0xea, 0xd1, 0x2b,
-	0x3f, 0xb3, 0x10, 0xb9, 0xff, 0x0d, 0x32, 0x0b, 0x51, 0xf8, 0x5b, 0xe0, 0x39, 0x34, 0x53, 0x0f,
-	0x55, 0x92, 0x09, 0xbd, 0xf0, 0x4a, 0x1f, 0x1d, 0xed, 0x33, 0x6b, 0xe9, 0x7e, 0x6f, 0x40, 0x1f,
-	0xff, 0x80, 0x39, 0x13, 0x3c, 0x60, 0x89, 0x7a, 0x27, 0x50, 0x55, 0xfe, 0xdf, 0xc9, 0x15, 0xcb,
-	0x9d, 0x9e, 0x77, 0x54, 0x51, 0xf3, 0x06, 0x79, 0x06, 0x8d, 0xb8, 0xc5, 0xc8, 0xca, 0x96, 0xeb,
-	0x46, 0xb2, 0xb2, 0xe5, 0xbb, 0x12, 0xf3, 0xc6, 0xf4, 0x97, 0x06, 0x0c, 0x52, 0x7f, 0xbe, 0x24,
-	0x61, 0xfa, 0xf0, 0xce, 0x9e, 0xbf, 0x74, 0xc8, 0x7b, 0xe9, 0x93, 0xf5, 0x1f, 0xff, 0x2f, 0x1b,
-	0xdd, 0xbf, 0x0e, 0x55, 0x0b, 0xf6, 0x47, 0x03, 0x3a, 0xea, 0x3e, 0x4b, 0xa2, 0xf8, 0x14, 0x5a,
-	0xe9, 0xcb, 0x91, 0xa4, 0xa5, 0xd9, 0x51, 0x1f, 0x46, 0xe3, 0xbd, 0xf6, 0x58, 0xbb, 0x57, 0xf9,
-	0x8a, 0x39, 0xde, 0x7b, 0xad, 0xee, 0x38, 0x26, 0x3b, 0xab, 0xa3, 0x79, 0xe3, 0xa4, 0xf2, 0x93,
-	0x92, 0xbf, 0x5c, 0xd6, 0xb0, 0x95, 0xf8, 0xee, 0xbf, 0x03, 0x00, 0x00, 0xff, 0xff, 0x8f, 0x28,
-	0x82, 0xe5, 0xcf, 0x14, 0x00, 0x00,
+	// 2145 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x59, 0xcd, 0x93, 0xdb, 0x48,
+	0x15, 0x8f, 0x3c, 0x1e, 0x8f, 0xfd, 0xec, 0xf1, 0x47, 0xcf, 0x47, 0xbc, 0x9a, 0x64, 0x3c, 0xd1,
+	0x2e, 0x24, 0x9b, 0xb0, 0x9e, 0xc4, 0x84, 0xc3, 0xb2, 0xb5, 0xc0, 0x7c, 0xb0, 0x1b, 0xd7, 0x86,
+	0x64, 0x56, 0x93, 0x6c, 0x51, 0xd4, 0xd6, 0xba, 0xda, 0x52, 0x8f, 0x47, 0xc4, 0x96, 0x14, 0xa9,
+	0x35, 0x64, 0x8a, 0x3b, 0x05, 0x27, 0x4e, 0x1c, 0xe0, 0x8f, 0xe0, 0x4a, 0x15, 0x57, 0x38, 0xc0,
+	0xbf, 0xc0, 0x61, 0x2f, 0x54, 0xc1, 0x9d, 0x1b, 0x37, 0xaa, 0x3f, 0x24, 0xb5, 0x64, 0x79, 0x3c,
+	0xc0, 0xee, 0xcd, 0xfd, 0xde, 0xaf, 0x9f, 0xde, 0xfb, 0xf5, 0xeb, 0x7e, 0xaf, 0xdb, 0xd0, 0x72,
+	0xdc, 0xd0, 0x27, 0x16, 0xf5, 0x82, 0xbe, 0x1f, 0x78, 0xd4, 0x43, 0xb5, 0x44, 0xa0, 0xc3, 0xc4,
+	0x9b, 0x78, 0x42, 0xac, 0x83, 0xeb, 0xd9, 0x44, 0xfe, 0x6e, 0xf9, 0x9e, 0xe3, 0x52, 0x12, 0xd8,
+	0x63, 0x29, 0xd8, 0x9d, 0x78, 0xde, 0x64, 0x4a, 0xf6, 0xf9, 0x68, 0x1c, 0x9d, 0xed, 0xdb, 0x51,
+	0x80, 0xa9, 0xe3, 0xb9, 0x52, 0xdf, 0xcb, 0xeb, 0xa9, 0x33, 0x23, 0x21, 0xc5, 0x33, 0x5f, 0x00,
+	0x8c, 0x67, 0xb0, 0xfb, 0xd4, 0x09, 0xe9, 0x30, 0x08, 0x88, 0x8f, 0x03, 0x3c, 0x9e, 0x92, 0x53,
+	0x32, 0x99, 0x11, 0x97, 0x86, 0x26, 0x79, 0x1d, 0x91, 0x90, 0xa2, 0x4d, 0x58, 0x9d, 0x3a, 0x33,
+	0x87, 0x76, 0xb5, 0x3d, 0xed, 0xde, 0xaa, 0x29, 0x06, 0x68, 0x1b, 0x2a, 0xde, 0xd9, 0x59, 0x48,
+	0x68, 0xb7, 0xc4, 0xc5, 0x72, 0x64, 0xfc, 0x43, 0x03, 0x34, 0x6f, 0x0c, 0x21, 0x28, 0xfb, 0x98,
+	0x9e, 0x73, 0x1b, 0x0d, 0x93, 0xff, 0x46, 0xef, 0x43, 0x33, 0x14, 0xea, 0x91, 0x4d, 0x28, 0x76,
+	0xa6, 0xdc, 0x54, 0x7d, 0x80, 0xfa, 0x69, 0x94, 0x27, 0xe2, 0x97, 0xb9, 0x2e, 0x91, 0xc7, 0x1c,
+	0x88, 0x7a, 0x50, 0x9f, 0x7a, 0x21, 0x1d, 0xf9, 0x0e, 0xb1, 0x48, 0xd8, 0x5d, 0xe1, 0x2e, 0x00,
+	0x13, 0x9d, 0x70, 0x09, 0xea, 0xc3, 0xc6, 0x14, 0x87, 0x74, 0xc4, 0x1c, 0x71, 0x82, 0x11, 0xa6,
+	0x94, 0xcc, 0x7c, 0xda, 0x2d, 0xef, 0x69, 0xf7, 0x56, 0xcc, 0x0e, 0x53, 0x99, 0x5c, 0x73, 0x20,
+	0x14, 0xe8, 0x21, 0x6c, 0x66, 0xa1, 0x23, 0xcb, 0x8b, 0x5c, 0xda, 0x5d, 0xe5, 0x13, 0x50, 0xa0,
+	0x82, 0x8f, 0x98, 0xc6, 0xf8, 0x1c, 0x7a, 0x0b, 0x89, 0x0b, 0x7d, 0xcf, 0x0d, 0x09, 0x7a, 0x1f,
+	0xaa, 0xd2, 0xed, 0xb0, 0xab, 0xed, 0xad, 0xdc, 0xab, 0x0f, 0x6e, 0xf7, 0xd3, 0x45, 0x9f, 0x9f,
+	0x69, 0x26, 0x70, 0xe3, 0xbb, 0xd0, 0xfa, 0x98, 0xd0, 0x53, 0x8a, 0xd3, 0x75, 0xb8, 0x0b, 0x6b,
+	0x2c, 0x13, 0x46, 0x8e, 0x2d, 0x58, 0x3c, 0x6c, 0xfe, 0xe5, 0xcb, 0xde, 0x8d, 0xbf, 0x7d, 0xd9,
+	0xab, 0x3c, 0xf3, 0x6c, 0x32, 0x3c, 0x36, 0x2b, 0x4c, 0x3d, 0xb4, 0x8d, 0xdf, 0x69, 0xd0, 0x4e,
+	0x27, 0x4b, 0x5f, 0x7a, 0x50, 0xc7, 0x91, 0xed, 0xc4, 0x71, 0x69, 0x3c, 0x2e, 0xe0, 0x22, 0x1e,
+	0x4f, 0x0a, 0xe0, 0xf9, 0xc3, 0x97, 0x42, 0x93, 0x00, 0x93, 0x49, 0xd0, 0x1d, 0x68, 0x44, 0x3e,
+	0x4b, 0x1f, 0x69, 0x62, 0x85, 0x9b, 0xa8, 0x0b, 0x99, 0xb0, 0x91, 0x42, 0x84, 0x91, 0x32, 0x37,
+	0x22, 0x21, 0xdc, 0x8a, 0xf1, 0x77, 0x0d, 0xd0, 0x51, 0x40, 0x30, 0x25, 0xff, 0x53, 0x70, 0xf9,
+	0x38, 0x4a, 0x73, 0x71, 0xf4, 0x61, 0x43, 0x00, 0xc2, 0xc8, 0xb2, 0x48, 0x18, 0x66, 0xbc, 0xed,
+	0x70, 0xd5, 0xa9, 0xd0, 0xe4, 0x7d, 0x16, 0xc0, 0xf2, 0x7c, 0x58, 0x0f, 0x61, 0x53, 0x42, 0xb2,
+	0x36, 0x65, 0x72, 0x08, 0x9d, 0x6a, 0xd4, 0xd8, 0x82, 0x8d, 0x4c, 0x90, 0x62, 0x11, 0x8c, 0x3e,
+	0x6c, 0x7f, 0x46, 0x28, 0x75, 0xdc, 0xc9, 0x89, 0xe3, 0x93, 0xa9, 0xe3, 0x92, 0x2b, 0x37, 0x99,
+	0xf1, 0x7b, 0x0d, 0x6e, 0xce, 0x4d, 0x90, 0x0b, 0xca, 0x33, 0xf6, 0x75, 0xe4, 0x04, 0xc4, 0x1e,
+	0xcd, 0xaf, 0x2c, 0x8a, 0x75, 0x07, 0x29, 0x33, 0x03, 0xd8, 0x4a, 0x66, 0x64, 0x42, 0x16, 0x24,
+	0x6e, 0xc4, 0xca, 0x97, 0x4a, 0xe8, 0xef, 0xc1, 0x2a, 0x23, 0x9e, 0x6d, 0x31, 0x96, 0xbf, 0x37,
+	0x95, 0xfc, 0x7d, 0xe9, 0x5e, 0x10, 0x4a, 0x89, 0xcd, 0x16, 0xc8, 0x14, 0x28, 0xe3, 0xe7, 0xd0,
+	0x50, 0xc5, 0x5f, 0xe1, 0xb2, 0x2e, 0xcf, 0x3e, 0xe3, 0x07, 0xb0, 0x75, 0xec, 0x84, 0xaf, 0x23,
+	0x3c, 0x75, 0xce, 0x2e, 0xb9, 0x57, 0xff, 0xed, 0xce, 0xe9, 0xc2, 0x76, 0xde, 0x82, 0x5c, 0xb9,
+	0x87, 0xd0, 0x4d, 0x34, 0x8e, 0x08, 0xee, 0xea, 0x03, 0xd2, 0x78, 0x06, 0x6f, 0x15, 0xcc, 0x90,
+	0x8b, 0xf7, 0x28, 0xa6, 0x55, 0x1c, 0x0b, 0x3b, 0x0a, 0xad, 0xf9, 0x49, 0x29, 0xb5, 0xed, 0xbc,
+	0xea, 0xfa, 0xf4, 0x7e, 0x0f, 0x1a, 0xb6, 0x32, 0x59, 0x1e, 0xb4, 0x7a, 0x5f, 0x54, 0x87, 0x7e,
+	0x5c, 0x1d, 0xfa, 0x2f, 0xe2, 0xea, 0x60, 0x66, 0xf0, 0xc6, 0x7d, 0x40, 0x9c, 0xe3, 0x6c, 0x14,
+	0x9b, 0xb0, 0xaa, 0xe6, 0x9c, 0x18, 0x18, 0x1b, 0xd0, 0x51, 0xb1, 0x9c, 0x23, 0x63, 0x1b, 0x36,
+	0x3f, 0x26, 0xf4, 0x30, 0xb2, 0x5e, 0x11, 0xca, 0x8e, 0xcd, 0x58, 0xfe, 0x2f, 0x0d, 0xb6, 0x72,
+	0x0a, 0x69, 0xfc, 0x00, 0xd6, 0xc6, 0x5c, 0x1a, 0x93, 0x74, 0x57, 0x21, 0xa9, 0x70, 0x4a, 0x5f,
+	0x88, 0xcc, 0x78, 0x9e, 0xfe, 0x1b, 0x0d, 0x2a, 0x42, 0x86, 0x1e, 0x40, 0x4d, 0x48, 0x17, 0x73,
+	0x55, 0x15, 0x80, 0xa1, 0x8d, 0xf6, 0x61, 0x3d, 0xf0, 0x22, 0xb6, 0xeb, 0x46, 0x62, 0x95, 0x4a,
+	0xdc, 0x01, 0xe8, 0xb3, 0x51, 0x9f, 0x2f, 0x4a, 0x43, 0x02, 0xd8, 0x20, 0x44, 0xef, 0x41, 0xc3,
+	0xc2, 0xd6, 0x39, 0xb1, 0x47, 0xea, 0x66, 0x51, 0xf1, 0x75, 0xa1, 0xe7, 0x70, 0xc6, 0x50, 0x12,
+	0x40, 0xc2, 0xd0, 0x13, 0x40, 0xaa, 0x30, 0xa5, 0x98, 0x7a, 0x14, 0x4f, 0x63, 0x8a, 0xf9, 0x00,
+	0xdd, 0x82, 0x15, 0xc7, 0x16, 0x6e, 0x35, 0x0e, 0x41, 0x89, 0x81, 0x89, 0x8d, 0x01, 0xb4, 0x13,
+	0x4b, 0x71, 0x8e, 0xee, 0x42, 0x69, 0x61, 0xe0, 0x25, 0xc7, 0x36, 0x5e, 0x2a, 0x2e, 0x25, 0x1f,
+	0x5f, 0x32, 0x09, 0xed, 0xc5, 0x59, 0x3c, 0xcf, 0x8f, 0x4c, 0xda, 0xfb, 0xc9, 0x02, 0x2c, 0xc7,
+	0xf6, 0x01, 0xd2, 0x35, 0x4d, 0xf1, 0xda, 0x22, 0xfc, 0x27, 0xd0, 0x3a, 0x91, 0x2b, 0x70, 0xcd,
+	0x28, 0x51, 0x17, 0xd6, 0xb0, 0x6d, 0x07, 0x24, 0x0c, 0xf9, 0x0e, 0xa8, 0x99, 0xf1, 0xd0, 0x30,
+	0xa0, 0x9d, 0x1a, 0x93, 0xe1, 0x37, 0xa1, 0xe4, 0xbd, 0xe2, 0xd6, 0xaa, 0x66, 0xc9, 0x7b, 0x65,
+	0x7c, 0x08, 0x9d, 0xa7, 0x9e, 0xf7, 0x2a, 0xf2, 0xd5, 0x4f, 0x36, 0x93, 0x4f, 0xd6, 0x96, 0x7c,
+	0xe2, 0x73, 0x40, 0xea, 0xf4, 0x84, 0xe3, 0x32, 0x0b, 0x87, 0x5b, 0xc8, 0x86, 0xc9, 0xe5, 0xe8,
+	0x9b, 0x50, 0x9e, 0x11, 0x8a, 0x93, 0xd6, 0x28, 0xd1, 0xff, 0x88, 0x50, 0x6c, 0x63, 0x8a, 0x4d,
+	0xae, 0x37, 0xbe, 0x80, 0x16, 0x0f, 0xd4, 0x3d, 0xf3, 0xae, 0xcb, 0xc6, 0x83, 0xac, 0xab, 0xf5,
+	0x41, 0x27, 0xb5, 0x7e, 0x20, 0x14, 0xa9, 0xf7, 0x7f, 0xd2, 0xa0, 0x9d, 0x7e, 0x40, 0x3a, 0x6f,
+	0x40, 0x99, 0x5e, 0xfa, 0xc2, 0xf9, 0xe6, 0xa0, 0x99, 0x4e, 0x7f, 0x71, 0xe9, 0x13, 0x93, 0xeb,
+	0x50, 0x1f, 0xaa, 0x9e, 0x4f, 0x02, 0x4c, 0xbd, 0x60, 0x3e, 0x88, 0xe7, 0x52, 0x63, 0x26, 0x18,
+	0x86, 0xb7, 0xb0, 0x8f, 0x2d, 0x87, 0x5e, 0x76, 0x57, 0xf2, 0xf8, 0x23, 0xa9, 0x31, 0x13, 0x0c,
+	0x8b, 0xe2, 0x82, 0x04, 0xa1, 0xe3, 0xb9, 0xdd, 0x72, 0x3e, 0x8a, 0xcf, 0x84, 0xc2, 0x8c, 0x11,
+	0xc6, 0x0c, 0x5a, 0x1f, 0x39, 0xae, 0xfd, 0x8c, 0xe0, 0xe0, 0xba, 0x2c, 0xbd, 0x03, 0xab, 0x21,
+	0xc5, 0x81, 0xa8, 0x49, 0xf3, 0x10, 0xa1, 0x4c, 0x6b, 0x80, 0xa8, 0x4b, 0x62, 0x60, 0x3c, 0x86,
+	0x76, 0xfa, 0x39, 0xc9, 0xd9, 0xf2, 0x8d, 0x80, 0xa0, 0x7d, 0x1c, 0xcd, 0xfc, 0xcc, 0xf9, 0xf9,
+	0x1d, 0xe8, 0x28, 0xb2, 0xbc, 0xa9, 0x85, 0x7b, 0xa4, 0x09, 0x0d, 0xb5, 0xcd, 0x32, 0xfe, 0xad,
+	0xc1, 0x06, 0x13, 0x9c, 0x46, 0xb3, 0x19, 0x0e, 0x2e, 0x13, 0x4b, 0xb7, 0x01, 0xa2, 0x90, 0xd8,
+	0xa3, 0xd0, 0xc7, 0x16, 0x91, 0x67, 0x4d, 0x8d, 0x49, 0x4e, 0x99, 0x00, 0xdd, 0x85, 0x16, 0xbe,
+	0xc0, 0xce, 0x94, 0xf5, 0xaa, 0x12, 0x23, 0x2a, 0x74, 0x33, 0x11, 0x0b, 0x20, 0xab, 0xd2, 0xcc,
+	0x8e, 0xe3, 0x4e, 0x78, 0x5e, 0xc5, 0x55, 0x3a, 0x24, 0xf6, 0x50, 0x88, 0x58, 0xa5, 0xe7, 0x10,
+	0x22, 0x10, 0xa2, 0xdd, 0xe2, 0x5f, 0xff, 0xa1, 0x00, 0x7c, 0x03, 0x9a, 0x1c, 0x30, 0xc6, 0xae,
+	0xfd, 0x33, 0xc7, 0xa6, 0xe7, 0xb2, 0xcf, 0x5a, 0x67, 0xd2, 0xc3, 0x58, 0x88, 0xf6, 0x61, 0x23,
+	0xf5, 0x29, 0xc5, 0x56, 0x38, 0x16, 0x25, 0xaa, 0x64, 0x02, 0xa7, 0x15, 0x87, 0xe7, 0x63, 0x0f,
+	0x07, 0x76, 0xcc, 0xc7, 0x1f, 0xca, 0xd0, 0x51, 0x84, 0x92, 0x8d, 0x6b, 0x97, 0xd5, 0x77, 0xa1,
+	0xcd, 0x81, 0x96, 0xe7, 0xba, 0xc4, 0x62, 0xd7, 0xae, 0x50, 0x12, 0xd3, 0x62, 0xf2, 0xa3, 0x54,
+	0x8c, 0x1e, 0x40, 0x67, 0xec, 0x79, 0x34, 0xa4, 0x01, 0xf6, 0x47, 0xf1, 0xb6, 0x5b, 0xe1, 0x27,
+	0x44, 0x3b, 0x51, 0xc8, 0x5d, 0xc7, 0xec, 0xf2, 0x6b, 0x8f, 0x8b, 0xa7, 0x09, 0xb6, 0xcc, 0xb1,
+	0xad, 0x58, 0xae, 0x40, 0xc9, 0x9b, 0x1c, 0x74, 0x55, 0x40, 0xc9, 0x9b, 0x2c, 0xf4, 0x31, 0xcf,
+	0x64, 0x1a, 0x72, 0x8e, 0xea, 0x83, 0x5d, 0xa5, 0x9e, 0x16, 0xe4, 0x84, 0x29, 0xc0, 0xe8, 0x11,
+	0x54, 0x44, 0x93, 0xd5, 0x5d, 0xe3, 0xd3, 0xde, 0x9a, 0x6b, 0x1a, 0x8e, 0xe5, 0x95, 0xd3, 0x94,
+	0x40, 0xf4, 0x01, 0xd4, 0xf9, 0xe5, 0xcb, 0x77, 0xdc, 0x09, 0xb1, 0xbb, 0xd5, 0xa5, 0xcd, 0x06,
+	0x30, 0xf8, 0x09, 0x47, 0xa3, 0x0f, 0xa1, 0xc1, 0x27, 0xbf, 0x8e, 0x48, 0xc0, 0x5a, 0x95, 0xda,
+	0xd2, 0xd9, 0xfc, 0x63, 0x9f, 0x0a, 0x38, 0xcb, 0x1e, 0xcb, 0x0b, 0x82, 0xc8, 0x4f, 0x2e, 0x87,
+	0x20, 0xb2, 0x47, 0x4a, 0xe5, 0xfd, 0xf0, 0xfb, 0xb0, 0xce, 0xbf, 0x12, 0x5a, 0x41, 0x34, 0x1e,
+	0x13, 0xbb, 0x5b, 0x5f, 0xde, 0x11, 0xb1, 0x09, 0xa7, 0x12, 0xcf, 0x1a, 0x9a, 0x23, 0xd5, 0x62,
+	0x9c, 0x51, 0x7f, 0xd6, 0xa0, 0xa1, 0x2a, 0xd0, 0x23, 0x68, 0x84, 0x98, 0x92, 0xe9, 0xd4, 0xa1,
+	0x57, 0x64, 0x54, 0x3d, 0xc1, 0x0c, 0x6d, 0x74, 0x1f, 0xaa, 0xdc, 0x77, 0x06, 0x17, 0xa7, 0x4e,
+	0x4b, 0xc2, 0xd7, 0xb8, 0xcd, 0xe1, 0xb1, 0xb9, 0xc6, 0x01, 0x43, 0x5e, 0x6f, 0x66, 0x4e, 0x18,
+	0x3a, 0xee, 0x84, 0x67, 0x53, 0xd5, 0x8c, 0x87, 0x6c, 0x15, 0x6c, 0x42, 0x89, 0x45, 0xd9, 0x05,
+	0x81, 0x76, 0xcb, 0x4b, 0x03, 0x84, 0x18, 0x7e, 0xc0, 0xba, 0x91, 0xad, 0x5c, 0x78, 0x72, 0x6f,
+	0xec, 0x43, 0x45, 0xf2, 0xaa, 0xcd, 0xdd, 0x08, 0xd4, 0x19, 0xa6, 0x84, 0x19, 0xbf, 0xd5, 0x60,
+	0x53, 0xde, 0x6f, 0x9f, 0x10, 0x3c, 0xa5, 0xe7, 0xf1, 0xc1, 0xbb, 0x0d, 0x15, 0xd1, 0x71, 0xc9,
+	0x47, 0x01, 0x39, 0x62, 0x2b, 0x48, 0x5c, 0x2b, 0xb8, 0xf4, 0x99, 0xe3, 0xfc, 0xd1, 0x80, 0x73,
+	0x60, 0xae, 0x27, 0xd2, 0x13, 0xf6, 0x7a, 0xf0, 0x36, 0xc4, 0x6f, 0x02, 0x23, 0xc7, 0xb5, 0xc9,
+	0x1b, 0x79, 0xd6, 0x34, 0xa4, 0x70, 0xc8, 0x64, 0xec, 0x5c, 0xf3, 0x03, 0xef, 0xa7, 0xc4, 0xe2,
+	0x7d, 0x5f, 0x99, 0xdb, 0xa9, 0x49, 0xc9, 0xd0, 0x36, 0x9e, 0xc2, 0x7a, 0xc6, 0x35, 0x76, 0x7e,
+	0x79, 0x2e, 0xbb, 0x66, 0x8d, 0xe2, 0x83, 0x95, 0x75, 0xf4, 0x75, 0x21, 0x13, 0xbd, 0x5e, 0x17,
+	0xd6, 0xe4, 0x27, 0xa4, 0x5f, 0xf1, 0xd0, 0xf8, 0x85, 0x06, 0x5b, 0xb9, 0x48, 0x93, 0xbb, 0x5a,
+	0xe5, 0x9c, 0x4b, 0x64, 0x99, 0xef, 0xaa, 0x5b, 0x2f, 0x33, 0x43, 0xe2, 0xd0, 0x07, 0x00, 0x01,
+	0xb1, 0x23, 0xd7, 0xc6, 0xae, 0x75, 0x29, 0xeb, 0xe6, 0x8e, 0xf2, 0x2e, 0x62, 0x26, 0xca, 0x53,
+	0xeb, 0x9c, 0xcc, 0x88, 0xa9, 0xc0, 0x8d, 0x7f, 0x6a, 0xb0, 0xf1, 0x7c, 0xcc, 0x62, 0xcc, 0x32,
+	0x3e, 0xcf, 0xac, 0x56, 0xc4, 0x6c, 0xba, 0x30, 0xa5, 0xcc, 0xc2, 0x64, 0xc9, 0x5c, 0xc9, 0x91,
+	0xc9, 0x2e, 0xde, 0xbc, 0x16, 0x8e, 0xf0, 0x19, 0x25, 0xc1, 0x28, 0x26, 0x49, 0x3e, 0xb9, 0x70,
+	0xd5, 0x01, 0xd3, 0xc4, 0x4f, 0x42, 0xdf, 0x02, 0x44, 0x5c, 0x7b, 0x34, 0x26, 0x67, 0x5e, 0x40,
+	0x12, 0xb8, 0x38, 0xeb, 0xdb, 0xc4, 0xb5, 0x0f, 0xb9, 0x22, 0x46, 0x27, 0x05, 0xb6, 0xa2, 0x5e,
+	0xb2, 0x7e, 0xa5, 0xc1, 0x66, 0x36, 0x52, 0xc9, 0xf8, 0xe3, 0xb9, 0xa7, 0x97, 0xc5, 0x9c, 0x27,
+	0xc8, 0xff, 0x8b, 0xf5, 0xc1, 0xaf, 0xcb, 0xd0, 0xf8, 0x04, 0xdb, 0xc3, 0xf8, 0x2b, 0x68, 0x08,
+	0x90, 0x5e, 0x84, 0xd0, 0xad, 0xcc, 0x46, 0xc9, 0xdd, 0x8f, 0xf4, 0xdb, 0x0b, 0xb4, 0x32, 0x9c,
+	0x23, 0xa8, 0xc6, 0xed, 0x29, 0xd2, 0x15, 0x68, 0xae, 0x01, 0xd6, 0x77, 0x0a, 0x75, 0xd2, 0xc8,
+	0x10, 0x20, 0x6d, 0x40, 0x33, 0xfe, 0xcc, 0xb5, 0xb5, 0xfa, 0xed, 0x05, 0xda, 0xd4, 0x9f, 0xb8,
+	0x19, 0xcc, 0xf8, 0x93, 0x6b, 0x41, 0xf5, 0x9d, 0x42, 0x5d, 0x6a, 0x24, 0xee, 0x8e, 0x32, 0x46,
+	0x72, 0x1d, 0x9a, 0xbe, 0x53, 0xa8, 0x93, 0x46, 0x3e, 0x82, 0x5a, 0xd2, 0x18, 0xa1, 0xcc, 0x3d,
+	0x3a, 0xd7, 0x42, 0xe9, 0xb7, 0x8a, 0x95, 0xd2, 0x8e, 0x09, 0xeb, 0x99, 0x4b, 0x25, 0xea, 0x2d,
+	0xbe, 0x6e, 0x0a, 0x7b, 0x7b, 0xcb, 0xee, 0xa3, 0x83, 0xbf, 0x96, 0xa1, 0xfd, 0xfc, 0x82, 0x04,
+	0x53, 0x7c, 0xf9, 0xb5, 0x64, 0xc5, 0x57, 0x15, 0xfb, 0x11, 0x54, 0xe3, 0xf7, 0xc2, 0xcc, 0x42,
+	0xe4, 0x5e, 0x20, 0xf5, 0x9d, 0x42, 0x9d, 0x34, 0xf2, 0x14, 0xea, 0xca, 0x93, 0x17, 0xca, 0xb8,
+	0x3e, 0xf7, 0xde, 0xa7, 0xef, 0x2e, 0x52, 0x4b, 0x6b, 0x3f, 0x86, 0x56, 0xee, 0xe1, 0x0b, 0xdd,
+	0x51, 0xa6, 0x14, 0xbf, 0xa2, 0xe9, 0xc6, 0x55, 0x10, 0x69, 0xf9, 0x25, 0x34, 0xb3, 0x6f, 0x3c,
+	0x68, 0xaf, 0xe8, 0xf5, 0x45, 0x7d, 0x40, 0xd2, 0xef, 0x5c, 0x81, 0x90, 0x66, 0xbf, 0x80, 0xce,
+	0xdc, 0x73, 0x0f, 0x7a, 0xfb, 0x8a, 0x77, 0x9d, 0x84, 0x8a, 0x77, 0xae, 0x06, 0xc9, 0x5c, 0x62,
+	0x9d, 0x3b, 0x2f, 0xac, 0xa7, 0xd4, 0x0b, 0x48, 0x9a, 0x4e, 0x87, 0xb0, 0x2a, 0x08, 0xbf, 0x99,
+	0x6b, 0xe7, 0x0a, 0xa9, 0x2e, 0xe8, 0xf3, 0x8c, 0x1b, 0xe8, 0x09, 0xd4, 0x92, 0x26, 0x38, 0x9b,
+	0x47, 0xb9, 0x7e, 0x59, 0xbf, 0x55, 0xac, 0x4c, 0x2c, 0xbd, 0x80, 0xf5, 0x4c, 0xdb, 0x90, 0xd9,
+	0x45, 0x45, 0xfd, 0x92, 0xbe, 0xb7, 0x18, 0x10, 0x5b, 0x1d, 0xfc, 0x52, 0x83, 0x4d, 0xe5, 0xb5,
+	0x3c, 0x0d, 0xde, 0x87, 0x9b, 0x0b, 0xde, 0xe0, 0xd1, 0xbb, 0xea, 0x01, 0x76, 0xe5, 0x1f, 0x1c,
+	0xfa, 0xfd, 0xeb, 0x40, 0xe5, 0x32, 0xfc, 0x51, 0x83, 0x96, 0x28, 0x1b, 0xa9, 0x17, 0x9f, 0x42,
+	0x43, 0xad, 0x41, 0x48, 0x25, 0xbc, 0xa0, 0x0c, 0xeb, 0xbd, 0x85, 0x7a, 0x95, 0xc7, 0x6c, 0x63,
+	0xd2, 0x5b, 0x58, 0xbd, 0x0a, 0x78, 0x2c, 0x6c, 0x42, 0x8c, 0x1b, 0x87, 0xe5, 0x9f, 0x94, 0xfc,
+	0xf1, 0xb8, 0xc2, 0x5b, 0xbf, 0x6f, 0xff, 0x67, 0x00, 0x86, 0x01, 0xb9, 0x81, 0x80, 0x1a, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -2151,6 +2636,12 @@ type OverlayInspectorClient interface {
 	GetStats(ctx context.Context, in *GetStatsRequest, opts ...grpc.CallOption) (*GetStatsResponse, error)
 	// CreateStats creates a node with specified stats
 	CreateStats(ctx context.Context, in *CreateStatsRequest, opts ...grpc.CallOption) (*CreateStatsResponse, error)
+	// VettingPipeline returns the nodes that aren't vetted yet
+	VettingPipeline(ctx context.Context, in *VettingPipelineRequest, opts ...grpc.CallOption) (*VettingPipelineResponse, error)
+	// DisqualifyNode disqualifies a node manually
+	DisqualifyNode(ctx context.Context, in *DisqualifyNodeRequest, opts ...grpc.CallOption) (*DisqualifyNodeResponse, error)
+	// DisqualifiedNodes returns the disqualified nodes
+	DisqualifiedNodes(ctx context.Context, in *DisqualifiedNodesRequest, opts ...grpc.CallOption) (*DisqualifiedNodesResponse, error)
 }
 
 type overlayInspectorClient struct {
@@ -2197,6 +2688,33 @@ func (c *overlayInspectorClient) CreateStats(ctx context.Context, in *CreateStat
 	return out, nil
 }
 
+func (c *overlayInspectorClient) VettingPipeline(ctx context.Context, in *VettingPipelineRequest, opts ...grpc.CallOption) (*VettingPipelineResponse, error) {
+	out := new(VettingPipelineResponse)
+	err := c.cc.Invoke(ctx, "/inspector.OverlayInspector/VettingPipeline", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *overlayInspectorClient) DisqualifyNode(ctx context.Context, in *DisqualifyNodeRequest, opts ...grpc.CallOption) (*DisqualifyNodeResponse, error) {
+	out := new(DisqualifyNodeResponse)
+	err := c.cc.Invoke(ctx, "/inspector.OverlayInspector/DisqualifyNode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *overlayInspectorClient) DisqualifiedNodes(ctx context.Context, in *DisqualifiedNodesRequest, opts ...grpc.CallOption) (*DisqualifiedNodesResponse, error) {
+	out := new(DisqualifiedNodesResponse)
+	err := c.cc.Invoke(ctx, "/inspector.OverlayInspector/DisqualifiedNodes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // OverlayInspectorServer is the server API for OverlayInspector service.
 type OverlayInspectorServer interface {
 	// CountNodes returns the number of nodes in the cache
@@ -2207,6 +2725,12 @@ type OverlayInspectorServer interface {
 	GetStats(context.Context, *GetStatsRequest) (*GetStatsResponse, error)
 	// CreateStats creates a node with specified stats
 	CreateStats(context.Context, *CreateStatsRequest) (*CreateStatsResponse, error)
+	// VettingPipeline returns the nodes that aren't vetted yet
+	VettingPipeline(context.Context, *VettingPipelineRequest) (*VettingPipelineResponse, error)
+	// DisqualifyNode disqualifies a node manually
+	DisqualifyNode(context.Context, *DisqualifyNodeRequest) (*DisqualifyNodeResponse, error)
+	// DisqualifiedNodes returns the disqualified nodes
+	DisqualifiedNodes(context.Context, *DisqualifiedNodesRequest) (*DisqualifiedNodesResponse, error)
 }
 
 func RegisterOverlayInspectorServer(s *grpc.Server, srv OverlayInspectorServer) {
@@ -2285,6 +2809,60 @@ func _OverlayInspector_CreateStats_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _OverlayInspector_VettingPipeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VettingPipelineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OverlayInspectorServer).VettingPipeline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inspector.OverlayInspector/VettingPipeline",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OverlayInspectorServer).VettingPipeline(ctx, req.(*VettingPipelineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OverlayInspector_DisqualifyNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisqualifyNodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OverlayInspectorServer).DisqualifyNode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inspector.OverlayInspector/DisqualifyNode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OverlayInspectorServer).DisqualifyNode(ctx, req.(*DisqualifyNodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OverlayInspector_DisqualifiedNodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisqualifiedNodesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OverlayInspectorServer).DisqualifiedNodes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inspector.OverlayInspector/DisqualifiedNodes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OverlayInspectorServer).DisqualifiedNodes(ctx, req.(*DisqualifiedNodesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _OverlayInspector_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "inspector.OverlayInspector",
 	HandlerType: (*OverlayInspectorServer)(nil),
@@ -2305,6 +2883,18 @@ var _OverlayInspector_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CreateStats",
 			Handler:    _OverlayInspector_CreateStats_Handler,
 		},
+		{
+			MethodName: "VettingPipeline",
+			Handler:    _OverlayInspector_VettingPipeline_Handler,
+		},
+		{
+			MethodName: "DisqualifyNode",
+			Handler:    _OverlayInspector_DisqualifyNode_Handler,
+		},
+		{
+			MethodName: "DisqualifiedNodes",
+			Handler:    _OverlayInspector_DisqualifiedNodes_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "inspector.proto",
@@ -2318,6 +2908,8 @@ type PieceStoreInspectorClient interface {
 	Stats(ctx context.Context, in *StatsRequest, opts ...grpc.CallOption) (*StatSummaryResponse, error)
 	// Dashboard returns stats for a specific storagenode
 	Dashboard(ctx context.Context, in *DashboardRequest, opts ...grpc.CallOption) (*DashboardResponse, error)
+	// CorruptPieces returns the pieces found corrupted by the scrubber
+	CorruptPieces(ctx context.Context, in *CorruptPiecesRequest, opts ...grpc.CallOption) (*CorruptPiecesResponse, error)
 }
 
 type pieceStoreInspectorClient struct {
@@ -2346,12 +2938,23 @@ func (c *pieceStoreInspectorClient) Dashboard(ctx context.Context, in *Dashboard
 	return out, nil
 }
 
+func (c *pieceStoreInspectorClient) CorruptPieces(ctx context.Context, in *CorruptPiecesRequest, opts ...grpc.CallOption) (*CorruptPiecesResponse, error) {
+	out := new(CorruptPiecesResponse)
+	err := c.cc.Invoke(ctx, "/inspector.PieceStoreInspector/CorruptPieces", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PieceStoreInspectorServer is the server API for PieceStoreInspector service.
 type PieceStoreInspectorServer interface {
 	// Stats return space and bandwidth stats for a storagenode
 	Stats(context.Context, *StatsRequest) (*StatSummaryResponse, error)
 	// Dashboard returns stats for a specific storagenode
 	Dashboard(context.Context, *DashboardRequest) (*DashboardResponse, error)
+	// CorruptPieces returns the pieces found corrupted by the scrubber
+	CorruptPieces(context.Context, *CorruptPiecesRequest) (*CorruptPiecesResponse, error)
 }
 
 func RegisterPieceStoreInspectorServer(s *grpc.Server, srv PieceStoreInspectorServer) {
@@ -2394,6 +2997,24 @@ func _PieceStoreInspector_Dashboard_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PieceStoreInspector_CorruptPieces_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CorruptPiecesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PieceStoreInspectorServer).CorruptPieces(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inspector.PieceStoreInspector/CorruptPieces",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PieceStoreInspectorServer).CorruptPieces(ctx, req.(*CorruptPiecesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _PieceStoreInspector_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "inspector.PieceStoreInspector",
 	HandlerType: (*PieceStoreInspectorServer)(nil),
@@ -2406,6 +3027,10 @@ var _PieceStoreInspector_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Dashboard",
 			Handler:    _PieceStoreInspector_Dashboard_Handler,
 		},
+		{
+			MethodName: "CorruptPieces",
+			Handler:    _PieceStoreInspector_CorruptPieces_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "inspector.proto",
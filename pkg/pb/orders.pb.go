@@ -323,11 +323,13 @@ func (m *SettlementRequest) GetOrder() *Order2 {
 }
 
 type SettlementResponse struct {
-	SerialNumber         SerialNumber              `protobuf:"bytes,1,opt,name=serial_number,json=serialNumber,proto3,customtype=SerialNumber" json:"serial_number"`
-	Status               SettlementResponse_Status `protobuf:"varint,2,opt,name=status,proto3,enum=orders.SettlementResponse_Status" json:"status,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
-	XXX_unrecognized     []byte                    `json:"-"`
-	XXX_sizecache        int32                     `json:"-"`
+	SerialNumber SerialNumber              `protobuf:"bytes,1,opt,name=serial_number,json=serialNumber,proto3,customtype=SerialNumber" json:"serial_number"`
+	Status       SettlementResponse_Status `protobuf:"varint,2,opt,name=status,proto3,enum=orders.SettlementResponse_Status" json:"status,omitempty"`
+	// reason explains why the order was rejected
+	Reason               string   `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *SettlementResponse) Reset()         { *m = SettlementResponse{} }
@@ -361,6 +363,13 @@ func (m *SettlementResponse) GetStatus() SettlementResponse_Status {
 	return SettlementResponse_INVALID
 }
 
+func (m *SettlementResponse) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterEnum("orders.PieceAction", PieceAction_name, PieceAction_value)
 	proto.RegisterEnum("orders.SettlementResponse_Status", SettlementResponse_Status_name, SettlementResponse_Status_value)
@@ -374,47 +383,48 @@ func init() {
 func init() { proto.RegisterFile("orders.proto", fileDescriptor_e0f5d4cf0fc9e41b) }
 
 var fileDescriptor_e0f5d4cf0fc9e41b = []byte{
-	// 634 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x53, 0x4d, 0x6f, 0xd3, 0x40,
-	0x10, 0xed, 0xe6, 0xc3, 0x49, 0x26, 0x69, 0x62, 0xb6, 0x15, 0x0a, 0x11, 0x52, 0x43, 0xc4, 0x21,
-	0xb4, 0x52, 0x4a, 0x8d, 0x84, 0xd4, 0x63, 0xda, 0x58, 0xc5, 0xa8, 0x2a, 0xd1, 0xc6, 0xe5, 0xc0,
-	0x25, 0x72, 0xea, 0xc5, 0xb5, 0x70, 0xbc, 0xc6, 0xbb, 0x96, 0xf8, 0x05, 0x1c, 0xf9, 0x47, 0xdc,
-	0xf9, 0x0d, 0x1c, 0xfa, 0x5b, 0x90, 0xc7, 0x4e, 0xe2, 0x42, 0x50, 0x0f, 0xbd, 0xf9, 0xcd, 0xbc,
-	0xb7, 0x6f, 0x77, 0xde, 0x18, 0x5a, 0x22, 0x76, 0x79, 0x2c, 0x47, 0x51, 0x2c, 0x94, 0xa0, 0x5a,
-	0x86, 0x7a, 0xe0, 0x09, 0x4f, 0x64, 0xb5, 0xde, 0x81, 0x27, 0x84, 0x17, 0xf0, 0x63, 0x44, 0x8b,
-	0xe4, 0xf3, 0xb1, 0xf2, 0x97, 0x5c, 0x2a, 0x67, 0x19, 0x65, 0x84, 0xc1, 0x8f, 0x0a, 0x34, 0x3f,
-	0xa4, 0xba, 0x4b, 0x7f, 0xe9, 0x2b, 0x83, 0x9e, 0xc2, 0xae, 0xe4, 0xb1, 0xef, 0x04, 0xf3, 0x30,
-	0x59, 0x2e, 0x78, 0xdc, 0x25, 0x7d, 0x32, 0x6c, 0x9d, 0xed, 0xff, 0xba, 0x3b, 0xd8, 0xf9, 0x7d,
-	0x77, 0xd0, 0x9a, 0x61, 0xf3, 0x0a, 0x7b, 0xac, 0x25, 0x0b, 0x88, 0x9e, 0x40, 0x4b, 0x3a, 0x8a,
-	0x07, 0x81, 0xaf, 0xf8, 0xdc, 0x77, 0xbb, 0x25, 0x54, 0xb6, 0x73, 0xa5, 0x76, 0x25, 0x5c, 0x6e,
-	0x4d, 0x58, 0x73, 0xcd, 0xb1, 0x5c, 0x7a, 0x04, 0x8d, 0x24, 0x0a, 0xfc, 0xf0, 0x4b, 0xca, 0x2f,
-	0x6f, 0xe5, 0xd7, 0x33, 0x82, 0xe5, 0xd2, 0xb7, 0xd0, 0x91, 0x4a, 0xc4, 0x8e, 0xc7, 0xe7, 0xa1,
-	0x70, 0xd1, 0xa2, 0xb2, 0x55, 0xb2, 0x9b, 0xd3, 0x10, 0xba, 0xf4, 0x10, 0xea, 0x91, 0xcf, 0x6f,
-	0x50, 0x50, 0x45, 0x41, 0x27, 0x17, 0xd4, 0xa6, 0x69, 0xdd, 0x9a, 0xb0, 0x1a, 0x12, 0x2c, 0x97,
-	0xee, 0x43, 0x35, 0x48, 0x07, 0xd1, 0xd5, 0xfa, 0x64, 0x58, 0x66, 0x19, 0xa0, 0x47, 0xa0, 0x39,
-	0x37, 0xca, 0x17, 0x61, 0xb7, 0xd6, 0x27, 0xc3, 0xb6, 0xb1, 0x37, 0xca, 0x07, 0x8f, 0xfa, 0x31,
-	0xb6, 0x58, 0x4e, 0xa1, 0x26, 0xe8, 0x99, 0x1d, 0xff, 0x16, 0xf9, 0xb1, 0x83, 0xb2, 0x7a, 0x9f,
-	0x0c, 0x9b, 0x46, 0x6f, 0x94, 0xa5, 0x31, 0x5a, 0xa5, 0x31, 0xb2, 0x57, 0x69, 0xb0, 0x0e, 0x6a,
-	0xcc, 0xb5, 0x24, 0x3d, 0x06, 0x4d, 0x8a, 0xc7, 0x34, 0x1e, 0x3e, 0x06, 0x35, 0x85, 0x63, 0x8e,
-	0x61, 0x6f, 0x13, 0x8a, 0xf4, 0xbd, 0xd0, 0x51, 0x49, 0xcc, 0xbb, 0x90, 0xce, 0x81, 0xd1, 0x75,
-	0x6b, 0xb6, 0xea, 0x0c, 0xbe, 0x13, 0xd0, 0x70, 0x21, 0x1e, 0xb5, 0x0b, 0x4f, 0x41, 0x73, 0x96,
-	0x22, 0x09, 0x15, 0x6e, 0x41, 0x99, 0xe5, 0x88, 0xbe, 0x02, 0x3d, 0x0f, 0x7c, 0x73, 0x17, 0xcc,
-	0x9d, 0x75, 0xb2, 0xfa, 0xe6, 0x22, 0x3e, 0x34, 0x70, 0xbc, 0xef, 0x1c, 0x79, 0x7b, 0x2f, 0x43,
-	0xf2, 0x40, 0x86, 0x14, 0x2a, 0xb7, 0x8e, 0xbc, 0xcd, 0xf6, 0x8f, 0xe1, 0x37, 0x7d, 0x0e, 0x8d,
-	0xbf, 0x0d, 0x37, 0x85, 0x81, 0x0b, 0x4f, 0x66, 0x5c, 0xa9, 0x80, 0x2f, 0x79, 0xa8, 0x18, 0xff,
-	0x9a, 0x70, 0x99, 0x5e, 0x35, 0x5f, 0x05, 0x82, 0x53, 0x5f, 0x67, 0x5e, 0xf8, 0x5b, 0x56, 0xfb,
-	0xf1, 0x12, 0xaa, 0xd8, 0x44, 0xcb, 0xa6, 0xd1, 0xbe, 0x47, 0x35, 0x58, 0xd6, 0x1c, 0xfc, 0x24,
-	0x40, 0x8b, 0x36, 0x32, 0x12, 0xa1, 0xe4, 0x8f, 0x99, 0xf2, 0x29, 0x68, 0x52, 0x39, 0x2a, 0x91,
-	0x68, 0xdc, 0x36, 0x5e, 0xac, 0x8c, 0xff, 0xb5, 0x19, 0xcd, 0x90, 0xc8, 0x72, 0xc1, 0xe0, 0x04,
-	0xb4, 0xac, 0x42, 0x9b, 0x50, 0xb3, 0xae, 0x3e, 0x8e, 0x2f, 0xad, 0x89, 0xbe, 0x43, 0x5b, 0x50,
-	0x1f, 0x9f, 0x9f, 0x9b, 0x53, 0xdb, 0x9c, 0xe8, 0x24, 0x45, 0xcc, 0x7c, 0x6f, 0x9e, 0xa7, 0xa8,
-	0x74, 0xe8, 0x41, 0xb3, 0xb0, 0xef, 0xf7, 0x75, 0x35, 0x28, 0x4f, 0xaf, 0x6d, 0x9d, 0xa4, 0x1f,
-	0x17, 0xa6, 0xad, 0x97, 0xe8, 0x2e, 0x34, 0x2e, 0x4c, 0x7b, 0x3e, 0xbe, 0x9e, 0x58, 0xb6, 0x5e,
-	0xa6, 0x6d, 0x80, 0x14, 0x32, 0x73, 0x3a, 0xb6, 0x98, 0x5e, 0x49, 0xf1, 0xf4, 0x7a, 0x8d, 0xab,
-	0x14, 0x40, 0x9b, 0x98, 0x97, 0xa6, 0x6d, 0xea, 0x9a, 0x31, 0xcb, 0x37, 0x50, 0x52, 0x0b, 0x60,
-	0xf3, 0x14, 0xfa, 0x6c, 0xdb, 0xf3, 0x30, 0xac, 0x5e, 0xef, 0xff, 0x2f, 0x1f, 0xec, 0x0c, 0xc9,
-	0x6b, 0x72, 0x56, 0xf9, 0x54, 0x8a, 0x16, 0x0b, 0x0d, 0xff, 0x99, 0x37, 0x7f, 0x02, 0x00, 0x00,
-	0xff, 0xff, 0xbc, 0xfe, 0x4a, 0x7c, 0x3a, 0x05, 0x00, 0x00,
+	// 644 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x53, 0xcd, 0x6e, 0xd3, 0x4c,
+	0x14, 0xed, 0xe4, 0xc7, 0x49, 0x6e, 0xd2, 0xc4, 0xdf, 0xb4, 0xfa, 0x94, 0x2f, 0xfa, 0xa4, 0x86,
+	0x88, 0x45, 0x68, 0xa5, 0x94, 0x1a, 0x09, 0xa9, 0xcb, 0xb4, 0xb1, 0x8a, 0x51, 0x55, 0xa2, 0x89,
+	0xcb, 0x82, 0x4d, 0xe4, 0xd4, 0x83, 0x6b, 0xe1, 0x78, 0x8c, 0x67, 0x2c, 0xf1, 0x04, 0x2c, 0x79,
+	0x2e, 0x5e, 0x80, 0x0d, 0x8b, 0x3e, 0x0b, 0x9a, 0x6b, 0xe7, 0xa7, 0x50, 0xd4, 0x45, 0x77, 0x3e,
+	0x73, 0xcf, 0xb9, 0x67, 0x7c, 0xcf, 0x1d, 0x68, 0x89, 0xd4, 0xe7, 0xa9, 0x1c, 0x25, 0xa9, 0x50,
+	0x82, 0x1a, 0x39, 0xea, 0x41, 0x20, 0x02, 0x91, 0x9f, 0xf5, 0x0e, 0x02, 0x21, 0x82, 0x88, 0x1f,
+	0x23, 0x5a, 0x64, 0x1f, 0x8f, 0x55, 0xb8, 0xe4, 0x52, 0x79, 0xcb, 0x24, 0x27, 0x0c, 0xbe, 0x55,
+	0xa0, 0xf9, 0x4e, 0xeb, 0x2e, 0xc3, 0x65, 0xa8, 0x2c, 0x7a, 0x0a, 0xbb, 0x92, 0xa7, 0xa1, 0x17,
+	0xcd, 0xe3, 0x6c, 0xb9, 0xe0, 0x69, 0x97, 0xf4, 0xc9, 0xb0, 0x75, 0xb6, 0xff, 0xfd, 0xee, 0x60,
+	0xe7, 0xe7, 0xdd, 0x41, 0x6b, 0x86, 0xc5, 0x2b, 0xac, 0xb1, 0x96, 0xdc, 0x42, 0xf4, 0x04, 0x5a,
+	0xd2, 0x53, 0x3c, 0x8a, 0x42, 0xc5, 0xe7, 0xa1, 0xdf, 0x2d, 0xa1, 0xb2, 0x5d, 0x28, 0x8d, 0x2b,
+	0xe1, 0x73, 0x67, 0xc2, 0x9a, 0x6b, 0x8e, 0xe3, 0xd3, 0x23, 0x68, 0x64, 0x49, 0x14, 0xc6, 0x9f,
+	0x34, 0xbf, 0xfc, 0x20, 0xbf, 0x9e, 0x13, 0x1c, 0x9f, 0xbe, 0x86, 0x8e, 0x54, 0x22, 0xf5, 0x02,
+	0x3e, 0x8f, 0x85, 0x8f, 0x16, 0x95, 0x07, 0x25, 0xbb, 0x05, 0x0d, 0xa1, 0x4f, 0x0f, 0xa1, 0x9e,
+	0x84, 0xfc, 0x06, 0x05, 0x55, 0x14, 0x74, 0x0a, 0x41, 0x6d, 0xaa, 0xcf, 0x9d, 0x09, 0xab, 0x21,
+	0xc1, 0xf1, 0xe9, 0x3e, 0x54, 0x23, 0x3d, 0x88, 0xae, 0xd1, 0x27, 0xc3, 0x32, 0xcb, 0x01, 0x3d,
+	0x02, 0xc3, 0xbb, 0x51, 0xa1, 0x88, 0xbb, 0xb5, 0x3e, 0x19, 0xb6, 0xad, 0xbd, 0x51, 0x31, 0x78,
+	0xd4, 0x8f, 0xb1, 0xc4, 0x0a, 0x0a, 0xb5, 0xc1, 0xcc, 0xed, 0xf8, 0x97, 0x24, 0x4c, 0x3d, 0x94,
+	0xd5, 0xfb, 0x64, 0xd8, 0xb4, 0x7a, 0xa3, 0x3c, 0x8d, 0xd1, 0x2a, 0x8d, 0x91, 0xbb, 0x4a, 0x83,
+	0x75, 0x50, 0x63, 0xaf, 0x25, 0xba, 0x0d, 0x9a, 0x6c, 0xb7, 0x69, 0x3c, 0xde, 0x06, 0x35, 0x5b,
+	0x6d, 0x8e, 0x61, 0x6f, 0x13, 0x8a, 0x0c, 0x83, 0xd8, 0x53, 0x59, 0xca, 0xbb, 0xa0, 0xe7, 0xc0,
+	0xe8, 0xba, 0x34, 0x5b, 0x55, 0x06, 0x5f, 0x09, 0x18, 0xb8, 0x10, 0x4f, 0xda, 0x85, 0x7f, 0xc1,
+	0xf0, 0x96, 0x22, 0x8b, 0x15, 0x6e, 0x41, 0x99, 0x15, 0x88, 0xbe, 0x00, 0xb3, 0x08, 0x7c, 0x73,
+	0x17, 0xcc, 0x9d, 0x75, 0xf2, 0xf3, 0xcd, 0x45, 0x42, 0x68, 0xe0, 0x78, 0xdf, 0x78, 0xf2, 0xf6,
+	0x5e, 0x86, 0xe4, 0x91, 0x0c, 0x29, 0x54, 0x6e, 0x3d, 0x79, 0x9b, 0xef, 0x1f, 0xc3, 0x6f, 0xfa,
+	0x3f, 0x34, 0x7e, 0x37, 0xdc, 0x1c, 0x0c, 0x7c, 0xf8, 0x67, 0xc6, 0x95, 0x8a, 0xf8, 0x92, 0xc7,
+	0x8a, 0xf1, 0xcf, 0x19, 0x97, 0xfa, 0xaa, 0xc5, 0x2a, 0x10, 0x9c, 0xfa, 0x3a, 0xf3, 0xad, 0xd7,
+	0xb2, 0xda, 0x8f, 0xe7, 0x50, 0xc5, 0x22, 0x5a, 0x36, 0xad, 0xf6, 0x3d, 0xaa, 0xc5, 0xf2, 0xe2,
+	0xe0, 0x07, 0x01, 0xba, 0x6d, 0x23, 0x13, 0x11, 0x4b, 0xfe, 0x94, 0x29, 0x9f, 0x82, 0x21, 0x95,
+	0xa7, 0x32, 0x89, 0xc6, 0x6d, 0xeb, 0xd9, 0xca, 0xf8, 0x4f, 0x9b, 0xd1, 0x0c, 0x89, 0xac, 0x10,
+	0xe8, 0x80, 0x52, 0xee, 0x49, 0x11, 0xe3, 0x34, 0x1a, 0xac, 0x40, 0x83, 0x13, 0x30, 0x72, 0x26,
+	0x6d, 0x42, 0xcd, 0xb9, 0x7a, 0x3f, 0xbe, 0x74, 0x26, 0xe6, 0x0e, 0x6d, 0x41, 0x7d, 0x7c, 0x7e,
+	0x6e, 0x4f, 0x5d, 0x7b, 0x62, 0x12, 0x8d, 0x98, 0xfd, 0xd6, 0x3e, 0xd7, 0xa8, 0x74, 0x18, 0x40,
+	0x73, 0xeb, 0x1d, 0xdc, 0xd7, 0xd5, 0xa0, 0x3c, 0xbd, 0x76, 0x4d, 0xa2, 0x3f, 0x2e, 0x6c, 0xd7,
+	0x2c, 0xd1, 0x5d, 0x68, 0x5c, 0xd8, 0xee, 0x7c, 0x7c, 0x3d, 0x71, 0x5c, 0xb3, 0x4c, 0xdb, 0x00,
+	0x1a, 0x32, 0x7b, 0x3a, 0x76, 0x98, 0x59, 0xd1, 0x78, 0x7a, 0xbd, 0xc6, 0x55, 0x0a, 0x60, 0x4c,
+	0xec, 0x4b, 0xdb, 0xb5, 0x4d, 0xc3, 0x9a, 0x15, 0x9b, 0x29, 0xa9, 0x03, 0xb0, 0xf9, 0x45, 0xfa,
+	0xdf, 0x43, 0xbf, 0x8d, 0x21, 0xf6, 0x7a, 0x7f, 0x9f, 0xc8, 0x60, 0x67, 0x48, 0x5e, 0x92, 0xb3,
+	0xca, 0x87, 0x52, 0xb2, 0x58, 0x18, 0xf8, 0x96, 0x5e, 0xfd, 0x1a, 0x00, 0x07, 0x29, 0xde, 0xa0,
+	0x52, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
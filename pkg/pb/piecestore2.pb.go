@@ -8,6 +8,7 @@ import (
 	fmt "fmt"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	timestamp "github.com/golang/protobuf/ptypes/timestamp"
 	grpc "google.golang.org/grpc"
 	math "math"
 )
@@ -23,6 +24,31 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
 
+type PieceHeader_FormatVersion int32
+
+const (
+	PieceHeader_FORMAT_V0 PieceHeader_FormatVersion = 0
+	PieceHeader_FORMAT_V1 PieceHeader_FormatVersion = 1
+)
+
+var PieceHeader_FormatVersion_name = map[int32]string{
+	0: "FORMAT_V0",
+	1: "FORMAT_V1",
+}
+
+var PieceHeader_FormatVersion_value = map[string]int32{
+	"FORMAT_V0": 0,
+	"FORMAT_V1": 1,
+}
+
+func (x PieceHeader_FormatVersion) String() string {
+	return proto.EnumName(PieceHeader_FormatVersion_name, int32(x))
+}
+
+func (PieceHeader_FormatVersion) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_23ff32dd550c2439, []int{12, 0}
+}
+
 // Expected order of messages from uplink:
 //   OrderLimit ->
 //   repeated
@@ -444,7 +470,356 @@ func (m *PieceDeleteResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_PieceDeleteResponse proto.InternalMessageInfo
 
+// RetainRequest is sent by the satellite with the pieces the storage node should keep.
+type RetainRequest struct {
+	// pieces created after this time are kept
+	CreationDate *timestamp.Timestamp `protobuf:"bytes,1,opt,name=creation_date,json=creationDate,proto3" json:"creation_date,omitempty"`
+	// bloom filter of the piece ids to keep
+	Filter               []byte   `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RetainRequest) Reset()         { *m = RetainRequest{} }
+func (m *RetainRequest) String() string { return proto.CompactTextString(m) }
+func (*RetainRequest) ProtoMessage()    {}
+func (*RetainRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_23ff32dd550c2439, []int{6}
+}
+func (m *RetainRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RetainRequest.Unmarshal(m, b)
+}
+func (m *RetainRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RetainRequest.Marshal(b, m, deterministic)
+}
+func (m *RetainRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RetainRequest.Merge(m, src)
+}
+func (m *RetainRequest) XXX_Size() int {
+	return xxx_messageInfo_RetainRequest.Size(m)
+}
+func (m *RetainRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RetainRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RetainRequest proto.InternalMessageInfo
+
+func (m *RetainRequest) GetCreationDate() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreationDate
+	}
+	return nil
+}
+
+func (m *RetainRequest) GetFilter() []byte {
+	if m != nil {
+		return m.Filter
+	}
+	return nil
+}
+
+type RetainResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RetainResponse) Reset()         { *m = RetainResponse{} }
+func (m *RetainResponse) String() string { return proto.CompactTextString(m) }
+func (*RetainResponse) ProtoMessage()    {}
+func (*RetainResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_23ff32dd550c2439, []int{7}
+}
+func (m *RetainResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RetainResponse.Unmarshal(m, b)
+}
+func (m *RetainResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RetainResponse.Marshal(b, m, deterministic)
+}
+func (m *RetainResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RetainResponse.Merge(m, src)
+}
+func (m *RetainResponse) XXX_Size() int {
+	return xxx_messageInfo_RetainResponse.Size(m)
+}
+func (m *RetainResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RetainResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RetainResponse proto.InternalMessageInfo
+
+// RestoreTrashRequest is sent by the satellite to restore its trashed pieces,
+// it is signed by the satellite like DeletePiecesRequest.
+type RestoreTrashRequest struct {
+	// satellite which owns the trashed pieces and signed the request
+	SatelliteId NodeID `protobuf:"bytes,1,opt,name=satellite_id,json=satelliteId,proto3,customtype=NodeID" json:"satellite_id"`
+	// storage node which is asked to restore the pieces
+	StorageNodeId NodeID `protobuf:"bytes,2,opt,name=storage_node_id,json=storageNodeId,proto3,customtype=NodeID" json:"storage_node_id"`
+	// the request is rejected after this time
+	Expiration           *timestamp.Timestamp `protobuf:"bytes,3,opt,name=expiration,proto3" json:"expiration,omitempty"`
+	SatelliteSignature   []byte               `protobuf:"bytes,4,opt,name=satellite_signature,json=satelliteSignature,proto3" json:"satellite_signature,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *RestoreTrashRequest) Reset()         { *m = RestoreTrashRequest{} }
+func (m *RestoreTrashRequest) String() string { return proto.CompactTextString(m) }
+func (*RestoreTrashRequest) ProtoMessage()    {}
+func (*RestoreTrashRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_23ff32dd550c2439, []int{8}
+}
+func (m *RestoreTrashRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RestoreTrashRequest.Unmarshal(m, b)
+}
+func (m *RestoreTrashRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RestoreTrashRequest.Marshal(b, m, deterministic)
+}
+func (m *RestoreTrashRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestoreTrashRequest.Merge(m, src)
+}
+func (m *RestoreTrashRequest) XXX_Size() int {
+	return xxx_messageInfo_RestoreTrashRequest.Size(m)
+}
+func (m *RestoreTrashRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestoreTrashRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RestoreTrashRequest proto.InternalMessageInfo
+
+func (m *RestoreTrashRequest) GetExpiration() *timestamp.Timestamp {
+	if m != nil {
+		return m.Expiration
+	}
+	return nil
+}
+
+func (m *RestoreTrashRequest) GetSatelliteSignature() []byte {
+	if m != nil {
+		return m.SatelliteSignature
+	}
+	return nil
+}
+
+type RestoreTrashResponse struct {
+	// number of pieces moved back from the trash
+	Restored             int64    `protobuf:"varint,1,opt,name=restored,proto3" json:"restored,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RestoreTrashResponse) Reset()         { *m = RestoreTrashResponse{} }
+func (m *RestoreTrashResponse) String() string { return proto.CompactTextString(m) }
+func (*RestoreTrashResponse) ProtoMessage()    {}
+func (*RestoreTrashResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_23ff32dd550c2439, []int{9}
+}
+func (m *RestoreTrashResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RestoreTrashResponse.Unmarshal(m, b)
+}
+func (m *RestoreTrashResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RestoreTrashResponse.Marshal(b, m, deterministic)
+}
+func (m *RestoreTrashResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestoreTrashResponse.Merge(m, src)
+}
+func (m *RestoreTrashResponse) XXX_Size() int {
+	return xxx_messageInfo_RestoreTrashResponse.Size(m)
+}
+func (m *RestoreTrashResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestoreTrashResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RestoreTrashResponse proto.InternalMessageInfo
+
+func (m *RestoreTrashResponse) GetRestored() int64 {
+	if m != nil {
+		return m.Restored
+	}
+	return 0
+}
+
+// DeletePiecesRequest authorizes deleting many pieces of a satellite at once,
+// it is signed by the satellite instead of using an order limit per piece.
+type DeletePiecesRequest struct {
+	// satellite which owns the pieces and signed the request
+	SatelliteId NodeID `protobuf:"bytes,1,opt,name=satellite_id,json=satelliteId,proto3,customtype=NodeID" json:"satellite_id"`
+	// storage node which is allowed to delete the pieces
+	StorageNodeId NodeID `protobuf:"bytes,2,opt,name=storage_node_id,json=storageNodeId,proto3,customtype=NodeID" json:"storage_node_id"`
+	// pieces to delete
+	PieceIds []PieceID `protobuf:"bytes,3,rep,name=piece_ids,json=pieceIds,proto3,customtype=PieceID" json:"piece_ids"`
+	// the request is rejected after this time
+	Expiration           *timestamp.Timestamp `protobuf:"bytes,4,opt,name=expiration,proto3" json:"expiration,omitempty"`
+	SatelliteSignature   []byte               `protobuf:"bytes,5,opt,name=satellite_signature,json=satelliteSignature,proto3" json:"satellite_signature,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *DeletePiecesRequest) Reset()         { *m = DeletePiecesRequest{} }
+func (m *DeletePiecesRequest) String() string { return proto.CompactTextString(m) }
+func (*DeletePiecesRequest) ProtoMessage()    {}
+func (*DeletePiecesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_23ff32dd550c2439, []int{10}
+}
+func (m *DeletePiecesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeletePiecesRequest.Unmarshal(m, b)
+}
+func (m *DeletePiecesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeletePiecesRequest.Marshal(b, m, deterministic)
+}
+func (m *DeletePiecesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeletePiecesRequest.Merge(m, src)
+}
+func (m *DeletePiecesRequest) XXX_Size() int {
+	return xxx_messageInfo_DeletePiecesRequest.Size(m)
+}
+func (m *DeletePiecesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeletePiecesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeletePiecesRequest proto.InternalMessageInfo
+
+func (m *DeletePiecesRequest) GetExpiration() *timestamp.Timestamp {
+	if m != nil {
+		return m.Expiration
+	}
+	return nil
+}
+
+func (m *DeletePiecesRequest) GetSatelliteSignature() []byte {
+	if m != nil {
+		return m.SatelliteSignature
+	}
+	return nil
+}
+
+type DeletePiecesResponse struct {
+	// number of pieces queued for deletion, the rest didn't fit into the queue
+	Queued               int64    `protobuf:"varint,1,opt,name=queued,proto3" json:"queued,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeletePiecesResponse) Reset()         { *m = DeletePiecesResponse{} }
+func (m *DeletePiecesResponse) String() string { return proto.CompactTextString(m) }
+func (*DeletePiecesResponse) ProtoMessage()    {}
+func (*DeletePiecesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_23ff32dd550c2439, []int{11}
+}
+func (m *DeletePiecesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeletePiecesResponse.Unmarshal(m, b)
+}
+func (m *DeletePiecesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeletePiecesResponse.Marshal(b, m, deterministic)
+}
+func (m *DeletePiecesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeletePiecesResponse.Merge(m, src)
+}
+func (m *DeletePiecesResponse) XXX_Size() int {
+	return xxx_messageInfo_DeletePiecesResponse.Size(m)
+}
+func (m *DeletePiecesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeletePiecesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeletePiecesResponse proto.InternalMessageInfo
+
+func (m *DeletePiecesResponse) GetQueued() int64 {
+	if m != nil {
+		return m.Queued
+	}
+	return 0
+}
+
+// PieceHeader is stored at the beginning of a piece file, it contains
+// everything needed to verify the piece without the piece info database.
+type PieceHeader struct {
+	FormatVersion PieceHeader_FormatVersion `protobuf:"varint,1,opt,name=format_version,json=formatVersion,proto3,enum=piecestore.PieceHeader_FormatVersion" json:"format_version,omitempty"`
+	// order limit the piece was uploaded with, signed by the satellite
+	OrderLimit *OrderLimit2 `protobuf:"bytes,2,opt,name=order_limit,json=orderLimit,proto3" json:"order_limit,omitempty"`
+	// hash of the piece, signed by the uplink
+	UplinkPieceHash *PieceHash `protobuf:"bytes,3,opt,name=uplink_piece_hash,json=uplinkPieceHash,proto3" json:"uplink_piece_hash,omitempty"`
+	// certificate chain of the uplink, in DER encoding
+	UplinkCertificateChain [][]byte             `protobuf:"bytes,4,rep,name=uplink_certificate_chain,json=uplinkCertificateChain,proto3" json:"uplink_certificate_chain,omitempty"`
+	CreationTime           *timestamp.Timestamp `protobuf:"bytes,5,opt,name=creation_time,json=creationTime,proto3" json:"creation_time,omitempty"`
+	PieceExpiration        *timestamp.Timestamp `protobuf:"bytes,6,opt,name=piece_expiration,json=pieceExpiration,proto3" json:"piece_expiration,omitempty"`
+	XXX_NoUnkeyedLiteral   struct{}             `json:"-"`
+	XXX_unrecognized       []byte               `json:"-"`
+	XXX_sizecache          int32                `json:"-"`
+}
+
+func (m *PieceHeader) Reset()         { *m = PieceHeader{} }
+func (m *PieceHeader) String() string { return proto.CompactTextString(m) }
+func (*PieceHeader) ProtoMessage()    {}
+func (*PieceHeader) Descriptor() ([]byte, []int) {
+	return fileDescriptor_23ff32dd550c2439, []int{12}
+}
+func (m *PieceHeader) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PieceHeader.Unmarshal(m, b)
+}
+func (m *PieceHeader) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PieceHeader.Marshal(b, m, deterministic)
+}
+func (m *PieceHeader) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PieceHeader.Merge(m, src)
+}
+func (m *PieceHeader) XXX_Size() int {
+	return xxx_messageInfo_PieceHeader.Size(m)
+}
+func (m *PieceHeader) XXX_DiscardUnknown() {
+	xxx_messageInfo_PieceHeader.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PieceHeader proto.InternalMessageInfo
+
+func (m *PieceHeader) GetFormatVersion() PieceHeader_FormatVersion {
+	if m != nil {
+		return m.FormatVersion
+	}
+	return PieceHeader_FORMAT_V0
+}
+
+func (m *PieceHeader) GetOrderLimit() *OrderLimit2 {
+	if m != nil {
+		return m.OrderLimit
+	}
+	return nil
+}
+
+func (m *PieceHeader) GetUplinkPieceHash() *PieceHash {
+	if m != nil {
+		return m.UplinkPieceHash
+	}
+	return nil
+}
+
+func (m *PieceHeader) GetUplinkCertificateChain() [][]byte {
+	if m != nil {
+		return m.UplinkCertificateChain
+	}
+	return nil
+}
+
+func (m *PieceHeader) GetCreationTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreationTime
+	}
+	return nil
+}
+
+func (m *PieceHeader) GetPieceExpiration() *timestamp.Timestamp {
+	if m != nil {
+		return m.PieceExpiration
+	}
+	return nil
+}
+
 func init() {
+	proto.RegisterEnum("piecestore.PieceHeader_FormatVersion", PieceHeader_FormatVersion_name, PieceHeader_FormatVersion_value)
 	proto.RegisterType((*PieceUploadRequest)(nil), "piecestore.PieceUploadRequest")
 	proto.RegisterType((*PieceUploadRequest_Chunk)(nil), "piecestore.PieceUploadRequest.Chunk")
 	proto.RegisterType((*PieceUploadResponse)(nil), "piecestore.PieceUploadResponse")
@@ -454,38 +829,75 @@ func init() {
 	proto.RegisterType((*PieceDownloadResponse_Chunk)(nil), "piecestore.PieceDownloadResponse.Chunk")
 	proto.RegisterType((*PieceDeleteRequest)(nil), "piecestore.PieceDeleteRequest")
 	proto.RegisterType((*PieceDeleteResponse)(nil), "piecestore.PieceDeleteResponse")
+	proto.RegisterType((*RetainRequest)(nil), "piecestore.RetainRequest")
+	proto.RegisterType((*RetainResponse)(nil), "piecestore.RetainResponse")
+	proto.RegisterType((*RestoreTrashRequest)(nil), "piecestore.RestoreTrashRequest")
+	proto.RegisterType((*RestoreTrashResponse)(nil), "piecestore.RestoreTrashResponse")
+	proto.RegisterType((*DeletePiecesRequest)(nil), "piecestore.DeletePiecesRequest")
+	proto.RegisterType((*DeletePiecesResponse)(nil), "piecestore.DeletePiecesResponse")
+	proto.RegisterType((*PieceHeader)(nil), "piecestore.PieceHeader")
 }
 
 func init() { proto.RegisterFile("piecestore2.proto", fileDescriptor_23ff32dd550c2439) }
 
 var fileDescriptor_23ff32dd550c2439 = []byte{
-	// 404 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x94, 0x51, 0x4f, 0xea, 0x30,
-	0x14, 0xc7, 0x29, 0x6c, 0xcb, 0xbd, 0xe7, 0x92, 0x9b, 0x50, 0xc4, 0x2c, 0x4b, 0x54, 0x5c, 0x50,
-	0xf1, 0x65, 0x31, 0xe3, 0xcd, 0xa0, 0x26, 0xca, 0x83, 0x89, 0x1a, 0x49, 0x0d, 0x2f, 0xbe, 0x98,
-	0xc1, 0x0a, 0x2c, 0xe2, 0x3a, 0xd7, 0x11, 0x13, 0xbe, 0x82, 0x9f, 0xd3, 0x8f, 0x61, 0x34, 0x6b,
-	0x37, 0xc8, 0x00, 0x21, 0x9a, 0xf8, 0x04, 0x3d, 0xe7, 0x7f, 0xfa, 0xff, 0xf5, 0xdf, 0x66, 0x50,
-	0x0a, 0x3c, 0xda, 0xa3, 0x3c, 0x62, 0x21, 0xb5, 0xad, 0x20, 0x64, 0x11, 0xc3, 0x30, 0x2b, 0x19,
-	0x30, 0x60, 0x03, 0x26, 0xeb, 0x46, 0x91, 0x85, 0x2e, 0x0d, 0xb9, 0x5c, 0x99, 0xef, 0x08, 0x70,
-	0x3b, 0x16, 0x76, 0x82, 0x11, 0x73, 0x5c, 0x42, 0x9f, 0xc7, 0x94, 0x47, 0xf8, 0x10, 0xd4, 0x91,
-	0xf7, 0xe4, 0x45, 0x3a, 0xaa, 0xa2, 0xfa, 0x3f, 0xbb, 0x6c, 0x25, 0x43, 0xb7, 0xf1, 0xcf, 0x75,
-	0xdc, 0xb1, 0x89, 0x54, 0xe0, 0x1a, 0xa8, 0xa2, 0xa9, 0xe7, 0x85, 0xf4, 0x7f, 0x46, 0x6a, 0x13,
-	0xd9, 0xc4, 0xc7, 0xa0, 0xf6, 0x86, 0x63, 0xff, 0x51, 0x2f, 0x08, 0x55, 0xcd, 0x9a, 0xd1, 0x59,
-	0x8b, 0xfe, 0xd6, 0x45, 0xac, 0x25, 0x72, 0x04, 0xef, 0x81, 0xe2, 0x32, 0x9f, 0xea, 0x8a, 0x18,
-	0x2d, 0xa5, 0x06, 0x62, 0xec, 0xd2, 0xe1, 0x43, 0x22, 0xda, 0x46, 0x03, 0x54, 0x31, 0x86, 0x37,
-	0x41, 0x63, 0xfd, 0x3e, 0xa7, 0x92, 0xbe, 0x40, 0x92, 0x15, 0xc6, 0xa0, 0xb8, 0x4e, 0xe4, 0x08,
-	0xd0, 0x22, 0x11, 0xff, 0xcd, 0x26, 0x94, 0x33, 0xf6, 0x3c, 0x60, 0x3e, 0xa7, 0x53, 0x4b, 0xb4,
-	0xd2, 0xd2, 0x7c, 0x43, 0xb0, 0x21, 0x6a, 0x2d, 0xf6, 0xe2, 0xff, 0x6a, 0x7e, 0xcd, 0x6c, 0x7e,
-	0xfb, 0x0b, 0xf9, 0xcd, 0x11, 0x64, 0x12, 0x34, 0x4e, 0xd7, 0x45, 0xb3, 0x05, 0x20, 0x94, 0x0f,
-	0xdc, 0x9b, 0x50, 0x41, 0x52, 0x20, 0x7f, 0x45, 0xe5, 0xce, 0x9b, 0x50, 0xf3, 0x15, 0x41, 0x65,
-	0xce, 0x25, 0x09, 0xea, 0x24, 0xe5, 0x92, 0x07, 0x3d, 0x58, 0xc1, 0x25, 0x27, 0xb2, 0x60, 0x3f,
-	0xba, 0xb3, 0xb3, 0xe4, 0xc9, 0xb6, 0xe8, 0x88, 0x46, 0xf4, 0xfb, 0x91, 0x9b, 0x95, 0xe4, 0xd2,
-	0xd3, 0x0d, 0x24, 0x99, 0xfd, 0x81, 0x00, 0xda, 0x53, 0x7c, 0x7c, 0x03, 0x9a, 0x7c, 0x15, 0x78,
-	0x7b, 0xf5, 0x6b, 0x35, 0x76, 0xbe, 0xec, 0xcb, 0x9d, 0xcd, 0x5c, 0x1d, 0xe1, 0x0e, 0xfc, 0x49,
-	0xb3, 0xc0, 0xd5, 0x75, 0xd7, 0x67, 0xec, 0xae, 0x0d, 0x32, 0xde, 0xf4, 0x08, 0xe1, 0x2b, 0xd0,
-	0xe4, 0x31, 0x96, 0x50, 0x66, 0x02, 0x5a, 0x42, 0x99, 0x3d, 0xbf, 0x99, 0x3b, 0x57, 0xee, 0xf3,
-	0x41, 0xb7, 0xab, 0x89, 0x4f, 0x43, 0xe3, 0x33, 0x00, 0x00, 0xff, 0xff, 0xf5, 0xfa, 0xf7, 0xbe,
-	0x55, 0x04, 0x00, 0x00,
+	// 893 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x55, 0x51, 0x6f, 0x1b, 0x45,
+	0x10, 0xce, 0xf9, 0x6c, 0x93, 0x4c, 0xce, 0x4e, 0xb2, 0x4e, 0xa3, 0xe3, 0x24, 0xb0, 0x39, 0xb5,
+	0x10, 0x24, 0xb8, 0xb4, 0x2e, 0x42, 0xa8, 0x6a, 0xa9, 0x9a, 0xb8, 0x15, 0x11, 0x2d, 0xad, 0x36,
+	0x69, 0x1f, 0x78, 0x39, 0x6d, 0x7c, 0x6b, 0x7b, 0xd5, 0xcb, 0xed, 0xf5, 0x76, 0x0d, 0xa8, 0x7f,
+	0x81, 0x9f, 0xc0, 0xaf, 0xe1, 0x8d, 0xdf, 0xc0, 0x43, 0xde, 0x78, 0x83, 0x9f, 0x80, 0x84, 0x6e,
+	0x77, 0xef, 0x72, 0x97, 0xc4, 0xb1, 0x40, 0x20, 0x9e, 0xec, 0xd9, 0xf9, 0x66, 0xe6, 0xbb, 0x6f,
+	0x67, 0x66, 0x61, 0x2b, 0x65, 0x74, 0x4c, 0x85, 0xe4, 0x19, 0x1d, 0x06, 0x69, 0xc6, 0x25, 0x47,
+	0x70, 0x7e, 0xe4, 0xc1, 0x94, 0x4f, 0xb9, 0x3e, 0xf7, 0x1c, 0x9e, 0x45, 0x34, 0x13, 0xc6, 0xea,
+	0x4f, 0x39, 0x9f, 0xc6, 0x74, 0x4f, 0x59, 0x27, 0xf3, 0xc9, 0x9e, 0x64, 0xa7, 0x54, 0x48, 0x72,
+	0x9a, 0x6a, 0x80, 0xff, 0xa7, 0x05, 0xe8, 0x45, 0x9e, 0xe9, 0x65, 0x1a, 0x73, 0x12, 0x61, 0xfa,
+	0x66, 0x4e, 0x85, 0x44, 0x1f, 0x43, 0x2b, 0x66, 0xa7, 0x4c, 0xba, 0xd6, 0xc0, 0xda, 0x5d, 0x1f,
+	0xf6, 0x02, 0x93, 0xf5, 0x79, 0xfe, 0xf3, 0x34, 0xf7, 0x0c, 0xb1, 0x46, 0xa0, 0x9b, 0xd0, 0x52,
+	0x4e, 0xb7, 0xa1, 0xa0, 0xdd, 0x1a, 0x74, 0x88, 0xb5, 0x13, 0xdd, 0x83, 0xd6, 0x78, 0x36, 0x4f,
+	0x5e, 0xbb, 0xb6, 0x42, 0xdd, 0x0c, 0xce, 0xe9, 0x07, 0x97, 0xeb, 0x07, 0x07, 0x39, 0x16, 0xeb,
+	0x10, 0x74, 0x0b, 0x9a, 0x11, 0x4f, 0xa8, 0xdb, 0x54, 0xa1, 0x5b, 0x45, 0x01, 0x15, 0xf6, 0x15,
+	0x11, 0x33, 0xac, 0xdc, 0xde, 0x5d, 0x68, 0xa9, 0x30, 0xb4, 0x03, 0x6d, 0x3e, 0x99, 0x08, 0xaa,
+	0xd9, 0xdb, 0xd8, 0x58, 0x08, 0x41, 0x33, 0x22, 0x92, 0x28, 0xa2, 0x0e, 0x56, 0xff, 0xfd, 0xfb,
+	0xd0, 0xab, 0x95, 0x17, 0x29, 0x4f, 0x04, 0x2d, 0x4b, 0x5a, 0xd7, 0x96, 0xf4, 0x7f, 0xb3, 0x60,
+	0x5b, 0x9d, 0x8d, 0xf8, 0xf7, 0xc9, 0x7f, 0xaa, 0xdf, 0xfd, 0xba, 0x7e, 0x1f, 0x5e, 0xd2, 0xef,
+	0x02, 0x83, 0x9a, 0x82, 0xde, 0x97, 0xcb, 0xa4, 0x79, 0x0f, 0x40, 0x21, 0x43, 0xc1, 0xde, 0x52,
+	0xc5, 0xc4, 0xc6, 0x6b, 0xea, 0xe4, 0x88, 0xbd, 0xa5, 0xfe, 0x8f, 0x16, 0xdc, 0xb8, 0x50, 0xc5,
+	0x08, 0xf5, 0xa0, 0xe0, 0xa5, 0x3f, 0xf4, 0xa3, 0x6b, 0x78, 0xe9, 0x88, 0x3a, 0xb1, 0x7f, 0x74,
+	0x67, 0x0f, 0x4d, 0xcb, 0x8e, 0x68, 0x4c, 0x25, 0xfd, 0xfb, 0x92, 0xfb, 0x37, 0xa0, 0x57, 0x4b,
+	0xa0, 0x99, 0xf9, 0x33, 0xe8, 0x60, 0x2a, 0x09, 0x4b, 0x8a, 0x94, 0x0f, 0xa1, 0x33, 0xce, 0x28,
+	0x91, 0x8c, 0x27, 0x61, 0x44, 0x64, 0xd1, 0x0e, 0x5e, 0xa0, 0xa7, 0x2a, 0x28, 0xa6, 0x2a, 0x38,
+	0x2e, 0xa6, 0x0a, 0x3b, 0x45, 0xc0, 0x88, 0x48, 0x9a, 0x7f, 0xd5, 0x84, 0xc5, 0xd2, 0x5c, 0xae,
+	0x83, 0x8d, 0xe5, 0x6f, 0x42, 0xb7, 0xa8, 0x64, 0x6a, 0xff, 0x61, 0x41, 0x0f, 0x6b, 0xdd, 0x8e,
+	0xb3, 0xbc, 0xc1, 0x0c, 0x85, 0x3b, 0xe0, 0x08, 0x22, 0x69, 0x1c, 0x33, 0x49, 0x43, 0x16, 0x29,
+	0x06, 0xce, 0x7e, 0xf7, 0x97, 0xb3, 0xfe, 0xca, 0xaf, 0x67, 0xfd, 0xf6, 0x37, 0x3c, 0xa2, 0x87,
+	0x23, 0xbc, 0x5e, 0x62, 0x0e, 0x23, 0xf4, 0x39, 0x6c, 0xe4, 0x79, 0xc8, 0x94, 0x86, 0x09, 0x8f,
+	0x54, 0x54, 0xe3, 0xca, 0xa8, 0x8e, 0x81, 0x29, 0x33, 0x42, 0xf7, 0x00, 0xe8, 0x0f, 0x29, 0xcb,
+	0x14, 0x7d, 0xd7, 0x5e, 0xfa, 0xa9, 0x15, 0x34, 0xda, 0x83, 0xde, 0x39, 0x4d, 0xc1, 0xa6, 0x09,
+	0x91, 0xf3, 0x4c, 0x4f, 0xac, 0x83, 0x51, 0xe9, 0x3a, 0x2a, 0x3c, 0xfe, 0x10, 0xb6, 0xeb, 0x9f,
+	0x6b, 0xfa, 0xc9, 0x83, 0xd5, 0x4c, 0x9f, 0x47, 0xa6, 0x13, 0x4a, 0xdb, 0xff, 0xa9, 0x01, 0x3d,
+	0x7d, 0x65, 0xea, 0xf6, 0xc4, 0xff, 0xa0, 0xd1, 0x27, 0xb0, 0xa6, 0x1a, 0x3c, 0x64, 0x91, 0x70,
+	0xed, 0x81, 0xbd, 0xeb, 0xec, 0x6f, 0x98, 0x88, 0x77, 0x14, 0xa9, 0xc3, 0x11, 0x5e, 0x55, 0x88,
+	0xc3, 0x48, 0x5c, 0x50, 0xb4, 0xf9, 0x6f, 0x28, 0xda, 0x5a, 0xa8, 0x68, 0x00, 0xdb, 0x75, 0x71,
+	0x8c, 0xa2, 0x3b, 0xd0, 0x7e, 0x33, 0xa7, 0xf3, 0x52, 0x4f, 0x63, 0xf9, 0x3f, 0xdb, 0xb0, 0xae,
+	0xf7, 0x19, 0x25, 0xf9, 0x86, 0x79, 0x0a, 0xdd, 0x09, 0xcf, 0x4e, 0x89, 0x0c, 0xbf, 0xa3, 0x99,
+	0xc8, 0x09, 0xe7, 0xf8, 0xee, 0xf0, 0xd6, 0xa5, 0x91, 0xd6, 0x01, 0xc1, 0x13, 0x85, 0x7e, 0xa5,
+	0xc1, 0xb8, 0x33, 0xa9, 0x9a, 0xe8, 0x33, 0x58, 0x57, 0xf3, 0x17, 0xea, 0x99, 0x6c, 0x2c, 0x9e,
+	0x49, 0xe0, 0xa5, 0x81, 0x1e, 0xc0, 0xd6, 0x3c, 0x8d, 0x59, 0xf2, 0x3a, 0xd4, 0x2a, 0xcf, 0x88,
+	0x98, 0xb9, 0xf6, 0xa2, 0x1d, 0xbc, 0xa1, 0xb1, 0xe5, 0x01, 0xfa, 0x02, 0x5c, 0x13, 0x3e, 0xa6,
+	0x99, 0x64, 0x13, 0x36, 0x26, 0x92, 0x86, 0xe3, 0x19, 0x61, 0xb9, 0xfa, 0xf6, 0xae, 0x83, 0x77,
+	0xb4, 0xff, 0xe0, 0xdc, 0x7d, 0x90, 0x7b, 0x6b, 0x93, 0x9e, 0x3f, 0x91, 0x6e, 0x6b, 0xe9, 0x65,
+	0x95, 0x93, 0x9e, 0x1f, 0xa1, 0xc7, 0xb0, 0xa9, 0x29, 0x57, 0x2e, 0xbc, 0xbd, 0x34, 0xc7, 0x86,
+	0x8a, 0x79, 0x5c, 0x86, 0xf8, 0x9f, 0x42, 0xa7, 0x26, 0x2b, 0xea, 0xc0, 0xda, 0x93, 0xe7, 0xf8,
+	0xd9, 0xa3, 0xe3, 0xf0, 0xd5, 0xed, 0xcd, 0x95, 0xaa, 0x79, 0x67, 0xd3, 0x1a, 0xfe, 0x6e, 0x03,
+	0xbc, 0x28, 0x6f, 0x07, 0x3d, 0x83, 0xb6, 0x7e, 0xc7, 0xd0, 0xfb, 0xd7, 0xbf, 0xaf, 0x5e, 0x7f,
+	0xa1, 0xdf, 0xec, 0xa3, 0x95, 0x5d, 0x0b, 0xbd, 0x84, 0xd5, 0x62, 0x7b, 0xa3, 0xc1, 0xb2, 0x07,
+	0xc7, 0xfb, 0x60, 0xe9, 0xea, 0xcf, 0x93, 0xde, 0xb6, 0xd0, 0xd7, 0xd0, 0xd6, 0x8d, 0x7a, 0x05,
+	0xcb, 0xda, 0x4a, 0xf7, 0xfa, 0x0b, 0xfd, 0x45, 0x42, 0xf4, 0x08, 0xda, 0x7a, 0x93, 0xa2, 0x77,
+	0xab, 0xe0, 0xda, 0x1e, 0xf7, 0xbc, 0xab, 0x5c, 0x65, 0x8a, 0x23, 0x70, 0xaa, 0xab, 0x08, 0xf5,
+	0xeb, 0xe8, 0x4b, 0x3b, 0xd9, 0x1b, 0x2c, 0x06, 0x54, 0x93, 0x56, 0xa7, 0xb1, 0x9e, 0xf4, 0x8a,
+	0x25, 0xe6, 0x0d, 0x16, 0x03, 0x8a, 0xa4, 0xfb, 0xcd, 0x6f, 0x1b, 0xe9, 0xc9, 0x49, 0x5b, 0x35,
+	0xd2, 0xdd, 0xbf, 0x06, 0x00, 0xf0, 0x2e, 0x72, 0x5e, 0x15, 0x0a, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -503,6 +915,9 @@ type PiecestoreClient interface {
 	Upload(ctx context.Context, opts ...grpc.CallOption) (Piecestore_UploadClient, error)
 	Download(ctx context.Context, opts ...grpc.CallOption) (Piecestore_DownloadClient, error)
 	Delete(ctx context.Context, in *PieceDeleteRequest, opts ...grpc.CallOption) (*PieceDeleteResponse, error)
+	Retain(ctx context.Context, in *RetainRequest, opts ...grpc.CallOption) (*RetainResponse, error)
+	RestoreTrash(ctx context.Context, in *RestoreTrashRequest, opts ...grpc.CallOption) (*RestoreTrashResponse, error)
+	DeletePieces(ctx context.Context, in *DeletePiecesRequest, opts ...grpc.CallOption) (*DeletePiecesResponse, error)
 }
 
 type piecestoreClient struct {
@@ -587,11 +1002,41 @@ func (c *piecestoreClient) Delete(ctx context.Context, in *PieceDeleteRequest, o
 	return out, nil
 }
 
+func (c *piecestoreClient) Retain(ctx context.Context, in *RetainRequest, opts ...grpc.CallOption) (*RetainResponse, error) {
+	out := new(RetainResponse)
+	err := c.cc.Invoke(ctx, "/piecestore.Piecestore/Retain", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *piecestoreClient) RestoreTrash(ctx context.Context, in *RestoreTrashRequest, opts ...grpc.CallOption) (*RestoreTrashResponse, error) {
+	out := new(RestoreTrashResponse)
+	err := c.cc.Invoke(ctx, "/piecestore.Piecestore/RestoreTrash", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *piecestoreClient) DeletePieces(ctx context.Context, in *DeletePiecesRequest, opts ...grpc.CallOption) (*DeletePiecesResponse, error) {
+	out := new(DeletePiecesResponse)
+	err := c.cc.Invoke(ctx, "/piecestore.Piecestore/DeletePieces", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PiecestoreServer is the server API for Piecestore service.
 type PiecestoreServer interface {
 	Upload(Piecestore_UploadServer) error
 	Download(Piecestore_DownloadServer) error
 	Delete(context.Context, *PieceDeleteRequest) (*PieceDeleteResponse, error)
+	Retain(context.Context, *RetainRequest) (*RetainResponse, error)
+	RestoreTrash(context.Context, *RestoreTrashRequest) (*RestoreTrashResponse, error)
+	DeletePieces(context.Context, *DeletePiecesRequest) (*DeletePiecesResponse, error)
 }
 
 func RegisterPiecestoreServer(s *grpc.Server, srv PiecestoreServer) {
@@ -668,6 +1113,60 @@ func _Piecestore_Delete_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Piecestore_Retain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RetainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PiecestoreServer).Retain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/piecestore.Piecestore/Retain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PiecestoreServer).Retain(ctx, req.(*RetainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Piecestore_RestoreTrash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreTrashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PiecestoreServer).RestoreTrash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/piecestore.Piecestore/RestoreTrash",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PiecestoreServer).RestoreTrash(ctx, req.(*RestoreTrashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Piecestore_DeletePieces_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePiecesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PiecestoreServer).DeletePieces(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/piecestore.Piecestore/DeletePieces",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PiecestoreServer).DeletePieces(ctx, req.(*DeletePiecesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Piecestore_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "piecestore.Piecestore",
 	HandlerType: (*PiecestoreServer)(nil),
@@ -676,6 +1175,18 @@ var _Piecestore_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Delete",
 			Handler:    _Piecestore_Delete_Handler,
 		},
+		{
+			MethodName: "Retain",
+			Handler:    _Piecestore_Retain_Handler,
+		},
+		{
+			MethodName: "RestoreTrash",
+			Handler:    _Piecestore_RestoreTrash_Handler,
+		},
+		{
+			MethodName: "DeletePieces",
+			Handler:    _Piecestore_DeletePieces_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -286,12 +286,21 @@ func cleanup(cmd *cobra.Command) {
 			_, _ = fmt.Fprintf(os.Stderr, "Fatal error: %v\n", err)
 			logger.Sugar().Debugf("Fatal error: %+v", err)
 			_ = logger.Sync()
-			os.Exit(1)
+			os.Exit(exitCode(err))
 		}
 		return err
 	}
 }
 
+// exitCode returns the exit code of a failed command. Errors can select
+// their code by implementing ExitCode() int, other errors exit with 1.
+func exitCode(err error) int {
+	if coder, ok := err.(interface{ ExitCode() int }); ok {
+		return coder.ExitCode()
+	}
+	return 1
+}
+
 func cmdVersion(cmd *cobra.Command, args []string) (err error) {
 	if version.Build.Release {
 		fmt.Println("Release build")
@@ -38,8 +38,9 @@ type Client interface {
 type psClientHelper func(context.Context, *pb.Node) (*piecestore.Client, error)
 
 type ecClient struct {
-	transport   transport.Client
-	memoryLimit int
+	transport      transport.Client
+	memoryLimit    int
+	holdBackSpares bool
 }
 
 // NewClient from the given identity and max buffer memory
@@ -50,6 +51,17 @@ func NewClient(tc transport.Client, memoryLimit int) Client {
 	}
 }
 
+// NewClientWithSpares returns a client which holds back some of the limits
+// above the optimal threshold when uploading, and uploads to them only when a
+// storage node rejects its piece because it is overloaded.
+func NewClientWithSpares(tc transport.Client, memoryLimit int) Client {
+	return &ecClient{
+		transport:      tc,
+		memoryLimit:    memoryLimit,
+		holdBackSpares: true,
+	}
+}
+
 func (ec *ecClient) newPSClient(ctx context.Context, n *pb.Node) (*piecestore.Client, error) {
 	conn, err := ec.transport.DialNode(ctx, n)
 	if err != nil {
@@ -96,11 +108,23 @@ func (ec *ecClient) Put(ctx context.Context, limits []*pb.AddressedOrderLimit, r
 
 	start := time.Now()
 
-	for i, addressedLimit := range limits {
-		go func(i int, addressedLimit *pb.AddressedOrderLimit) {
-			hash, err := ec.putPiece(psCtx, ctx, addressedLimit, readers[i], expiration)
+	put := func(i int) {
+		go func() {
+			hash, err := ec.putPiece(psCtx, ctx, limits[i], readers[i], expiration)
 			infos <- info{i: i, err: err, hash: hash}
-		}(i, addressedLimit)
+		}()
+	}
+
+	// without spares the long tail covers the overloaded nodes
+	spares := &spareSet{}
+	if ec.holdBackSpares {
+		spares = spareLimits(limits, rs.OptimalThreshold())
+	}
+	defer closeSpares(readers, spares)
+	for i := range limits {
+		if !spares.has(i) {
+			put(i)
+		}
 	}
 
 	successfulNodes = make([]*pb.Node, len(limits))
@@ -108,7 +132,7 @@ func (ec *ecClient) Put(ctx context.Context, limits []*pb.AddressedOrderLimit, r
 	var successfulCount int32
 	var timer *time.Timer
 
-	for range limits {
+	for pending := len(limits) - len(spares.indexes); pending > 0; pending-- {
 		info := <-infos
 
 		if limits[info.i] == nil {
@@ -116,6 +140,16 @@ func (ec *ecClient) Put(ctx context.Context, limits []*pb.AddressedOrderLimit, r
 		}
 
 		if info.err != nil {
+			if piecestore.ErrOverloaded.Has(info.err) {
+				mon.Meter("put_node_overloaded").Mark(1)
+				zap.S().Debugf("Upload to storage node %s rejected, node is overloaded: %v", limits[info.i].GetLimit().StorageNodeId, info.err)
+				if spare, ok := spares.next(psCtx); ok {
+					zap.S().Debugf("Handing piece over to spare storage node %s", limits[spare].GetLimit().StorageNodeId)
+					put(spare)
+					pending++
+				}
+				continue
+			}
 			zap.S().Debugf("Upload to storage node %s failed: %v", limits[info.i].GetLimit().StorageNodeId, info.err)
 			continue
 		}
@@ -199,20 +233,31 @@ func (ec *ecClient) Repair(ctx context.Context, limits []*pb.AddressedOrderLimit
 	psCtx, cancel := context.WithCancel(ctx)
 	defer cancel()
 
-	for i, addressedLimit := range limits {
-		go func(i int, addressedLimit *pb.AddressedOrderLimit) {
-			hash, err := ec.putPiece(psCtx, ctx, addressedLimit, readers[i], expiration)
+	put := func(i int) {
+		go func() {
+			hash, err := ec.putPiece(psCtx, ctx, limits[i], readers[i], expiration)
 			infos <- info{i: i, err: err, hash: hash}
-		}(i, addressedLimit)
+		}()
+	}
+
+	// how many nodes must be repaired to reach the success threshold: o - (n - r)
+	optimalCount := rs.OptimalThreshold() - (rs.TotalCount() - nonNilCount(limits))
+
+	spares := &spareSet{}
+	if ec.holdBackSpares {
+		spares = spareLimits(limits, optimalCount)
+	}
+	defer closeSpares(readers, spares)
+	for i := range limits {
+		if !spares.has(i) {
+			put(i)
+		}
 	}
 
 	successfulNodes = make([]*pb.Node, len(limits))
 	successfulHashes = make([]*pb.PieceHash, len(limits))
 	var successfulCount int32
 
-	// how many nodes must be repaired to reach the success threshold: o - (n - r)
-	optimalCount := rs.OptimalThreshold() - (rs.TotalCount() - nonNilCount(limits))
-
 	zap.S().Infof("Starting a timer for %s for repairing %s to %d nodes to reach the success threshold (%d nodes)...",
 		timeout, path, optimalCount, rs.OptimalThreshold())
 
@@ -223,7 +268,7 @@ func (ec *ecClient) Repair(ctx context.Context, limits []*pb.AddressedOrderLimit
 		}
 	})
 
-	for range limits {
+	for pending := len(limits) - len(spares.indexes); pending > 0; pending-- {
 		info := <-infos
 
 		if limits[info.i] == nil {
@@ -231,6 +276,16 @@ func (ec *ecClient) Repair(ctx context.Context, limits []*pb.AddressedOrderLimit
 		}
 
 		if info.err != nil {
+			if piecestore.ErrOverloaded.Has(info.err) {
+				mon.Meter("repair_node_overloaded").Mark(1)
+				zap.S().Debugf("Repair %s to storage node %s rejected, node is overloaded: %v", path, limits[info.i].GetLimit().StorageNodeId, info.err)
+				if spare, ok := spares.next(psCtx); ok {
+					zap.S().Debugf("Handing repair %s over to spare storage node %s", path, limits[spare].GetLimit().StorageNodeId)
+					put(spare)
+					pending++
+				}
+				continue
+			}
 			zap.S().Debugf("Repair %s to storage node %s failed: %v", path, limits[info.i].GetLimit().StorageNodeId, info.err)
 			continue
 		}
@@ -467,12 +522,24 @@ func (lr *lazyPieceRanger) Range(ctx context.Context, offset, length int64) (io.
 	if err != nil {
 		return nil, errs.Combine(err, ps.Close())
 	}
-	return &clientCloser{download, ps}, nil
+	return &clientCloser{download, ps, lr.limit.GetLimit().StorageNodeId}, nil
 }
 
 type clientCloser struct {
 	piecestore.Downloader
 	client *piecestore.Client
+	nodeID storj.NodeID
+}
+
+// Read implements io.Reader and reports downloads rejected by overloaded
+// storage nodes. The decoder continues with the pieces of the other nodes.
+func (client *clientCloser) Read(data []byte) (int, error) {
+	n, err := client.Downloader.Read(data)
+	if err != nil && piecestore.ErrOverloaded.Has(err) {
+		mon.Meter("get_node_overloaded").Mark(1)
+		zap.S().Debugf("Download from storage node %s rejected, node is overloaded: %v", client.nodeID, err)
+	}
+	return n, err
 }
 
 func (client *clientCloser) Close() error {
@@ -482,6 +549,51 @@ func (client *clientCloser) Close() error {
 	)
 }
 
+// spareSet holds the limits that are not uploaded to right away. An upload
+// rejected by an overloaded storage node is handed over to the next spare.
+type spareSet struct {
+	indexes []int
+}
+
+// spareLimits holds back half of the non-nil limits that are not needed to
+// reach the optimal count, so the other half still cuts the long tail.
+func spareLimits(limits []*pb.AddressedOrderLimit, optimalCount int) *spareSet {
+	count := (nonNilCount(limits) - optimalCount) / 2
+	spares := &spareSet{}
+	for i := len(limits) - 1; i >= 0 && len(spares.indexes) < count; i-- {
+		if limits[i] != nil {
+			spares.indexes = append([]int{i}, spares.indexes...)
+		}
+	}
+	return spares
+}
+
+func (spares *spareSet) has(i int) bool {
+	for _, index := range spares.indexes {
+		if index == i {
+			return true
+		}
+	}
+	return false
+}
+
+// next takes the next spare unless the uploads were already canceled.
+func (spares *spareSet) next(ctx context.Context) (int, bool) {
+	if ctx.Err() != nil || len(spares.indexes) == 0 {
+		return 0, false
+	}
+	next := spares.indexes[0]
+	spares.indexes = spares.indexes[1:]
+	return next, true
+}
+
+// closeSpares closes the readers of the spares that were never uploaded.
+func closeSpares(readers []io.ReadCloser, spares *spareSet) {
+	for _, i := range spares.indexes {
+		_ = readers[i].Close()
+	}
+}
+
 func nonNilCount(limits []*pb.AddressedOrderLimit) int {
 	total := 0
 	for _, limit := range limits {
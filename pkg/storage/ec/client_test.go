@@ -4,6 +4,7 @@
 package ecclient
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
@@ -44,3 +45,43 @@ func TestUnique(t *testing.T) {
 		assert.Equal(t, tt.unique, unique(tt.limits), errTag)
 	}
 }
+
+func TestSpareLimits(t *testing.T) {
+	limit := &pb.AddressedOrderLimit{Limit: &pb.OrderLimit2{}}
+
+	for i, tt := range []struct {
+		limits       []*pb.AddressedOrderLimit
+		optimalCount int
+		spares       []int
+	}{
+		{[]*pb.AddressedOrderLimit{limit, limit, limit, limit}, 4, nil},
+		{[]*pb.AddressedOrderLimit{limit, limit, limit, limit}, 3, nil},
+		{[]*pb.AddressedOrderLimit{limit, limit, limit, limit}, 2, []int{3}},
+		{[]*pb.AddressedOrderLimit{limit, limit, limit, limit, limit, nil}, 1, []int{3, 4}},
+		{[]*pb.AddressedOrderLimit{nil, limit, nil, limit, limit, limit}, 0, []int{4, 5}},
+	} {
+		errTag := fmt.Sprintf("Test case #%d", i)
+		spares := spareLimits(tt.limits, tt.optimalCount)
+		assert.Equal(t, tt.spares, spares.indexes, errTag)
+	}
+
+	spares := spareLimits([]*pb.AddressedOrderLimit{limit, limit, limit, limit, limit}, 1)
+	assert.True(t, spares.has(3))
+	assert.False(t, spares.has(2))
+
+	next, ok := spares.next(context.Background())
+	assert.True(t, ok)
+	assert.Equal(t, 3, next)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, ok = spares.next(ctx)
+	assert.False(t, ok)
+
+	next, ok = spares.next(context.Background())
+	assert.True(t, ok)
+	assert.Equal(t, 4, next)
+
+	_, ok = spares.next(context.Background())
+	assert.False(t, ok)
+}
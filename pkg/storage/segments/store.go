@@ -292,7 +292,7 @@ func (s *segmentStore) Delete(ctx context.Context, path storj.Path) (err error)
 	}
 
 	if len(limits) == 0 {
-		// inline segment - nothing else to do
+		// inline segment or pieces deleted by the satellite - nothing else to do
 		return
 	}
 
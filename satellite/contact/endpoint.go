@@ -0,0 +1,113 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package contact implements the endpoint the storage nodes check in with.
+package contact
+
+import (
+	"context"
+	"net"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	monkit "gopkg.in/spacemonkeygo/monkit.v2"
+
+	"storj.io/storj/pkg/identity"
+	"storj.io/storj/pkg/overlay"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/transport"
+)
+
+var (
+	// Error is the default error class for contact.
+	Error = errs.Class("contact")
+
+	mon = monkit.Package()
+)
+
+// Endpoint receives the check-ins of the storage nodes and pings them back
+// to verify that they are reachable at the address they reported.
+type Endpoint struct {
+	log       *zap.Logger
+	transport transport.Client
+	overlay   *overlay.Cache
+}
+
+// NewEndpoint creates a new contact endpoint.
+func NewEndpoint(log *zap.Logger, transport transport.Client, overlay *overlay.Cache) *Endpoint {
+	return &Endpoint{
+		log:       log,
+		transport: transport,
+		overlay:   overlay,
+	}
+}
+
+// Checkin pings the storage node back at the address it reported and, when
+// the node is reachable, updates its address and info in the overlay. The
+// result of the ping is returned to the node.
+func (endpoint *Endpoint) Checkin(ctx context.Context, req *pb.CheckinRequest) (_ *pb.CheckinResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	peer, err := identity.PeerIdentityFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+	nodeID := peer.ID
+
+	if _, _, err := net.SplitHostPort(req.Address); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address %q: %v", req.Address, err)
+	}
+
+	node := pb.Node{
+		Id: nodeID,
+		Address: &pb.NodeAddress{
+			Transport: pb.NodeTransport_TCP_TLS_GRPC,
+			Address:   req.Address,
+		},
+	}
+
+	// the transport observers update the uptime of the node
+	pingErr := endpoint.pingBack(ctx, &node)
+	if pingErr != nil {
+		// the error may contain details about the satellite's network, hence it is only logged
+		endpoint.log.Debug("unable to ping back node", zap.Stringer("node", nodeID), zap.String("address", req.Address), zap.Error(pingErr))
+		return &pb.CheckinResponse{
+			PingNodeSuccess:  false,
+			PingErrorMessage: "failed to ping the node back at " + req.Address,
+		}, nil
+	}
+
+	if err := endpoint.overlay.Put(ctx, nodeID, node); err != nil {
+		endpoint.log.Error("unable to update node address", zap.Stringer("node", nodeID), zap.Error(err))
+		return nil, status.Error(codes.Internal, Error.Wrap(err).Error())
+	}
+
+	_, err = endpoint.overlay.UpdateNodeInfo(ctx, nodeID, &pb.InfoResponse{
+		Type:     pb.NodeType_STORAGE,
+		Operator: req.Operator,
+		Capacity: req.Capacity,
+		Version:  req.Version,
+	})
+	if err != nil {
+		endpoint.log.Error("unable to update node info", zap.Stringer("node", nodeID), zap.Error(err))
+		return nil, status.Error(codes.Internal, Error.Wrap(err).Error())
+	}
+
+	return &pb.CheckinResponse{PingNodeSuccess: true}, nil
+}
+
+// pingBack connects to the node and pings its contact service.
+func (endpoint *Endpoint) pingBack(ctx context.Context, node *pb.Node) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	conn, err := endpoint.transport.DialNode(ctx, node)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, Error.Wrap(conn.Close())) }()
+
+	_, err = pb.NewContactClient(conn).PingNode(ctx, &pb.ContactPingRequest{})
+	return Error.Wrap(err)
+}
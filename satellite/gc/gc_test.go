@@ -0,0 +1,122 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package gc_test
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/internal/memory"
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/testplanet"
+	"storj.io/storj/pkg/auth/signing"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/storage"
+	"storj.io/storj/storagenode"
+	"storj.io/storj/storagenode/pieces"
+	"storj.io/storj/uplink"
+)
+
+// TestGarbageCollection does the following:
+// * Upload a segment to the storage nodes
+// * Store an additional piece, which is not referenced by any pointer, on one of them
+// * Send the garbage collection filters
+// * Check that the unreferenced piece was deleted and the segment still exists
+func TestGarbageCollection(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		ul := planet.Uplinks[0]
+
+		satellite.GarbageCollection.Service.Loop.Pause()
+
+		testData := make([]byte, 5*memory.KiB)
+		_, err := rand.Read(testData)
+		require.NoError(t, err)
+
+		err = ul.UploadWithConfig(ctx, satellite, &uplink.RSConfig{
+			MinThreshold:     2,
+			RepairThreshold:  3,
+			SuccessThreshold: 4,
+			MaxThreshold:     4,
+		}, "testbucket", "test/path", testData)
+		require.NoError(t, err)
+
+		var rootPieceID storj.PieceID
+		err = satellite.Metainfo.Service.Iterate("", "", true, false, func(it storage.Iterator) error {
+			var item storage.ListItem
+			for it.Next(&item) {
+				pointer := &pb.Pointer{}
+				if err := proto.Unmarshal(item.Value, pointer); err != nil {
+					return err
+				}
+				if pointer.GetRemote() != nil {
+					rootPieceID = pointer.GetRemote().RootPieceId
+				}
+			}
+			return nil
+		})
+		require.NoError(t, err)
+		require.False(t, rootPieceID.IsZero())
+
+		node := planet.StorageNodes[0]
+		realPieceID := rootPieceID.Derive(node.ID())
+
+		orphanPieceID := storj.NewPieceID()
+		storeOrphanPiece(t, ctx, node, satellite.ID(), ul, orphanPieceID)
+
+		err = satellite.GarbageCollection.Service.Send(ctx)
+		require.NoError(t, err)
+
+		_, err = node.DB.PieceInfo().Get(ctx, satellite.ID(), orphanPieceID)
+		require.Error(t, err)
+		_, err = node.Storage2.Store.Reader(ctx, satellite.ID(), orphanPieceID)
+		require.Error(t, err)
+
+		_, err = node.DB.PieceInfo().Get(ctx, satellite.ID(), realPieceID)
+		require.NoError(t, err)
+
+		data, err := ul.Download(ctx, satellite, "testbucket", "test/path")
+		require.NoError(t, err)
+		require.Equal(t, testData, data)
+	})
+}
+
+// storeOrphanPiece stores a piece on the node that was created before the
+// garbage collection retain time buffer.
+func storeOrphanPiece(t *testing.T, ctx *testcontext.Context, node *storagenode.Peer, satelliteID storj.NodeID, ul *testplanet.Uplink, pieceID storj.PieceID) {
+	data := make([]byte, 1*memory.KiB)
+	_, err := rand.Read(data)
+	require.NoError(t, err)
+
+	writer, err := node.Storage2.Store.Writer(ctx, satelliteID, pieceID)
+	require.NoError(t, err)
+	_, err = writer.Write(data)
+	require.NoError(t, err)
+
+	pieceHash, err := signing.SignPieceHash(
+		signing.SignerFromFullIdentity(ul.Identity),
+		&pb.PieceHash{
+			PieceId: pieceID,
+			Hash:    writer.Hash(),
+		})
+	require.NoError(t, err)
+	require.NoError(t, writer.Commit(&pb.PieceHeader{UplinkPieceHash: pieceHash}))
+
+	err = node.DB.PieceInfo().Add(ctx, &pieces.Info{
+		SatelliteID:     satelliteID,
+		PieceID:         pieceID,
+		PieceSize:       writer.Size(),
+		PieceCreation:   time.Now().Add(-24 * time.Hour).UTC(),
+		UplinkPieceHash: pieceHash,
+		Uplink:          ul.Identity.PeerIdentity(),
+	})
+	require.NoError(t, err)
+}
@@ -0,0 +1,193 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package gc
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+	monkit "gopkg.in/spacemonkeygo/monkit.v2"
+
+	"storj.io/storj/internal/sync2"
+	"storj.io/storj/pkg/auth/signing"
+	"storj.io/storj/pkg/bloomfilter"
+	"storj.io/storj/pkg/overlay"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/pkg/transport"
+	"storj.io/storj/satellite/metainfo"
+	"storj.io/storj/storage"
+	"storj.io/storj/uplink/piecestore"
+)
+
+var (
+	// Error defines the gc service errors class
+	Error = errs.Class("gc service error")
+
+	mon = monkit.Package()
+)
+
+// Config contains configurable values for garbage collection
+type Config struct {
+	Interval time.Duration `help:"the time between each send of garbage collection filters to storage nodes" default:"120h0m0s"`
+	Enabled  bool          `help:"set if garbage collection is enabled or not" releaseDefault:"false" devDefault:"true"`
+	// value for InitialPieces currently based on average pieces per node
+	InitialPieces     int     `help:"the initial number of pieces expected for a storage node to have, used for creating a filter" default:"400000"`
+	FalsePositiveRate float64 `help:"the false positive rate used for creating a garbage collection bloom filter" default:"0.1"`
+	ConcurrentSends   int     `help:"the number of nodes to concurrently send garbage collection bloom filters to" default:"1"`
+}
+
+// Service implements the garbage collection service. It builds a bloom filter
+// of the pieces every storage node should have and sends it to the node, which
+// then deletes the pieces not in the filter.
+type Service struct {
+	log    *zap.Logger
+	config Config
+	Loop   sync2.Cycle
+
+	signer    signing.Signer
+	transport transport.Client
+	overlay   *overlay.Cache
+	metainfo  *metainfo.Service
+}
+
+// NewService creates a new instance of the gc service
+func NewService(log *zap.Logger, config Config, signer signing.Signer, transport transport.Client, overlay *overlay.Cache, metainfo *metainfo.Service) *Service {
+	return &Service{
+		log:    log,
+		config: config,
+		Loop:   *sync2.NewCycle(config.Interval),
+
+		signer:    signer,
+		transport: transport,
+		overlay:   overlay,
+		metainfo:  metainfo,
+	}
+}
+
+// Run starts the gc loop service
+func (service *Service) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if !service.config.Enabled {
+		return nil
+	}
+
+	return service.Loop.Run(ctx, func(ctx context.Context) error {
+		if err := service.Send(ctx); err != nil {
+			service.log.Error("garbage collection failed", zap.Error(err))
+		}
+		return nil
+	})
+}
+
+// Send builds the bloom filters from the pointers and sends them to the storage nodes.
+func (service *Service) Send(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	// pieces uploaded after this time may be missing from the filters
+	createdBefore := time.Now().UTC()
+
+	filters, err := service.buildFilters(ctx)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	creationDate, err := ptypes.TimestampProto(createdBefore)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	concurrentSends := service.config.ConcurrentSends
+	if concurrentSends < 1 {
+		concurrentSends = 1
+	}
+	limiter := make(chan struct{}, concurrentSends)
+
+	var group errgroup.Group
+	for nodeID, filter := range filters {
+		nodeID, filter := nodeID, filter
+		limiter <- struct{}{}
+		group.Go(func() error {
+			defer func() { <-limiter }()
+
+			err := service.sendRetainRequest(ctx, nodeID, &pb.RetainRequest{
+				CreationDate: creationDate,
+				Filter:       filter.Bytes(),
+			})
+			if err != nil {
+				service.log.Error("error sending retain filter", zap.Stringer("node", nodeID), zap.Error(err))
+			}
+			return nil
+		})
+	}
+	_ = group.Wait() // doesn't return errors
+
+	return nil
+}
+
+// buildFilters adds the pieces of all remote segments to the filters of their nodes.
+func (service *Service) buildFilters(ctx context.Context) (filters map[storj.NodeID]*bloomfilter.Filter, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	filters = make(map[storj.NodeID]*bloomfilter.Filter)
+	err = service.metainfo.Iterate("", "", true, false,
+		func(it storage.Iterator) error {
+			var item storage.ListItem
+			for it.Next(&item) {
+				pointer := &pb.Pointer{}
+				if err := proto.Unmarshal(item.Value, pointer); err != nil {
+					return Error.New("error unmarshalling pointer %s", err)
+				}
+
+				remote := pointer.GetRemote()
+				if remote == nil {
+					continue
+				}
+
+				for _, piece := range remote.GetRemotePieces() {
+					filter, ok := filters[piece.NodeId]
+					if !ok {
+						filter = bloomfilter.NewOptimal(service.config.InitialPieces, service.config.FalsePositiveRate)
+						filters[piece.NodeId] = filter
+					}
+					filter.Add(remote.RootPieceId.Derive(piece.NodeId))
+				}
+			}
+			return nil
+		},
+	)
+	return filters, err
+}
+
+// sendRetainRequest sends the filter to a single storage node.
+func (service *Service) sendRetainRequest(ctx context.Context, nodeID storj.NodeID, retainReq *pb.RetainRequest) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	dossier, err := service.overlay.Get(ctx, nodeID)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	conn, err := service.transport.DialNode(ctx, &dossier.Node)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	client := piecestore.NewClient(service.log.Named("piecestore"), service.signer, conn, piecestore.DefaultConfig)
+	defer func() { err = errs.Combine(err, Error.Wrap(client.Close())) }()
+
+	return Error.Wrap(client.Retain(ctx, retainReq))
+}
+
+// Close stops the gc loop service
+func (service *Service) Close() error {
+	service.Loop.Close()
+	return nil
+}
@@ -0,0 +1,28 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package gracefulexit
+
+import (
+	"context"
+	"time"
+
+	"storj.io/storj/pkg/storj"
+)
+
+// Progress is the transfer progress of an exiting storage node.
+type Progress struct {
+	NodeID            storj.NodeID
+	BytesTransferred  int64
+	PiecesTransferred int64
+	PiecesFailed      int64
+	UpdatedAt         time.Time
+}
+
+// DB implements the database for graceful exit progress.
+type DB interface {
+	// IncrementProgress increments the transferred bytes and the transferred and failed piece counts of a node.
+	IncrementProgress(ctx context.Context, nodeID storj.NodeID, bytes, successfulTransfers, failedTransfers int64) error
+	// GetProgress gets the transfer progress of a node, the progress is empty when nothing has been transferred yet.
+	GetProgress(ctx context.Context, nodeID storj.NodeID) (*Progress, error)
+}
@@ -0,0 +1,514 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package gracefulexit
+
+import (
+	"bytes"
+	"context"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	monkit "gopkg.in/spacemonkeygo/monkit.v2"
+
+	"storj.io/storj/pkg/auth/signing"
+	"storj.io/storj/pkg/certdb"
+	"storj.io/storj/pkg/eestream"
+	"storj.io/storj/pkg/identity"
+	"storj.io/storj/pkg/kademlia"
+	"storj.io/storj/pkg/overlay"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/satellite/metainfo"
+	"storj.io/storj/satellite/orders"
+	"storj.io/storj/storage"
+)
+
+var (
+	// Error is the default error class for graceful exit.
+	Error = errs.Class("graceful exit error")
+
+	mon = monkit.Package()
+)
+
+// Config contains configurable values for graceful exit.
+type Config struct {
+	MaxInflightTransfers         int `help:"maximum number of piece transfers an exiting node is asked to do at the same time" default:"5"`
+	MaxFailuresPerPiece          int `help:"maximum number of failed transfers of a piece before the piece is counted as failed" default:"3"`
+	OverallMaxFailuresPercentage int `help:"maximum percentage of failed piece transfers for an exit to be successful" default:"10"`
+}
+
+// Endpoint sends the pieces of exiting storage nodes to them for transfer
+// and moves the pieces to their new nodes in the pointers.
+type Endpoint struct {
+	log       *zap.Logger
+	satellite signing.Signee
+	db        DB
+	overlay   *overlay.Cache
+	kademlia  *kademlia.Kademlia
+	metainfo  *metainfo.Service
+	orders    *orders.Service
+	certdb    certdb.DB
+	config    Config
+}
+
+// NewEndpoint creates a new graceful exit endpoint.
+func NewEndpoint(log *zap.Logger, satellite signing.Signee, db DB, overlay *overlay.Cache, kademlia *kademlia.Kademlia, metainfo *metainfo.Service, orders *orders.Service, certdb certdb.DB, config Config) *Endpoint {
+	if config.MaxInflightTransfers <= 0 {
+		// otherwise no transfer is ever started
+		log.Warn("invalid maximum number of inflight transfers, using 1", zap.Int("max inflight transfers", config.MaxInflightTransfers))
+		config.MaxInflightTransfers = 1
+	}
+
+	return &Endpoint{
+		log:       log,
+		satellite: satellite,
+		db:        db,
+		overlay:   overlay,
+		kademlia:  kademlia,
+		metainfo:  metainfo,
+		orders:    orders,
+		certdb:    certdb,
+		config:    config,
+	}
+}
+
+// transfer is a piece which has to be moved away from the exiting node.
+type transfer struct {
+	path     storj.Path
+	pieceNum int32
+	failures int
+	// excluded are the replacement nodes which failed to receive the piece
+	excluded []storj.NodeID
+
+	// set while the transfer is in progress
+	pointer   *pb.Pointer
+	pieceSize int64
+	newNode   *pb.Node
+	limit     *pb.AddressedOrderLimit
+}
+
+// Process is called by an exiting storage node. It initiates the exit, sends
+// the pieces to transfer to the node and handles the results of the transfers.
+func (endpoint *Endpoint) Process(stream pb.SatelliteGracefulExit_ProcessServer) (err error) {
+	ctx := stream.Context()
+	defer mon.Task()(&ctx)(&err)
+
+	peer, err := identity.PeerIdentityFromContext(ctx)
+	if err != nil {
+		return status.Error(codes.Unauthenticated, err.Error())
+	}
+	nodeID := peer.ID
+
+	node, err := endpoint.overlay.Get(ctx, nodeID)
+	if err != nil {
+		if overlay.ErrNodeNotFound.Has(err) {
+			return status.Error(codes.NotFound, err.Error())
+		}
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	if node.ExitStatus.ExitFinishedAt != nil {
+		return endpoint.sendResult(ctx, stream, nodeID, node.ExitStatus.ExitSuccess)
+	}
+
+	if node.ExitStatus.ExitInitiatedAt == nil {
+		endpoint.log.Info("graceful exit initiated", zap.Stringer("node", nodeID))
+		_, err = endpoint.overlay.UpdateExitStatus(ctx, &overlay.ExitStatusRequest{
+			NodeID:          nodeID,
+			ExitInitiatedAt: time.Now().UTC(),
+		})
+		if err != nil {
+			return status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	queue, err := endpoint.collectTransfers(ctx, nodeID)
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	// receive the results from the storage node in the background
+	messages := make(chan *pb.StorageNodeMessage)
+	recvErr := make(chan error, 1)
+	go func() {
+		for {
+			message, err := stream.Recv()
+			if err != nil {
+				recvErr <- err
+				return
+			}
+			select {
+			case messages <- message:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	inflight := make(map[storj.PieceID]*transfer)
+	for len(queue) > 0 || len(inflight) > 0 {
+		for len(queue) > 0 && len(inflight) < endpoint.config.MaxInflightTransfers {
+			next := queue[0]
+			queue = queue[1:]
+
+			pieceID, ok, err := endpoint.startTransfer(ctx, stream, nodeID, next)
+			if err != nil {
+				return Error.Wrap(err)
+			}
+			if ok {
+				inflight[pieceID] = next
+				continue
+			}
+			if next.pieceSize == 0 {
+				// the segment or the piece is gone, nothing to transfer
+				continue
+			}
+			// no replacement node was found
+			if retry, err := endpoint.fail(ctx, nodeID, next); err != nil {
+				return Error.Wrap(err)
+			} else if retry {
+				queue = append(queue, next)
+			}
+		}
+
+		if len(inflight) == 0 {
+			if len(queue) > 0 {
+				// the exit isn't finished, the node continues it when it reconnects
+				return Error.New("%d pieces left to transfer with no transfer in progress", len(queue))
+			}
+			break
+		}
+
+		var message *pb.StorageNodeMessage
+		select {
+		case message = <-messages:
+		case err := <-recvErr:
+			// the node will continue the exit when it reconnects
+			return Error.Wrap(err)
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+
+		switch {
+		case message.Succeeded != nil:
+			pieceID := message.Succeeded.PieceId
+			current, ok := inflight[pieceID]
+			if !ok {
+				endpoint.log.Debug("unknown transfer succeeded", zap.Stringer("node", nodeID), zap.Stringer("piece", pieceID))
+				continue
+			}
+			delete(inflight, pieceID)
+
+			if err := endpoint.verifyTransfer(ctx, nodeID, current, pieceID, message.Succeeded); err != nil {
+				endpoint.log.Info("transfer verification failed", zap.Stringer("node", nodeID), zap.Stringer("piece", pieceID), zap.Error(err))
+				if retry, err := endpoint.fail(ctx, nodeID, current); err != nil {
+					return Error.Wrap(err)
+				} else if retry {
+					queue = append(queue, current)
+				}
+				continue
+			}
+
+			if err := endpoint.succeed(ctx, nodeID, current, message.Succeeded.ReplacementPieceHash); err != nil {
+				return Error.Wrap(err)
+			}
+
+			err = stream.Send(&pb.SatelliteMessage{
+				DeletePiece: &pb.DeletePiece{PieceId: pieceID},
+			})
+			if err != nil {
+				return Error.Wrap(err)
+			}
+
+		case message.Failed != nil:
+			pieceID := message.Failed.PieceId
+			current, ok := inflight[pieceID]
+			if !ok {
+				endpoint.log.Debug("unknown transfer failed", zap.Stringer("node", nodeID), zap.Stringer("piece", pieceID))
+				continue
+			}
+			delete(inflight, pieceID)
+
+			endpoint.log.Info("transfer failed", zap.Stringer("node", nodeID), zap.Stringer("piece", pieceID), zap.Stringer("error", message.Failed.Error))
+			if message.Failed.Error == pb.TransferFailed_NOT_FOUND {
+				// the node lost the piece, retrying won't help
+				current.failures = endpoint.config.MaxFailuresPerPiece
+			}
+			if retry, err := endpoint.fail(ctx, nodeID, current); err != nil {
+				return Error.Wrap(err)
+			} else if retry {
+				queue = append(queue, current)
+			}
+		}
+	}
+
+	progress, err := endpoint.db.GetProgress(ctx, nodeID)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	success := true
+	if total := progress.PiecesTransferred + progress.PiecesFailed; total > 0 {
+		success = progress.PiecesFailed*100 <= int64(endpoint.config.OverallMaxFailuresPercentage)*total
+	}
+
+	endpoint.log.Info("graceful exit finished", zap.Stringer("node", nodeID), zap.Bool("success", success))
+	_, err = endpoint.overlay.UpdateExitStatus(ctx, &overlay.ExitStatusRequest{
+		NodeID:         nodeID,
+		ExitFinishedAt: time.Now().UTC(),
+		ExitSuccess:    success,
+	})
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	return endpoint.sendResult(ctx, stream, nodeID, success)
+}
+
+// collectTransfers finds all the remote pieces stored on the node.
+func (endpoint *Endpoint) collectTransfers(ctx context.Context, nodeID storj.NodeID) (queue []*transfer, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = endpoint.metainfo.Iterate("", "", true, false,
+		func(it storage.Iterator) error {
+			var item storage.ListItem
+			for it.Next(&item) {
+				pointer := &pb.Pointer{}
+				if err := proto.Unmarshal(item.Value, pointer); err != nil {
+					return Error.New("error unmarshalling pointer %s", err)
+				}
+
+				for _, piece := range pointer.GetRemote().GetRemotePieces() {
+					if piece.NodeId == nodeID {
+						queue = append(queue, &transfer{
+							path:     item.Key.String(),
+							pieceNum: piece.PieceNum,
+						})
+					}
+				}
+			}
+			return nil
+		},
+	)
+	return queue, Error.Wrap(err)
+}
+
+// startTransfer finds a replacement node for the piece and asks the exiting node
+// to upload the piece to it. It returns false when the piece cannot be transferred,
+// in which case current.pieceSize is zero when there is nothing left to transfer.
+func (endpoint *Endpoint) startTransfer(ctx context.Context, stream pb.SatelliteGracefulExit_ProcessServer, nodeID storj.NodeID, current *transfer) (_ storj.PieceID, _ bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	current.pointer, current.pieceSize = nil, 0
+
+	pointer, err := endpoint.metainfo.Get(current.path)
+	if err != nil {
+		if storage.ErrKeyNotFound.Has(err) {
+			return storj.PieceID{}, false, nil
+		}
+		return storj.PieceID{}, false, err
+	}
+	remote := pointer.GetRemote()
+	if remote == nil || findPiece(remote, nodeID, current.pieceNum) < 0 {
+		return storj.PieceID{}, false, nil
+	}
+
+	redundancy, err := eestream.NewRedundancyStrategyFromProto(remote.GetRedundancy())
+	if err != nil {
+		return storj.PieceID{}, false, err
+	}
+	current.pointer = pointer
+	current.pieceSize = eestream.CalcPieceSize(pointer.GetSegmentSize(), redundancy)
+
+	excluded := append([]storj.NodeID(nil), current.excluded...)
+	for _, piece := range remote.GetRemotePieces() {
+		excluded = append(excluded, piece.NodeId)
+	}
+
+	newNodes, err := endpoint.overlay.FindStorageNodes(ctx, overlay.FindStorageNodesRequest{
+		RequestedCount: 1,
+		FreeBandwidth:  current.pieceSize,
+		FreeDisk:       current.pieceSize,
+		ExcludedNodes:  excluded,
+	})
+	if err != nil {
+		if overlay.ErrNotEnoughNodes.Has(err) {
+			endpoint.log.Info("no replacement node found", zap.Stringer("node", nodeID), zap.String("path", current.path))
+			return storj.PieceID{}, false, nil
+		}
+		return storj.PieceID{}, false, err
+	}
+	current.newNode = newNodes[0]
+
+	bucketID, err := createBucketID(current.path)
+	if err != nil {
+		return storj.PieceID{}, false, err
+	}
+
+	current.limit, err = endpoint.orders.CreateGracefulExitPutOrderLimit(ctx, nodeID, bucketID, pointer, current.newNode)
+	if err != nil {
+		return storj.PieceID{}, false, err
+	}
+
+	pieceID := remote.RootPieceId.Derive(nodeID)
+	err = stream.Send(&pb.SatelliteMessage{
+		TransferPiece: &pb.TransferPiece{
+			PieceId:             pieceID,
+			AddressedOrderLimit: current.limit,
+		},
+	})
+	if err != nil {
+		return storj.PieceID{}, false, err
+	}
+
+	return pieceID, true, nil
+}
+
+// verifyTransfer checks that the replacement node stored the same piece the uplink uploaded.
+// The original piece hash has to be signed by the uplink of the original order limit and
+// the replacement piece hash by the replacement node.
+func (endpoint *Endpoint) verifyTransfer(ctx context.Context, nodeID storj.NodeID, current *transfer, pieceID storj.PieceID, succeeded *pb.TransferSucceeded) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	original, replacement, limit := succeeded.OriginalPieceHash, succeeded.ReplacementPieceHash, succeeded.OriginalOrderLimit
+	switch {
+	case original == nil || replacement == nil:
+		return Error.New("missing piece hash")
+	case limit == nil:
+		return Error.New("missing original order limit")
+	case original.PieceId != pieceID:
+		return Error.New("original piece hash is for a different piece %s", original.PieceId)
+	case limit.PieceId != pieceID || limit.StorageNodeId != nodeID || limit.SatelliteId != endpoint.satellite.ID():
+		return Error.New("original order limit is for a different piece %s", limit.PieceId)
+	case replacement.PieceId != current.limit.Limit.PieceId:
+		return Error.New("replacement piece hash is for a different piece %s", replacement.PieceId)
+	case !bytes.Equal(original.Hash, replacement.Hash):
+		return Error.New("replacement piece hash does not match the original")
+	}
+
+	if err := signing.VerifyOrderLimitSignature(endpoint.satellite, limit); err != nil {
+		return Error.New("unable to verify original order limit: %v", err)
+	}
+
+	// who uploaded the piece: uplink (put) or satellite (put_repair)
+	var uplink signing.Signee
+	if limit.UplinkId == endpoint.satellite.ID() {
+		uplink = endpoint.satellite
+	} else {
+		uplinkPubKey, err := endpoint.certdb.GetPublicKey(ctx, limit.UplinkId)
+		if err != nil {
+			return Error.New("unable to find uplink public key: %v", err)
+		}
+		uplink = &signing.PublicKey{
+			Self: limit.UplinkId,
+			Key:  uplinkPubKey,
+		}
+	}
+	if err := signing.VerifyPieceHashSignature(uplink, original); err != nil {
+		return Error.New("unable to verify original piece hash: %v", err)
+	}
+
+	replacementNode, err := endpoint.kademlia.FetchPeerIdentityFromNode(ctx, *current.newNode)
+	if err != nil {
+		return Error.New("unable to fetch replacement node identity: %v", err)
+	}
+	if err := signing.VerifyPieceHashSignature(signing.SigneeFromPeerIdentity(replacementNode), replacement); err != nil {
+		return Error.New("unable to verify replacement piece hash: %v", err)
+	}
+
+	return nil
+}
+
+// succeed moves the piece to the replacement node in the pointer and records the transfer.
+// The pointer is only updated when it hasn't been replaced since the transfer started.
+func (endpoint *Endpoint) succeed(ctx context.Context, nodeID storj.NodeID, current *transfer, hash *pb.PieceHash) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = endpoint.metainfo.UpdatePieces(current.path, current.pointer,
+		[]*pb.RemotePiece{{
+			PieceNum: current.pieceNum,
+			NodeId:   current.newNode.Id,
+			Hash:     hash,
+		}},
+		[]*pb.RemotePiece{{
+			PieceNum: current.pieceNum,
+			NodeId:   nodeID,
+		}},
+	)
+	if err != nil {
+		if !metainfo.ErrPointerReplaced.Has(err) {
+			return err
+		}
+		// the segment was deleted or replaced, the piece doesn't need to be transferred anymore
+		endpoint.log.Debug("segment changed during transfer", zap.Stringer("node", nodeID), zap.String("path", current.path))
+	}
+
+	return endpoint.db.IncrementProgress(ctx, nodeID, current.pieceSize, 1, 0)
+}
+
+// fail records a failed transfer attempt and reports whether the transfer should be retried.
+func (endpoint *Endpoint) fail(ctx context.Context, nodeID storj.NodeID, current *transfer) (retry bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	current.failures++
+	if current.newNode != nil {
+		current.excluded = append(current.excluded, current.newNode.Id)
+		current.newNode = nil
+	}
+	if current.failures < endpoint.config.MaxFailuresPerPiece {
+		return true, nil
+	}
+
+	return false, endpoint.db.IncrementProgress(ctx, nodeID, 0, 0, 1)
+}
+
+// sendResult sends the final result of the exit to the node.
+func (endpoint *Endpoint) sendResult(ctx context.Context, stream pb.SatelliteGracefulExit_ProcessServer, nodeID storj.NodeID, success bool) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	progress, err := endpoint.db.GetProgress(ctx, nodeID)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	if success {
+		err = stream.Send(&pb.SatelliteMessage{
+			ExitCompleted: &pb.ExitCompleted{
+				PiecesTransferred: progress.PiecesTransferred,
+				PiecesFailed:      progress.PiecesFailed,
+			},
+		})
+	} else {
+		err = stream.Send(&pb.SatelliteMessage{
+			ExitFailed: &pb.ExitFailed{
+				Reason:            pb.ExitFailed_OVERALL_FAILURE_PERCENTAGE_EXCEEDED,
+				PiecesTransferred: progress.PiecesTransferred,
+				PiecesFailed:      progress.PiecesFailed,
+			},
+		})
+	}
+	return Error.Wrap(err)
+}
+
+// findPiece returns the index of the piece pieceNum stored on nodeID or -1.
+func findPiece(remote *pb.RemoteSegment, nodeID storj.NodeID, pieceNum int32) int {
+	for i, piece := range remote.GetRemotePieces() {
+		if piece.NodeId == nodeID && piece.PieceNum == pieceNum {
+			return i
+		}
+	}
+	return -1
+}
+
+func createBucketID(path storj.Path) ([]byte, error) {
+	comps := storj.SplitPath(path)
+	if len(comps) < 3 {
+		return nil, Error.New("no bucket component in path: %s", path)
+	}
+	return []byte(storj.JoinPaths(comps[0], comps[2])), nil
+}
@@ -0,0 +1,131 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package gracefulexit_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"storj.io/storj/internal/memory"
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/testplanet"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/satellite"
+	"storj.io/storj/storage"
+	"storj.io/storj/storagenode"
+	"storj.io/storj/storagenode/gracefulexit"
+	"storj.io/storj/uplink"
+)
+
+func TestGracefulExit(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 8, UplinkCount: 1,
+	}, testGracefulExit)
+}
+
+func TestGracefulExitInvalidInflightTransfers(t *testing.T) {
+	// the endpoint falls back to one transfer at a time instead of finishing the exit without any transfer
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 8, UplinkCount: 1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.GracefulExit.MaxInflightTransfers = 0
+			},
+		},
+	}, testGracefulExit)
+}
+
+// testGracefulExit exits a storage node holding a piece and checks that the piece is moved.
+func testGracefulExit(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+	satellite := planet.Satellites[0]
+	ul := planet.Uplinks[0]
+
+	testData := make([]byte, 5*memory.KiB)
+	_, err := rand.Read(testData)
+	require.NoError(t, err)
+
+	err = ul.UploadWithConfig(ctx, satellite, &uplink.RSConfig{
+		MinThreshold:     2,
+		RepairThreshold:  3,
+		SuccessThreshold: 4,
+		MaxThreshold:     4,
+	}, "testbucket", "test/path", testData)
+	require.NoError(t, err)
+
+	pieceNodes := remotePieceNodes(t, satellite.Metainfo.Service.Iterate)
+	require.NotEmpty(t, pieceNodes)
+
+	var exiting *storagenode.Peer
+	for _, node := range planet.StorageNodes {
+		if pieceNodes[node.ID()] {
+			exiting = node
+			break
+		}
+	}
+	require.NotNil(t, exiting)
+
+	_, err = exiting.GracefulExit.Endpoint.InitiateGracefulExit(ctx, &pb.InitiateGracefulExitRequest{
+		NodeId: satellite.ID(),
+	})
+	require.NoError(t, err)
+
+	err = exiting.GracefulExit.Chore.Exit(ctx, satellite.ID())
+	require.NoError(t, err)
+
+	// the pieces of the exiting node have been moved to other nodes
+	pieceNodes = remotePieceNodes(t, satellite.Metainfo.Service.Iterate)
+	require.False(t, pieceNodes[exiting.ID()])
+	require.Len(t, pieceNodes, 4)
+
+	dossier, err := satellite.Overlay.Service.Get(ctx, exiting.ID())
+	require.NoError(t, err)
+	require.NotNil(t, dossier.ExitStatus.ExitInitiatedAt)
+	require.NotNil(t, dossier.ExitStatus.ExitFinishedAt)
+	require.True(t, dossier.ExitStatus.ExitSuccess)
+
+	progress, err := satellite.DB.GracefulExit().GetProgress(ctx, exiting.ID())
+	require.NoError(t, err)
+	require.EqualValues(t, 1, progress.PiecesTransferred)
+	require.EqualValues(t, 0, progress.PiecesFailed)
+
+	exits, err := exiting.DB.GracefulExit().ListGracefulExits(ctx)
+	require.NoError(t, err)
+	require.Len(t, exits, 1)
+	require.NotNil(t, exits[0].FinishedAt)
+	require.Equal(t, gracefulexit.StatusSucceeded, exits[0].Status)
+	require.EqualValues(t, 1, exits[0].PiecesTransferred)
+
+	// the data can still be downloaded without the exiting node
+	err = planet.StopPeer(exiting)
+	require.NoError(t, err)
+
+	data, err := ul.Download(ctx, satellite, "testbucket", "test/path")
+	require.NoError(t, err)
+	require.Equal(t, testData, data)
+}
+
+// remotePieceNodes returns the nodes storing the remote pieces of all pointers.
+func remotePieceNodes(t *testing.T, iterate func(prefix string, first string, recurse bool, reverse bool, f func(it storage.Iterator) error) error) map[storj.NodeID]bool {
+	nodes := map[storj.NodeID]bool{}
+	err := iterate("", "", true, false, func(it storage.Iterator) error {
+		var item storage.ListItem
+		for it.Next(&item) {
+			pointer := &pb.Pointer{}
+			if err := proto.Unmarshal(item.Value, pointer); err != nil {
+				return err
+			}
+			for _, piece := range pointer.GetRemote().GetRemotePieces() {
+				nodes[piece.NodeId] = true
+			}
+		}
+		return nil
+	})
+	require.NoError(t, err)
+	return nodes
+}
@@ -28,6 +28,7 @@ import (
 	"storj.io/storj/pkg/storj"
 	"storj.io/storj/satellite/console"
 	"storj.io/storj/satellite/orders"
+	"storj.io/storj/satellite/piecedeletion"
 	"storj.io/storj/storage"
 )
 
@@ -52,11 +53,12 @@ type Endpoint struct {
 	storagenodeAccountingDB accounting.StoragenodeAccounting
 	projectAccountingDB     accounting.ProjectAccounting
 	liveAccounting          live.Service
+	pieceDeletion           *piecedeletion.Service
 	maxAlphaUsage           memory.Size
 }
 
 // NewEndpoint creates new metainfo endpoint instance
-func NewEndpoint(log *zap.Logger, metainfo *Service, orders *orders.Service, cache *overlay.Cache, apiKeys APIKeys, sdb accounting.StoragenodeAccounting, pdb accounting.ProjectAccounting, liveAccounting live.Service, maxAlphaUsage memory.Size) *Endpoint {
+func NewEndpoint(log *zap.Logger, metainfo *Service, orders *orders.Service, cache *overlay.Cache, apiKeys APIKeys, sdb accounting.StoragenodeAccounting, pdb accounting.ProjectAccounting, liveAccounting live.Service, pieceDeletion *piecedeletion.Service, maxAlphaUsage memory.Size) *Endpoint {
 	// TODO do something with too many params
 	return &Endpoint{
 		log:                     log,
@@ -67,6 +69,7 @@ func NewEndpoint(log *zap.Logger, metainfo *Service, orders *orders.Service, cac
 		storagenodeAccountingDB: sdb,
 		projectAccountingDB:     pdb,
 		liveAccounting:          liveAccounting,
+		pieceDeletion:           pieceDeletion,
 		maxAlphaUsage:           maxAlphaUsage,
 	}
 }
@@ -382,6 +385,12 @@ func (endpoint *Endpoint) DeleteSegment(ctx context.Context, req *pb.SegmentDele
 	}
 
 	if pointer.Type == pb.Pointer_REMOTE && pointer.Remote != nil {
+		// the satellite deletes the pieces, the uplink doesn't need delete order limits
+		if endpoint.pieceDeletion.Enabled() {
+			endpoint.pieceDeletion.DeletePointer(ctx, pointer)
+			return &pb.SegmentDeleteResponse{}, nil
+		}
+
 		uplinkIdentity, err := identity.PeerIdentityFromContext(ctx)
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, err.Error())
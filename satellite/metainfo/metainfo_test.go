@@ -16,12 +16,15 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/zeebo/errs"
+	"go.uber.org/zap"
 
 	"storj.io/storj/internal/testcontext"
 	"storj.io/storj/internal/testplanet"
 	"storj.io/storj/pkg/pb"
 	"storj.io/storj/pkg/storj"
 	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/metainfo"
+	"storj.io/storj/storage/teststore"
 )
 
 // mockAPIKeys is mock for api keys store of pointerdb
@@ -220,3 +223,53 @@ func TestCommitSegment(t *testing.T) {
 		}
 	})
 }
+
+func TestUpdatePieces(t *testing.T) {
+	service := metainfo.NewService(zap.NewNop(), teststore.New())
+
+	pointer := &pb.Pointer{
+		Type: pb.Pointer_REMOTE,
+		Remote: &pb.RemoteSegment{
+			RemotePieces: []*pb.RemotePiece{
+				{PieceNum: 0, NodeId: storj.NodeID{1}},
+				{PieceNum: 1, NodeId: storj.NodeID{2}},
+			},
+		},
+	}
+	require.NoError(t, service.Put("a/b/c", pointer))
+	ref, err := service.Get("a/b/c")
+	require.NoError(t, err)
+
+	// replace the node of piece 1
+	updated, err := service.UpdatePieces("a/b/c", ref,
+		[]*pb.RemotePiece{{PieceNum: 1, NodeId: storj.NodeID{3}}},
+		[]*pb.RemotePiece{{PieceNum: 1, NodeId: storj.NodeID{2}}},
+	)
+	require.NoError(t, err)
+	require.Len(t, updated.GetRemote().GetRemotePieces(), 2)
+	require.Equal(t, storj.NodeID{3}, updated.GetRemote().GetRemotePieces()[1].NodeId)
+
+	stored, err := service.Get("a/b/c")
+	require.NoError(t, err)
+	require.Len(t, stored.GetRemote().GetRemotePieces(), 2)
+	for i, piece := range stored.GetRemote().GetRemotePieces() {
+		require.Equal(t, updated.GetRemote().GetRemotePieces()[i].NodeId, piece.NodeId)
+	}
+
+	// pieces are only removed when the node matches
+	_, err = service.UpdatePieces("a/b/c", ref,
+		[]*pb.RemotePiece{{PieceNum: 0, NodeId: storj.NodeID{4}}},
+		[]*pb.RemotePiece{{PieceNum: 0, NodeId: storj.NodeID{2}}},
+	)
+	require.Error(t, err)
+
+	// a replaced pointer is not updated
+	require.NoError(t, service.Put("a/b/c", pointer))
+	_, err = service.UpdatePieces("a/b/c", ref, nil, []*pb.RemotePiece{{PieceNum: 0, NodeId: storj.NodeID{1}}})
+	require.True(t, metainfo.ErrPointerReplaced.Has(err))
+
+	// neither is a deleted one
+	require.NoError(t, service.Delete("a/b/c"))
+	_, err = service.UpdatePieces("a/b/c", ref, nil, nil)
+	require.True(t, metainfo.ErrPointerReplaced.Has(err))
+}
@@ -14,6 +14,10 @@ import (
 	"storj.io/storj/storage"
 )
 
+// ErrPointerReplaced is returned by UpdatePieces when the pointer has been
+// replaced or deleted since the caller read it.
+var ErrPointerReplaced = errs.Class("pointer replaced")
+
 // Service structure
 type Service struct {
 	logger *zap.Logger
@@ -61,6 +65,74 @@ func (s *Service) Get(path string) (pointer *pb.Pointer, err error) {
 	return pointer, nil
 }
 
+// UpdatePieces atomically removes the toRemove pieces from and adds the toAdd
+// pieces to the pointer under path. ref is the pointer the caller got from Get,
+// the update fails with ErrPointerReplaced when the pointer is no longer ref.
+// A piece is only removed when both its number and its node match.
+func (s *Service) UpdatePieces(path string, ref *pb.Pointer, toAdd, toRemove []*pb.RemotePiece) (pointer *pb.Pointer, err error) {
+	for {
+		oldPointerBytes, err := s.DB.Get([]byte(path))
+		if err != nil {
+			if storage.ErrKeyNotFound.Has(err) {
+				return nil, ErrPointerReplaced.New("%s", path)
+			}
+			return nil, err
+		}
+
+		pointer = &pb.Pointer{}
+		if err := proto.Unmarshal(oldPointerBytes, pointer); err != nil {
+			return nil, errs.New("error unmarshaling pointer: %v", err)
+		}
+		if !proto.Equal(pointer.GetCreationDate(), ref.GetCreationDate()) || pointer.GetRemote() == nil {
+			return nil, ErrPointerReplaced.New("%s", path)
+		}
+
+		var pieces []*pb.RemotePiece
+		for _, piece := range pointer.GetRemote().GetRemotePieces() {
+			if !containsPiece(toRemove, piece) {
+				pieces = append(pieces, piece)
+			}
+		}
+		for _, piece := range toAdd {
+			for _, existing := range pieces {
+				if existing.PieceNum == piece.PieceNum {
+					return nil, errs.New("piece %d already exists in %s", piece.PieceNum, path)
+				}
+			}
+			pieces = append(pieces, piece)
+		}
+		pointer.Remote.RemotePieces = pieces
+
+		newPointerBytes, err := proto.Marshal(pointer)
+		if err != nil {
+			return nil, err
+		}
+
+		err = s.DB.CompareAndSwap([]byte(path), oldPointerBytes, newPointerBytes)
+		if storage.ErrValueChanged.Has(err) {
+			// someone else updated the pointer in the meantime, try again
+			continue
+		}
+		if storage.ErrKeyNotFound.Has(err) {
+			return nil, ErrPointerReplaced.New("%s", path)
+		}
+		if err != nil {
+			return nil, err
+		}
+		return pointer, nil
+	}
+}
+
+// containsPiece returns whether pieces contains a piece with the same number and node as piece.
+func containsPiece(pieces []*pb.RemotePiece, piece *pb.RemotePiece) bool {
+	for _, p := range pieces {
+		if p.PieceNum == piece.PieceNum && p.NodeId == piece.NodeId {
+			return true
+		}
+	}
+	return false
+}
+
 // List returns all Path keys in the pointers bucket
 func (s *Service) List(prefix string, startAfter string, endBefore string, recursive bool, limit int32,
 	metaFlags uint32) (items []*pb.ListResponse_Item, more bool, err error) {
@@ -14,6 +14,8 @@ import (
 	"storj.io/storj/internal/testcontext"
 	"storj.io/storj/internal/testplanet"
 	"storj.io/storj/pkg/storj"
+	"storj.io/storj/storagenode"
+	snorders "storj.io/storj/storagenode/orders"
 	"storj.io/storj/uplink"
 )
 
@@ -169,6 +171,66 @@ func TestUploadDownloadBandwidth(t *testing.T) {
 	})
 }
 
+func TestSettlementBatchesAndRejections(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 6, UplinkCount: 1,
+		Reconfigure: testplanet.Reconfigure{
+			StorageNode: func(index int, config *storagenode.Config) {
+				config.Storage2.Sender.BatchSize = 1
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		planet.Satellites[0].Audit.Service.Loop.Stop()
+		for _, storageNode := range planet.StorageNodes {
+			storageNode.Storage2.Sender.Loop.Pause()
+		}
+
+		expectedData := make([]byte, 50*memory.KiB)
+		_, err := rand.Read(expectedData)
+		require.NoError(t, err)
+
+		err = planet.Uplinks[0].Upload(ctx, planet.Satellites[0], "testbucket", "test/path", expectedData)
+		require.NoError(t, err)
+
+		for _, storageNode := range planet.StorageNodes {
+			ordersDB := storageNode.DB.Orders()
+
+			unsent, err := ordersDB.ListUnsent(ctx, 100)
+			require.NoError(t, err)
+			if len(unsent) == 0 {
+				// the node wasn't used due to long tail cancellation
+				continue
+			}
+
+			// enqueue an order with a serial number which wasn't signed by the satellite
+			limit, order := *unsent[0].Limit, *unsent[0].Order
+			_, err = rand.Read(limit.SerialNumber[:])
+			require.NoError(t, err)
+			order.SerialNumber = limit.SerialNumber
+			require.NoError(t, ordersDB.Enqueue(ctx, &snorders.Info{
+				Limit:  &limit,
+				Order:  &order,
+				Uplink: unsent[0].Uplink,
+			}))
+
+			storageNode.Storage2.Sender.Loop.TriggerWait()
+
+			counts, err := ordersDB.CountByStatus(ctx)
+			require.NoError(t, err)
+			require.Zero(t, counts[snorders.StatusUnsent])
+			require.Equal(t, int64(len(unsent)), counts[snorders.StatusAccepted])
+			require.Equal(t, int64(1), counts[snorders.StatusRejected])
+
+			reasons, err := ordersDB.CountRejectReasons(ctx)
+			require.NoError(t, err)
+			require.Len(t, reasons, 1)
+			for reason := range reasons {
+				require.Contains(t, reason, "unable to verify order limit")
+			}
+		}
+	})
+}
+
 func noLongTailRedundancy(planet *testplanet.Planet) uplink.RSConfig {
 	redundancy := planet.Uplinks[0].GetConfig(planet.Satellites[0]).RS
 	redundancy.SuccessThreshold = redundancy.MaxThreshold
@@ -311,8 +311,9 @@ func (service *Service) CreateDeleteOrderLimits(ctx context.Context, uplink *ide
 	return limits, nil
 }
 
-// CreateAuditOrderLimits creates the order limits for auditing the pieces of pointer.
-func (service *Service) CreateAuditOrderLimits(ctx context.Context, auditor *identity.PeerIdentity, bucketID []byte, pointer *pb.Pointer) (_ []*pb.AddressedOrderLimit, err error) {
+// CreateAuditOrderLimits creates the order limits for auditing the pieces of pointer,
+// leaving out the pieces stored on the nodes in skip.
+func (service *Service) CreateAuditOrderLimits(ctx context.Context, auditor *identity.PeerIdentity, bucketID []byte, pointer *pb.Pointer, skip map[storj.NodeID]bool) (_ []*pb.AddressedOrderLimit, err error) {
 	rootPieceID := pointer.GetRemote().RootPieceId
 	redundancy := pointer.GetRemote().GetRedundancy()
 	shareSize := redundancy.GetErasureShareSize()
@@ -335,6 +336,10 @@ func (service *Service) CreateAuditOrderLimits(ctx context.Context, auditor *ide
 	var limitsCount int32
 	limits := make([]*pb.AddressedOrderLimit, totalPieces)
 	for _, piece := range pointer.GetRemote().GetRemotePieces() {
+		if skip[piece.NodeId] {
+			continue
+		}
+
 		node, err := service.cache.Get(ctx, piece.NodeId)
 		if err != nil {
 			service.log.Error("error getting node from the overlay cache", zap.Error(err))
@@ -391,6 +396,64 @@ func (service *Service) CreateAuditOrderLimits(ctx context.Context, auditor *ide
 	return limits, nil
 }
 
+// CreateAuditOrderLimit creates an order limit for auditing a single piece of a segment.
+func (service *Service) CreateAuditOrderLimit(ctx context.Context, auditor *identity.PeerIdentity, bucketID []byte, nodeID storj.NodeID, pieceNum int32, rootPieceID storj.PieceID, shareSize int32) (limit *pb.AddressedOrderLimit, err error) {
+	// convert orderExpiration from duration to timestamp
+	orderExpirationTime := time.Now().UTC().Add(service.orderExpiration)
+	orderExpiration, err := ptypes.TimestampProto(orderExpirationTime)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	serialNumber, err := service.createSerial(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	node, err := service.cache.Get(ctx, nodeID)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	if node != nil {
+		node.Type.DPanicOnInvalid("order service audit order limit")
+	}
+
+	if !service.cache.IsOnline(node) {
+		return nil, overlay.ErrNodeOffline.New("%v", nodeID)
+	}
+
+	orderLimit, err := signing.SignOrderLimit(service.satellite, &pb.OrderLimit2{
+		SerialNumber:    serialNumber,
+		SatelliteId:     service.satellite.ID(),
+		UplinkId:        auditor.ID,
+		StorageNodeId:   nodeID,
+		PieceId:         rootPieceID.Derive(nodeID),
+		Action:          pb.PieceAction_GET_AUDIT,
+		Limit:           int64(shareSize),
+		OrderExpiration: orderExpiration,
+	})
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	limit = &pb.AddressedOrderLimit{
+		Limit:              orderLimit,
+		StorageNodeAddress: node.Address,
+	}
+
+	err = service.saveSerial(ctx, serialNumber, bucketID, orderExpirationTime)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	if err := service.updateBandwidth(ctx, bucketID, []*pb.AddressedOrderLimit{limit}); err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	return limit, nil
+}
+
 // CreateGetRepairOrderLimits creates the order limits for downloading the healthy pieces of pointer as the source for repair.
 func (service *Service) CreateGetRepairOrderLimits(ctx context.Context, repairer *identity.PeerIdentity, bucketID []byte, pointer *pb.Pointer, healthy []*pb.RemotePiece) (_ []*pb.AddressedOrderLimit, err error) {
 	rootPieceID := pointer.GetRemote().RootPieceId
@@ -542,6 +605,60 @@ func (service *Service) CreatePutRepairOrderLimits(ctx context.Context, repairer
 	return limits, nil
 }
 
+// CreateGracefulExitPutOrderLimit creates an order limit for uploading the piece pieceNum of the pointer
+// from an exiting storage node to a new node.
+func (service *Service) CreateGracefulExitPutOrderLimit(ctx context.Context, exiting storj.NodeID, bucketID []byte, pointer *pb.Pointer, newNode *pb.Node) (_ *pb.AddressedOrderLimit, err error) {
+	rootPieceID := pointer.GetRemote().RootPieceId
+	redundancy, err := eestream.NewRedundancyStrategyFromProto(pointer.GetRemote().GetRedundancy())
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	pieceSize := eestream.CalcPieceSize(pointer.GetSegmentSize(), redundancy)
+
+	// convert orderExpiration from duration to timestamp
+	orderExpirationTime := time.Now().UTC().Add(service.orderExpiration)
+	orderExpiration, err := ptypes.TimestampProto(orderExpirationTime)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	serialNumber, err := service.createSerial(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	orderLimit, err := signing.SignOrderLimit(service.satellite, &pb.OrderLimit2{
+		SerialNumber:    serialNumber,
+		SatelliteId:     service.satellite.ID(),
+		UplinkId:        exiting,
+		StorageNodeId:   newNode.Id,
+		PieceId:         rootPieceID.Derive(newNode.Id),
+		Action:          pb.PieceAction_PUT_REPAIR,
+		Limit:           pieceSize,
+		PieceExpiration: pointer.ExpirationDate,
+		OrderExpiration: orderExpiration,
+	})
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	limit := &pb.AddressedOrderLimit{
+		Limit:              orderLimit,
+		StorageNodeAddress: newNode.Address,
+	}
+
+	err = service.saveSerial(ctx, serialNumber, bucketID, orderExpirationTime)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	if err := service.updateBandwidth(ctx, bucketID, []*pb.AddressedOrderLimit{limit}); err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	return limit, nil
+}
+
 // UpdateGetInlineOrder updates amount of inline GET bandwidth for given bucket
 func (service *Service) UpdateGetInlineOrder(ctx context.Context, bucketID []byte, amount int64) (err error) {
 	now := time.Now().UTC()
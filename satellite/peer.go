@@ -46,11 +46,15 @@ import (
 	"storj.io/storj/satellite/console"
 	"storj.io/storj/satellite/console/consoleauth"
 	"storj.io/storj/satellite/console/consoleweb"
+	"storj.io/storj/satellite/contact"
+	"storj.io/storj/satellite/gc"
+	"storj.io/storj/satellite/gracefulexit"
 	"storj.io/storj/satellite/inspector"
 	"storj.io/storj/satellite/mailservice"
 	"storj.io/storj/satellite/mailservice/simulate"
 	"storj.io/storj/satellite/metainfo"
 	"storj.io/storj/satellite/orders"
+	"storj.io/storj/satellite/piecedeletion"
 	"storj.io/storj/storage"
 	"storj.io/storj/storage/boltdb"
 )
@@ -81,8 +85,12 @@ type DB interface {
 	RepairQueue() queue.RepairQueue
 	// Irreparable returns database for failed repairs
 	Irreparable() irreparable.DB
+	// GracefulExit returns database for graceful exit progress
+	GracefulExit() gracefulexit.DB
 	// Console returns database for satellite console
 	Console() console.DB
+	// Containment returns database for storing pending audit info
+	Containment() audit.Containment
 	// Orders returns database for orders
 	Orders() orders.DB
 }
@@ -105,6 +113,11 @@ type Config struct {
 	Repairer repairer.Config
 	Audit    audit.Config
 
+	GarbageCollection gc.Config
+	PieceDeletion     piecedeletion.Config
+
+	GracefulExit gracefulexit.Config
+
 	Tally          tally.Config
 	Rollup         rollup.Config
 	LiveAccounting live.Config
@@ -147,6 +160,10 @@ type Peer struct {
 		Service *discovery.Discovery
 	}
 
+	Contact struct {
+		Endpoint *contact.Endpoint
+	}
+
 	Metainfo struct {
 		Database  storage.KeyValueStore // TODO: move into pointerDB
 		Service   *metainfo.Service
@@ -175,6 +192,18 @@ type Peer struct {
 		Service *audit.Service
 	}
 
+	GarbageCollection struct {
+		Service *gc.Service
+	}
+
+	PieceDeletion struct {
+		Service *piecedeletion.Service
+	}
+
+	GracefulExit struct {
+		Endpoint *gracefulexit.Endpoint
+	}
+
 	Accounting struct {
 		Tally  *tally.Service
 		Rollup *rollup.Service
@@ -304,6 +333,12 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, config *Config, ve
 		pb.RegisterKadInspectorServer(peer.Server.PrivateGRPC(), peer.Kademlia.Inspector)
 	}
 
+	{ // setup contact
+		log.Debug("Setting up contact")
+		peer.Contact.Endpoint = contact.NewEndpoint(peer.Log.Named("contact"), peer.Transport, peer.Overlay.Service)
+		pb.RegisterNodeServer(peer.Server.GRPC(), peer.Contact.Endpoint)
+	}
+
 	{ // setup discovery
 		log.Debug("Setting up discovery")
 		config := config.Discovery
@@ -340,6 +375,17 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, config *Config, ve
 		pb.RegisterOrdersServer(peer.Server.GRPC(), peer.Orders.Endpoint)
 	}
 
+	{ // setup piece deletion
+		log.Debug("Setting up piece deletion")
+		peer.PieceDeletion.Service = piecedeletion.NewService(
+			peer.Log.Named("piece deletion"),
+			config.PieceDeletion,
+			signing.SignerFromFullIdentity(peer.Identity),
+			peer.Transport,
+			peer.Overlay.Service,
+		)
+	}
+
 	{ // setup metainfo
 		log.Debug("Setting up metainfo")
 		db, err := metainfo.NewStore(peer.Log.Named("metainfo:store"), config.Metainfo.DatabaseURL)
@@ -359,6 +405,7 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, config *Config, ve
 			peer.DB.StoragenodeAccounting(),
 			peer.DB.ProjectAccounting(),
 			peer.LiveAccounting.Service,
+			peer.PieceDeletion.Service,
 			config.Rollup.MaxAlphaUsage,
 		)
 
@@ -407,6 +454,7 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, config *Config, ve
 			peer.Orders.Service,
 			peer.Transport,
 			peer.Overlay.Service,
+			peer.DB.Containment(),
 			peer.Identity,
 		)
 		if err != nil {
@@ -414,6 +462,34 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, config *Config, ve
 		}
 	}
 
+	{ // setup garbage collection
+		log.Debug("Setting up garbage collection")
+		peer.GarbageCollection.Service = gc.NewService(
+			peer.Log.Named("garbage collection"),
+			config.GarbageCollection,
+			signing.SignerFromFullIdentity(peer.Identity),
+			peer.Transport,
+			peer.Overlay.Service,
+			peer.Metainfo.Service,
+		)
+	}
+
+	{ // setup graceful exit
+		log.Debug("Setting up graceful exit")
+		peer.GracefulExit.Endpoint = gracefulexit.NewEndpoint(
+			peer.Log.Named("gracefulexit"),
+			signing.SigneeFromPeerIdentity(peer.Identity.PeerIdentity()),
+			peer.DB.GracefulExit(),
+			peer.Overlay.Service,
+			peer.Kademlia.Service,
+			peer.Metainfo.Service,
+			peer.Orders.Service,
+			peer.DB.CertDB(),
+			config.GracefulExit,
+		)
+		pb.RegisterSatelliteGracefulExitServer(peer.Server.GRPC(), peer.GracefulExit.Endpoint)
+	}
+
 	{ // setup accounting
 		log.Debug("Setting up accounting")
 		peer.Accounting.Tally = tally.New(peer.Log.Named("tally"), peer.DB.StoragenodeAccounting(), peer.DB.ProjectAccounting(), peer.LiveAccounting.Service, peer.Metainfo.Service, peer.Overlay.Service, 0, config.Tally.Interval)
@@ -563,6 +639,12 @@ func (peer *Peer) Run(ctx context.Context) error {
 	group.Go(func() error {
 		return errs2.IgnoreCanceled(peer.Audit.Service.Run(ctx))
 	})
+	group.Go(func() error {
+		return errs2.IgnoreCanceled(peer.GarbageCollection.Service.Run(ctx))
+	})
+	group.Go(func() error {
+		return errs2.IgnoreCanceled(peer.PieceDeletion.Service.Run(ctx))
+	})
 	group.Go(func() error {
 		// TODO: move the message into Server instead
 		// Don't change the format of this comment, it is used to figure out the node id.
@@ -602,6 +684,13 @@ func (peer *Peer) Close() error {
 	}
 
 	// close services in reverse initialization order
+	if peer.GarbageCollection.Service != nil {
+		errlist.Add(peer.GarbageCollection.Service.Close())
+	}
+	if peer.PieceDeletion.Service != nil {
+		errlist.Add(peer.PieceDeletion.Service.Close())
+	}
+
 	if peer.Repair.Repairer != nil {
 		errlist.Add(peer.Repair.Repairer.Close())
 	}
@@ -0,0 +1,215 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package piecedeletion implements deleting the pieces of deleted segments
+// directly from the storage nodes, batching the pieces of each node.
+package piecedeletion
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+	monkit "gopkg.in/spacemonkeygo/monkit.v2"
+
+	"storj.io/storj/internal/sync2"
+	"storj.io/storj/pkg/auth/signing"
+	"storj.io/storj/pkg/overlay"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/pkg/transport"
+	"storj.io/storj/uplink/piecestore"
+)
+
+var (
+	// Error defines the piece deletion service errors class
+	Error = errs.Class("piece deletion")
+
+	mon = monkit.Package()
+)
+
+// Config contains configurable values for deleting pieces from the storage nodes.
+type Config struct {
+	Enabled         bool          `help:"delete the pieces of deleted segments from the storage nodes by the satellite instead of the uplink" releaseDefault:"false" devDefault:"true"`
+	Interval        time.Duration `help:"how frequently the queued pieces are sent to the storage nodes" default:"30s"`
+	BatchSize       int           `help:"maximum number of pieces in a single delete request, a node is sent its pieces as soon as a batch is full" default:"1000"`
+	MaxQueued       int           `help:"maximum number of pieces queued for a single node, the rest are left for garbage collection" default:"100000"`
+	ConcurrentSends int           `help:"the number of nodes to concurrently send delete requests to" default:"10"`
+	Expiration      time.Duration `help:"how long the storage nodes accept a delete request" default:"1h0m0s"`
+}
+
+// Service queues the pieces of deleted segments and sends them to the storage
+// nodes in batches, signed by the satellite.
+type Service struct {
+	log    *zap.Logger
+	config Config
+	Loop   sync2.Cycle
+
+	signer    signing.Signer
+	transport transport.Client
+	overlay   *overlay.Cache
+
+	mu     sync.Mutex
+	queued map[storj.NodeID][]storj.PieceID
+}
+
+// NewService creates a new piece deletion service.
+func NewService(log *zap.Logger, config Config, signer signing.Signer, transport transport.Client, overlay *overlay.Cache) *Service {
+	return &Service{
+		log:    log,
+		config: config,
+		Loop:   *sync2.NewCycle(config.Interval),
+
+		signer:    signer,
+		transport: transport,
+		overlay:   overlay,
+
+		queued: map[storj.NodeID][]storj.PieceID{},
+	}
+}
+
+// Enabled returns whether the satellite deletes the pieces instead of the uplink.
+func (service *Service) Enabled() bool {
+	return service.config.Enabled
+}
+
+// Run starts sending the queued pieces.
+func (service *Service) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if !service.config.Enabled {
+		return nil
+	}
+
+	return service.Loop.Run(ctx, func(ctx context.Context) error {
+		service.Send(ctx)
+		return nil
+	})
+}
+
+// Close stops the piece deletion service.
+func (service *Service) Close() error {
+	service.Loop.Close()
+	return nil
+}
+
+// DeletePointer queues the pieces of the remote segment for deletion.
+func (service *Service) DeletePointer(ctx context.Context, pointer *pb.Pointer) {
+	defer mon.Task()(&ctx)(nil)
+
+	remote := pointer.GetRemote()
+	if remote == nil {
+		return
+	}
+
+	service.mu.Lock()
+	defer service.mu.Unlock()
+
+	batchFull := false
+	for _, piece := range remote.GetRemotePieces() {
+		queued := service.queued[piece.NodeId]
+		if len(queued) >= service.config.MaxQueued {
+			mon.Meter("delete_queue_full").Mark(1)
+			continue
+		}
+		queued = append(queued, remote.RootPieceId.Derive(piece.NodeId))
+		service.queued[piece.NodeId] = queued
+		batchFull = batchFull || len(queued) >= service.config.BatchSize
+	}
+
+	if batchFull {
+		service.Loop.Trigger()
+	}
+}
+
+// Send sends the queued pieces to the storage nodes, the pieces
+// which couldn't be sent are left for garbage collection.
+func (service *Service) Send(ctx context.Context) {
+	defer mon.Task()(&ctx)(nil)
+
+	service.mu.Lock()
+	queued := service.queued
+	service.queued = map[storj.NodeID][]storj.PieceID{}
+	service.mu.Unlock()
+
+	concurrentSends := service.config.ConcurrentSends
+	if concurrentSends < 1 {
+		concurrentSends = 1
+	}
+	limiter := make(chan struct{}, concurrentSends)
+
+	var group errgroup.Group
+	for nodeID, pieceIDs := range queued {
+		nodeID, pieceIDs := nodeID, pieceIDs
+		limiter <- struct{}{}
+		group.Go(func() error {
+			defer func() { <-limiter }()
+
+			err := service.sendDeleteRequests(ctx, nodeID, pieceIDs)
+			if err != nil {
+				service.log.Error("error sending delete requests", zap.Stringer("node", nodeID), zap.Int("pieces", len(pieceIDs)), zap.Error(err))
+			}
+			return nil
+		})
+	}
+	_ = group.Wait() // doesn't return errors
+}
+
+// sendDeleteRequests sends the pieces to a single storage node in batches.
+func (service *Service) sendDeleteRequests(ctx context.Context, nodeID storj.NodeID, pieceIDs []storj.PieceID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	dossier, err := service.overlay.Get(ctx, nodeID)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	conn, err := service.transport.DialNode(ctx, &dossier.Node)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	client := piecestore.NewClient(service.log.Named("piecestore"), service.signer, conn, piecestore.DefaultConfig)
+	defer func() { err = errs.Combine(err, Error.Wrap(client.Close())) }()
+
+	batchSize := service.config.BatchSize
+	if batchSize < 1 {
+		batchSize = 1
+	}
+
+	for len(pieceIDs) > 0 {
+		batch := pieceIDs
+		if len(batch) > batchSize {
+			batch = batch[:batchSize]
+		}
+		pieceIDs = pieceIDs[len(batch):]
+
+		expiration, err := ptypes.TimestampProto(time.Now().Add(service.config.Expiration))
+		if err != nil {
+			return Error.Wrap(err)
+		}
+
+		req, err := signing.SignDeletePiecesRequest(service.signer, &pb.DeletePiecesRequest{
+			SatelliteId:   service.signer.ID(),
+			StorageNodeId: nodeID,
+			PieceIds:      batch,
+			Expiration:    expiration,
+		})
+		if err != nil {
+			return Error.Wrap(err)
+		}
+
+		queued, err := client.DeletePieces(ctx, req)
+		if err != nil {
+			return Error.Wrap(err)
+		}
+		if dropped := int64(len(batch)) - queued; dropped > 0 {
+			service.log.Debug("storage node dropped pieces from deletion", zap.Stringer("node", nodeID), zap.Int64("dropped", dropped))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,92 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package piecedeletion_test
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"storj.io/storj/internal/memory"
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/testplanet"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/satellite"
+	"storj.io/storj/storage"
+)
+
+// TestDeletePieces does the following:
+// * Upload a segment to the storage nodes
+// * Delete the object, the uplink doesn't delete any pieces
+// * Send the queued pieces from the satellite
+// * Check that the storage nodes deleted the pieces of the segment
+func TestDeletePieces(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.PieceDeletion.Enabled = true
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		satellite.PieceDeletion.Service.Loop.Pause()
+
+		testData := make([]byte, 100*memory.KiB)
+		_, err := rand.Read(testData)
+		require.NoError(t, err)
+
+		err = planet.Uplinks[0].Upload(ctx, satellite, "testbucket", "test/path", testData)
+		require.NoError(t, err)
+
+		var pointer *pb.Pointer
+		err = satellite.Metainfo.Service.Iterate("", "", true, false, func(it storage.Iterator) error {
+			var item storage.ListItem
+			for it.Next(&item) {
+				pointer = &pb.Pointer{}
+				if err := proto.Unmarshal(item.Value, pointer); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		require.NoError(t, err)
+		require.NotNil(t, pointer.GetRemote())
+
+		remote := pointer.GetRemote()
+		hasPiece := func(piece *pb.RemotePiece) bool {
+			for _, node := range planet.StorageNodes {
+				if node.ID() == piece.NodeId {
+					_, err := node.DB.PieceInfo().Get(ctx, satellite.ID(), remote.RootPieceId.Derive(piece.NodeId))
+					return err == nil
+				}
+			}
+			t.Fatalf("unknown node %v", piece.NodeId)
+			return false
+		}
+
+		err = planet.Uplinks[0].Delete(ctx, satellite, "testbucket", "test/path")
+		require.NoError(t, err)
+
+		// the pieces are deleted only after the satellite sends them
+		for _, piece := range remote.GetRemotePieces() {
+			require.True(t, hasPiece(piece))
+		}
+
+		satellite.PieceDeletion.Service.Send(ctx)
+
+		// the storage nodes delete the queued pieces in the background
+		for _, piece := range remote.GetRemotePieces() {
+			deadline := time.Now().Add(10 * time.Second)
+			for hasPiece(piece) {
+				require.True(t, time.Now().Before(deadline), "piece not deleted from %v", piece.NodeId)
+				time.Sleep(10 * time.Millisecond)
+			}
+		}
+	})
+}
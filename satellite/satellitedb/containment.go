@@ -0,0 +1,139 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/pkg/audit"
+	"storj.io/storj/pkg/storj"
+	dbx "storj.io/storj/satellite/satellitedb/dbx"
+)
+
+type containment struct {
+	db *dbx.DB
+}
+
+// Get gets the pending audit by node id
+func (containment *containment) Get(ctx context.Context, nodeID storj.NodeID) (_ *audit.PendingAudit, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	pending, err := containment.db.Get_PendingAudits_By_NodeId(ctx, dbx.PendingAudits_NodeId(nodeID.Bytes()))
+	if err == sql.ErrNoRows {
+		return nil, audit.ErrContainedNotFound.New("%v", nodeID)
+	}
+	if err != nil {
+		return nil, audit.ContainError.Wrap(err)
+	}
+
+	return convertDBPending(pending)
+}
+
+// IncrementPending creates a new pending audit entry, or increases its reverify count if it already exists
+func (containment *containment) IncrementPending(ctx context.Context, pendingAudit *audit.PendingAudit) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	tx, err := containment.db.Open(ctx)
+	if err != nil {
+		return audit.ContainError.Wrap(err)
+	}
+
+	existing, err := tx.Get_PendingAudits_By_NodeId(ctx, dbx.PendingAudits_NodeId(pendingAudit.NodeID.Bytes()))
+	switch err {
+	case sql.ErrNoRows:
+		_, err = tx.Create_PendingAudits(
+			ctx,
+			dbx.PendingAudits_NodeId(pendingAudit.NodeID.Bytes()),
+			dbx.PendingAudits_PieceId(pendingAudit.PieceID.Bytes()),
+			dbx.PendingAudits_StripeIndex(pendingAudit.StripeIndex),
+			dbx.PendingAudits_ShareSize(int64(pendingAudit.ShareSize)),
+			dbx.PendingAudits_ExpectedShareHash(pendingAudit.ExpectedShareHash),
+			dbx.PendingAudits_ReverifyCount(0),
+			dbx.PendingAudits_Path([]byte(pendingAudit.Path)),
+		)
+		if err != nil {
+			return audit.ContainError.Wrap(errs.Combine(err, tx.Rollback()))
+		}
+
+		_, err = tx.Update_Node_By_Id(ctx, dbx.Node_Id(pendingAudit.NodeID.Bytes()), dbx.Node_Update_Fields{
+			Contained: dbx.Node_Contained(true),
+		})
+		if err != nil {
+			return audit.ContainError.Wrap(errs.Combine(err, tx.Rollback()))
+		}
+	case nil:
+		if !bytes.Equal(existing.ExpectedShareHash, pendingAudit.ExpectedShareHash) ||
+			existing.StripeIndex != pendingAudit.StripeIndex ||
+			!bytes.Equal(existing.PieceId, pendingAudit.PieceID.Bytes()) {
+			return errs.Combine(audit.ErrAlreadyExists.New("%v", pendingAudit.NodeID), tx.Rollback())
+		}
+
+		_, err = tx.Update_PendingAudits_By_NodeId(ctx, dbx.PendingAudits_NodeId(pendingAudit.NodeID.Bytes()), dbx.PendingAudits_Update_Fields{
+			ReverifyCount: dbx.PendingAudits_ReverifyCount(existing.ReverifyCount + 1),
+		})
+		if err != nil {
+			return audit.ContainError.Wrap(errs.Combine(err, tx.Rollback()))
+		}
+	default:
+		return audit.ContainError.Wrap(errs.Combine(err, tx.Rollback()))
+	}
+
+	return audit.ContainError.Wrap(tx.Commit())
+}
+
+// Delete deletes the pending audit and releases the node from containment
+func (containment *containment) Delete(ctx context.Context, nodeID storj.NodeID) (_ bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	tx, err := containment.db.Open(ctx)
+	if err != nil {
+		return false, audit.ContainError.Wrap(err)
+	}
+
+	isDeleted, err := tx.Delete_PendingAudits_By_NodeId(ctx, dbx.PendingAudits_NodeId(nodeID.Bytes()))
+	if err != nil {
+		return false, audit.ContainError.Wrap(errs.Combine(err, tx.Rollback()))
+	}
+
+	if isDeleted {
+		_, err = tx.Update_Node_By_Id(ctx, dbx.Node_Id(nodeID.Bytes()), dbx.Node_Update_Fields{
+			Contained: dbx.Node_Contained(false),
+		})
+		if err != nil {
+			return false, audit.ContainError.Wrap(errs.Combine(err, tx.Rollback()))
+		}
+	}
+
+	return isDeleted, audit.ContainError.Wrap(tx.Commit())
+}
+
+func convertDBPending(info *dbx.PendingAudits) (*audit.PendingAudit, error) {
+	if info == nil {
+		return nil, Error.New("missing info")
+	}
+
+	nodeID, err := storj.NodeIDFromBytes(info.NodeId)
+	if err != nil {
+		return nil, audit.ContainError.Wrap(err)
+	}
+
+	pieceID, err := storj.PieceIDFromBytes(info.PieceId)
+	if err != nil {
+		return nil, audit.ContainError.Wrap(err)
+	}
+
+	return &audit.PendingAudit{
+		NodeID:            nodeID,
+		PieceID:           pieceID,
+		StripeIndex:       info.StripeIndex,
+		ShareSize:         int32(info.ShareSize),
+		ExpectedShareHash: info.ExpectedShareHash,
+		ReverifyCount:     int32(info.ReverifyCount),
+		Path:              storj.Path(info.Path),
+	}, nil
+}
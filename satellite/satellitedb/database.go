@@ -10,6 +10,7 @@ import (
 	"storj.io/storj/internal/dbutil"
 	"storj.io/storj/internal/dbutil/pgutil"
 	"storj.io/storj/pkg/accounting"
+	"storj.io/storj/pkg/audit"
 	"storj.io/storj/pkg/bwagreement"
 	"storj.io/storj/pkg/certdb"
 	"storj.io/storj/pkg/datarepair/irreparable"
@@ -17,6 +18,7 @@ import (
 	"storj.io/storj/pkg/overlay"
 	"storj.io/storj/satellite"
 	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/gracefulexit"
 	"storj.io/storj/satellite/orders"
 	dbx "storj.io/storj/satellite/satellitedb/dbx"
 )
@@ -128,6 +130,16 @@ func (db *DB) ProjectAccounting() accounting.ProjectAccounting {
 	return &ProjectAccounting{db: db.db}
 }
 
+// Containment returns database for storing pending audit info
+func (db *DB) Containment() audit.Containment {
+	return &containment{db: db.db}
+}
+
+// GracefulExit returns database for graceful exit progress
+func (db *DB) GracefulExit() gracefulexit.DB {
+	return &gracefulexitDB{db: db.db}
+}
+
 // Irreparable returns database for storing segments that failed repair
 func (db *DB) Irreparable() irreparable.DB {
 	return &irreparableDB{db: db.db}
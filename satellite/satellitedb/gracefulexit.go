@@ -0,0 +1,59 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/satellite/gracefulexit"
+	dbx "storj.io/storj/satellite/satellitedb/dbx"
+)
+
+type gracefulexitDB struct {
+	db *dbx.DB
+}
+
+// IncrementProgress increments the transferred bytes and the transferred and failed piece counts of a node.
+func (db *gracefulexitDB) IncrementProgress(ctx context.Context, nodeID storj.NodeID, bytes, successfulTransfers, failedTransfers int64) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	statement := db.db.Rebind(
+		`INSERT INTO graceful_exit_progress (node_id, bytes_transferred, pieces_transferred, pieces_failed, updated_at)
+		VALUES (?, ?, ?, ?, ?)
+		ON CONFLICT(node_id)
+		DO UPDATE SET
+			bytes_transferred = graceful_exit_progress.bytes_transferred + excluded.bytes_transferred,
+			pieces_transferred = graceful_exit_progress.pieces_transferred + excluded.pieces_transferred,
+			pieces_failed = graceful_exit_progress.pieces_failed + excluded.pieces_failed,
+			updated_at = excluded.updated_at`,
+	)
+	_, err = db.db.ExecContext(ctx, statement,
+		nodeID.Bytes(), bytes, successfulTransfers, failedTransfers, time.Now().UTC(),
+	)
+	return Error.Wrap(err)
+}
+
+// GetProgress gets the transfer progress of a node.
+func (db *gracefulexitDB) GetProgress(ctx context.Context, nodeID storj.NodeID) (_ *gracefulexit.Progress, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	dbxProgress, err := db.db.Get_GracefulExitProgress_By_NodeId(ctx, dbx.GracefulExitProgress_NodeId(nodeID.Bytes()))
+	if err == sql.ErrNoRows {
+		return &gracefulexit.Progress{NodeID: nodeID}, nil
+	}
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	return &gracefulexit.Progress{
+		NodeID:            nodeID,
+		BytesTransferred:  dbxProgress.BytesTransferred,
+		PiecesTransferred: dbxProgress.PiecesTransferred,
+		PiecesFailed:      dbxProgress.PiecesFailed,
+		UpdatedAt:         dbxProgress.UpdatedAt,
+	}, nil
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/skyrings/skyring-common/tools/uuid"
 
 	"storj.io/storj/pkg/accounting"
+	"storj.io/storj/pkg/audit"
 	"storj.io/storj/pkg/bwagreement"
 	"storj.io/storj/pkg/certdb"
 	"storj.io/storj/pkg/datarepair/irreparable"
@@ -23,6 +24,7 @@ import (
 	"storj.io/storj/pkg/storj"
 	"storj.io/storj/satellite"
 	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/gracefulexit"
 	"storj.io/storj/satellite/orders"
 )
 
@@ -481,6 +483,40 @@ func (m *lockedUsers) Update(ctx context.Context, user *console.User) error {
 	return m.db.Update(ctx, user)
 }
 
+// Containment returns database for storing pending audit info
+func (m *locked) Containment() audit.Containment {
+	m.Lock()
+	defer m.Unlock()
+	return &lockedContainment{m.Locker, m.db.Containment()}
+}
+
+// lockedContainment implements locking wrapper for audit.Containment
+type lockedContainment struct {
+	sync.Locker
+	db audit.Containment
+}
+
+// Delete removes the pending audit of the node and releases it from containment
+func (m *lockedContainment) Delete(ctx context.Context, nodeID storj.NodeID) (bool, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.Delete(ctx, nodeID)
+}
+
+// Get returns the pending audit for the node
+func (m *lockedContainment) Get(ctx context.Context, nodeID storj.NodeID) (*audit.PendingAudit, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.Get(ctx, nodeID)
+}
+
+// IncrementPending creates a pending audit for the node and marks it as contained, or increments the reverify count of the node's existing pending audit
+func (m *lockedContainment) IncrementPending(ctx context.Context, pendingAudit *audit.PendingAudit) error {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.IncrementPending(ctx, pendingAudit)
+}
+
 // CreateSchema sets the schema
 func (m *locked) CreateSchema(schema string) error {
 	m.Lock()
@@ -502,6 +538,33 @@ func (m *locked) DropSchema(schema string) error {
 	return m.db.DropSchema(schema)
 }
 
+// GracefulExit returns database for graceful exit progress
+func (m *locked) GracefulExit() gracefulexit.DB {
+	m.Lock()
+	defer m.Unlock()
+	return &lockedGracefulExit{m.Locker, m.db.GracefulExit()}
+}
+
+// lockedGracefulExit implements locking wrapper for gracefulexit.DB
+type lockedGracefulExit struct {
+	sync.Locker
+	db gracefulexit.DB
+}
+
+// GetProgress gets the transfer progress of a node, the progress is empty when nothing has been transferred yet.
+func (m *lockedGracefulExit) GetProgress(ctx context.Context, nodeID storj.NodeID) (*gracefulexit.Progress, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.GetProgress(ctx, nodeID)
+}
+
+// IncrementProgress increments the transferred bytes and the transferred and failed piece counts of a node.
+func (m *lockedGracefulExit) IncrementProgress(ctx context.Context, nodeID storj.NodeID, bytes int64, successfulTransfers int64, failedTransfers int64) error {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.IncrementProgress(ctx, nodeID, bytes, successfulTransfers, failedTransfers)
+}
+
 // Irreparable returns database for failed repairs
 func (m *locked) Irreparable() irreparable.DB {
 	m.Lock()
@@ -646,6 +709,20 @@ func (m *lockedOverlayCache) CreateStats(ctx context.Context, nodeID storj.NodeI
 	return m.db.CreateStats(ctx, nodeID, initial)
 }
 
+// DisqualifiedNodes returns the disqualified nodes, the most recently disqualified first.
+func (m *lockedOverlayCache) DisqualifiedNodes(ctx context.Context, limit int) ([]*overlay.DisqualifiedNode, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.DisqualifiedNodes(ctx, limit)
+}
+
+// DisqualifyNode disqualifies the node, nodes which are already disqualified are left unchanged.
+func (m *lockedOverlayCache) DisqualifyNode(ctx context.Context, nodeID storj.NodeID, disqualifiedAt time.Time) error {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.DisqualifyNode(ctx, nodeID, disqualifiedAt)
+}
+
 // Get looks up the node by nodeID
 func (m *lockedOverlayCache) Get(ctx context.Context, nodeID storj.NodeID) (*overlay.NodeDossier, error) {
 	m.Lock()
@@ -660,6 +737,13 @@ func (m *lockedOverlayCache) KnownUnreliableOrOffline(ctx context.Context, a1 *o
 	return m.db.KnownUnreliableOrOffline(ctx, a1, a2)
 }
 
+// KnownUnvetted filters a set of nodes to the nodes which aren't vetted yet.
+func (m *lockedOverlayCache) KnownUnvetted(ctx context.Context, nodeIDs storj.NodeIDList) (storj.NodeIDList, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.KnownUnvetted(ctx, nodeIDs)
+}
+
 // Paginate will page through the database nodes
 func (m *lockedOverlayCache) Paginate(ctx context.Context, offset int64, limit int) ([]*overlay.NodeDossier, bool, error) {
 	m.Lock()
@@ -681,11 +765,25 @@ func (m *lockedOverlayCache) SelectStorageNodes(ctx context.Context, count int,
 	return m.db.SelectStorageNodes(ctx, count, criteria)
 }
 
+// UnvettedNodes returns the storage nodes which aren't vetted yet, the nodes closest to being vetted first.
+func (m *lockedOverlayCache) UnvettedNodes(ctx context.Context, limit int) ([]*overlay.VettingStatus, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.UnvettedNodes(ctx, limit)
+}
+
 // Update updates node address
-func (m *lockedOverlayCache) UpdateAddress(ctx context.Context, value *pb.Node) error {
+func (m *lockedOverlayCache) UpdateAddress(ctx context.Context, value *pb.Node, lastNet string, defaults overlay.NodeSelectionConfig) error {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.UpdateAddress(ctx, value, lastNet, defaults)
+}
+
+// UpdateExitStatus updates a single storagenode's graceful exit status.
+func (m *lockedOverlayCache) UpdateExitStatus(ctx context.Context, request *overlay.ExitStatusRequest) (stats *overlay.NodeDossier, err error) {
 	m.Lock()
 	defer m.Unlock()
-	return m.db.UpdateAddress(ctx, value)
+	return m.db.UpdateExitStatus(ctx, request)
 }
 
 // UpdateNodeInfo updates node dossier with info requested from the node itself like node type, email, wallet, capacity, and version.
@@ -703,10 +801,17 @@ func (m *lockedOverlayCache) UpdateStats(ctx context.Context, request *overlay.U
 }
 
 // UpdateUptime updates a single storagenode's uptime stats.
-func (m *lockedOverlayCache) UpdateUptime(ctx context.Context, nodeID storj.NodeID, isUp bool) (stats *overlay.NodeStats, err error) {
+func (m *lockedOverlayCache) UpdateUptime(ctx context.Context, nodeID storj.NodeID, isUp bool, lambda float64, weight float64) (stats *overlay.NodeStats, err error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.UpdateUptime(ctx, nodeID, isUp, lambda, weight)
+}
+
+// UpdateVetted marks the node as vetted, nodes which are already vetted are left unchanged.
+func (m *lockedOverlayCache) UpdateVetted(ctx context.Context, nodeID storj.NodeID, vettedAt time.Time) error {
 	m.Lock()
 	defer m.Unlock()
-	return m.db.UpdateUptime(ctx, nodeID, isUp)
+	return m.db.UpdateVetted(ctx, nodeID, vettedAt)
 }
 
 // ProjectAccounting returns database for storing information about project data use
@@ -663,6 +663,80 @@ func (db *DB) PostgresMigration() *migrate.Migration {
 					);`,
 				},
 			},
+			{
+				Description: "Adds graceful exit columns to nodes table, adds graceful_exit_progress table",
+				Version:     21,
+				Action: migrate.SQL{
+					`ALTER TABLE nodes ADD exit_initiated_at timestamp with time zone;
+					ALTER TABLE nodes ADD exit_finished_at timestamp with time zone;
+					ALTER TABLE nodes ADD exit_success boolean;
+					UPDATE nodes SET exit_success = false;
+					ALTER TABLE nodes ALTER COLUMN exit_success SET NOT NULL;`,
+
+					`CREATE TABLE graceful_exit_progress (
+						node_id bytea NOT NULL,
+						bytes_transferred bigint NOT NULL,
+						pieces_transferred bigint NOT NULL,
+						pieces_failed bigint NOT NULL,
+						updated_at timestamp with time zone NOT NULL,
+						PRIMARY KEY ( node_id )
+					);`,
+				},
+			},
+			{
+				Description: "Adds vetted_at column to nodes table, the nodes which have reached the default audit and uptime counts are vetted",
+				Version:     22,
+				Action: migrate.SQL{
+					`ALTER TABLE nodes ADD vetted_at timestamp with time zone;`,
+					`UPDATE nodes SET vetted_at = now() WHERE total_audit_count >= 500 AND total_uptime_count >= 500;`,
+				},
+			},
+			{
+				// the seeded counts are limited to weight/(1-lambda) of the default
+				// lambda and weight, 20 for audits and 100 for uptime checks
+				Description: "Adds reputation alpha and beta columns to nodes table, seeded from the audit and uptime counts",
+				Version:     23,
+				Action: migrate.SQL{
+					`ALTER TABLE nodes ADD audit_reputation_alpha double precision;
+					ALTER TABLE nodes ADD audit_reputation_beta double precision;
+					ALTER TABLE nodes ADD uptime_reputation_alpha double precision;
+					ALTER TABLE nodes ADD uptime_reputation_beta double precision;
+					UPDATE nodes SET
+						audit_reputation_alpha = (1 + audit_success_count) * LEAST(1, 20.0 / (1 + total_audit_count)),
+						audit_reputation_beta = (total_audit_count - audit_success_count) * LEAST(1, 20.0 / (1 + total_audit_count)),
+						uptime_reputation_alpha = (1 + uptime_success_count) * LEAST(1, 100.0 / (1 + total_uptime_count)),
+						uptime_reputation_beta = (total_uptime_count - uptime_success_count) * LEAST(1, 100.0 / (1 + total_uptime_count));
+					ALTER TABLE nodes ALTER COLUMN audit_reputation_alpha SET NOT NULL;
+					ALTER TABLE nodes ALTER COLUMN audit_reputation_beta SET NOT NULL;
+					ALTER TABLE nodes ALTER COLUMN uptime_reputation_alpha SET NOT NULL;
+					ALTER TABLE nodes ALTER COLUMN uptime_reputation_beta SET NOT NULL;`,
+				},
+			},
+			{
+				Description: "Adds disqualified column to nodes table",
+				Version:     24,
+				Action: migrate.SQL{
+					`ALTER TABLE nodes ADD disqualified timestamp with time zone;`,
+				},
+			},
+			{
+				Description: "Adds path column to pending_audits table",
+				Version:     25,
+				Action: migrate.SQL{
+					`ALTER TABLE pending_audits ADD path bytea;`,
+					`UPDATE pending_audits SET path = ''::bytea;`,
+					`ALTER TABLE pending_audits ALTER COLUMN path SET NOT NULL;`,
+				},
+			},
+			{
+				Description: "Adds last_net column to nodes table",
+				Version:     26,
+				Action: migrate.SQL{
+					`ALTER TABLE nodes ADD last_net text;`,
+					`UPDATE nodes SET last_net = '';`,
+					`ALTER TABLE nodes ALTER COLUMN last_net SET NOT NULL;`,
+				},
+			},
 		},
 	}
 }
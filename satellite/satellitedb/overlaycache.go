@@ -40,15 +40,16 @@ func (cache *overlaycache) SelectStorageNodes(ctx context.Context, count int, cr
 
 	safeQuery := `
 		WHERE type = ? AND free_bandwidth >= ? AND free_disk >= ?
-		  AND total_audit_count >= ?
-		  AND audit_success_ratio >= ?
-		  AND total_uptime_count >= ?
-		  AND uptime_ratio >= ?
+		  AND (vetted_at IS NOT NULL OR (total_audit_count >= ? AND total_uptime_count >= ?))
+		  AND audit_reputation_alpha >= ? * (audit_reputation_alpha + audit_reputation_beta)
+		  AND uptime_reputation_alpha >= ? * (uptime_reputation_alpha + uptime_reputation_beta)
 		  AND last_contact_success > ?
-		  AND last_contact_success > last_contact_failure`
+		  AND last_contact_success > last_contact_failure
+		  AND exit_initiated_at IS NULL
+		  AND disqualified IS NULL`
 	args := append(make([]interface{}, 0, 13),
 		nodeType, criteria.FreeBandwidth, criteria.FreeDisk,
-		criteria.AuditCount, criteria.AuditSuccessRatio, criteria.UptimeCount, criteria.UptimeSuccessRatio,
+		criteria.AuditCount, criteria.UptimeCount, criteria.AuditReputationDQ, criteria.UptimeReputationDQ,
 		time.Now().Add(-criteria.OnlineWindow))
 
 	if criteria.MinimumVersion != "" {
@@ -62,7 +63,7 @@ func (cache *overlaycache) SelectStorageNodes(ctx context.Context, count int, cr
 		args = append(args, v.Major, v.Major, v.Minor, v.Minor, v.Patch)
 	}
 
-	return cache.queryFilteredNodes(ctx, criteria.Excluded, count, safeQuery, args...)
+	return cache.queryFilteredNodes(ctx, criteria.Excluded, criteria.DistinctNetworks, count, safeQuery, args...)
 }
 
 func (cache *overlaycache) SelectNewStorageNodes(ctx context.Context, count int, criteria *overlay.NodeCriteria) ([]*pb.Node, error) {
@@ -70,11 +71,15 @@ func (cache *overlaycache) SelectNewStorageNodes(ctx context.Context, count int,
 
 	safeQuery := `
 		WHERE type = ? AND free_bandwidth >= ? AND free_disk >= ?
-		  AND total_audit_count < ? AND audit_success_ratio >= ?
+		  AND vetted_at IS NULL
+		  AND total_audit_count < ?
+		  AND audit_reputation_alpha >= ? * (audit_reputation_alpha + audit_reputation_beta)
 		  AND last_contact_success > ?
-		  AND last_contact_success > last_contact_failure`
+		  AND last_contact_success > last_contact_failure
+		  AND exit_initiated_at IS NULL
+		  AND disqualified IS NULL`
 	args := append(make([]interface{}, 0, 10),
-		nodeType, criteria.FreeBandwidth, criteria.FreeDisk, criteria.AuditCount, criteria.AuditSuccessRatio, time.Now().Add(-criteria.OnlineWindow))
+		nodeType, criteria.FreeBandwidth, criteria.FreeDisk, criteria.AuditCount, criteria.AuditReputationDQ, time.Now().Add(-criteria.OnlineWindow))
 
 	if criteria.MinimumVersion != "" {
 		v, err := version.NewSemVer(criteria.MinimumVersion)
@@ -87,10 +92,10 @@ func (cache *overlaycache) SelectNewStorageNodes(ctx context.Context, count int,
 		args = append(args, v.Major, v.Major, v.Minor, v.Minor, v.Patch)
 	}
 
-	return cache.queryFilteredNodes(ctx, criteria.Excluded, count, safeQuery, args...)
+	return cache.queryFilteredNodes(ctx, criteria.Excluded, criteria.DistinctNetworks, count, safeQuery, args...)
 }
 
-func (cache *overlaycache) queryFilteredNodes(ctx context.Context, excluded []storj.NodeID, count int, safeQuery string, args ...interface{}) (_ []*pb.Node, err error) {
+func (cache *overlaycache) queryFilteredNodes(ctx context.Context, excluded []storj.NodeID, distinctNetworks bool, count int, safeQuery string, args ...interface{}) (_ []*pb.Node, err error) {
 	if count == 0 {
 		return nil, nil
 	}
@@ -102,16 +107,60 @@ func (cache *overlaycache) queryFilteredNodes(ctx context.Context, excluded []st
 	for _, id := range excluded {
 		args = append(args, id.Bytes())
 	}
-	args = append(args, count)
 
-	rows, err := cache.db.Query(cache.db.Rebind(`SELECT id,
+	safeColumns := `id,
 		type, address, free_bandwidth, free_disk, audit_success_ratio,
 		uptime_ratio, total_audit_count, audit_success_count, total_uptime_count,
-		uptime_success_count
+		uptime_success_count`
+
+	var safeSelect string
+	if !distinctNetworks {
+		safeSelect = `SELECT ` + safeColumns + `
 		FROM nodes
-		`+safeQuery+safeExcludeNodes+`
+		` + safeQuery + safeExcludeNodes + `
+		ORDER BY RANDOM()
+		LIMIT ?`
+	} else {
+		// the networks of the excluded nodes already hold pieces of the segment
+		if len(excluded) > 0 {
+			safeExcludeNodes += `
+		  AND last_net NOT IN (SELECT last_net FROM nodes WHERE last_net <> '' AND id IN (?` + strings.Repeat(", ?", len(excluded)-1) + `))`
+		}
+		for _, id := range excluded {
+			args = append(args, id.Bytes())
+		}
+
+		// nodes whose network isn't known yet are told apart by their address
+		safeNetwork := `(CASE WHEN last_net = '' THEN address ELSE last_net END)`
+
+		switch t := cache.db.Driver().(type) {
+		case *sqlite3.SQLiteDriver:
+			// a random node of each network, like DISTINCT ON in postgres
+			safeSelect = `SELECT ` + safeColumns + ` FROM (
+			SELECT ` + safeColumns + `,
+				ROW_NUMBER() OVER (PARTITION BY ` + safeNetwork + ` ORDER BY RANDOM()) AS network_row
+			FROM nodes
+			` + safeQuery + safeExcludeNodes + `
+		) distinct_nodes
+		WHERE network_row = 1
 		ORDER BY RANDOM()
-		LIMIT ?`), args...)
+		LIMIT ?`
+		case *pq.Driver:
+			safeSelect = `SELECT * FROM (
+			SELECT DISTINCT ON ` + safeNetwork + ` ` + safeColumns + `
+			FROM nodes
+			` + safeQuery + safeExcludeNodes + `
+			ORDER BY ` + safeNetwork + `, RANDOM()
+		) distinct_nodes
+		ORDER BY RANDOM()
+		LIMIT ?`
+		default:
+			return nil, Error.New("Unsupported database %t", t)
+		}
+	}
+	args = append(args, count)
+
+	rows, err := cache.db.Query(cache.db.Rebind(safeSelect), args...)
 	if err != nil {
 		return nil, err
 	}
@@ -170,23 +219,29 @@ func (cache *overlaycache) KnownUnreliableOrOffline(ctx context.Context, criteri
 		for i := range nodeIds {
 			args = append(args, nodeIds[i].Bytes())
 		}
-		args = append(args, criteria.AuditSuccessRatio, criteria.UptimeSuccessRatio, time.Now().Add(-criteria.OnlineWindow))
+		args = append(args, criteria.AuditReputationDQ, criteria.UptimeReputationDQ, time.Now().Add(-criteria.OnlineWindow))
 
 		rows, err = cache.db.Query(cache.db.Rebind(`
 			SELECT id FROM nodes
 			WHERE id IN (?`+strings.Repeat(", ?", len(nodeIds)-1)+`)
-			AND audit_success_ratio >= ? AND uptime_ratio >= ?
+			AND audit_reputation_alpha >= ? * (audit_reputation_alpha + audit_reputation_beta)
+			AND uptime_reputation_alpha >= ? * (uptime_reputation_alpha + uptime_reputation_beta)
 			AND last_contact_success > ? AND last_contact_success > last_contact_failure
+			AND exit_finished_at IS NULL
+			AND disqualified IS NULL
 		`), args...)
 
 	case *pq.Driver:
 		rows, err = cache.db.Query(`
 			SELECT id FROM nodes
 				WHERE id = any($1::bytea[])
-				AND audit_success_ratio >= $2 AND uptime_ratio >= $3
+				AND audit_reputation_alpha >= $2 * (audit_reputation_alpha + audit_reputation_beta)
+				AND uptime_reputation_alpha >= $3 * (uptime_reputation_alpha + uptime_reputation_beta)
 				AND last_contact_success > $4 AND last_contact_success > last_contact_failure
+				AND exit_finished_at IS NULL
+				AND disqualified IS NULL
 			`, postgresNodeIDList(nodeIds),
-			criteria.AuditSuccessRatio, criteria.UptimeSuccessRatio,
+			criteria.AuditReputationDQ, criteria.UptimeReputationDQ,
 			time.Now().Add(-criteria.OnlineWindow),
 		)
 	default:
@@ -217,6 +272,130 @@ func (cache *overlaycache) KnownUnreliableOrOffline(ctx context.Context, criteri
 	return badNodes, nil
 }
 
+// UpdateVetted marks the node as vetted, nodes which are already vetted are left unchanged.
+func (cache *overlaycache) UpdateVetted(ctx context.Context, nodeID storj.NodeID, vettedAt time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = cache.db.ExecContext(ctx, cache.db.Rebind(`
+		UPDATE nodes SET vetted_at = ?
+		WHERE id = ? AND vetted_at IS NULL
+	`), vettedAt, nodeID.Bytes())
+	return Error.Wrap(err)
+}
+
+// KnownUnvetted filters a set of nodes to the nodes which aren't vetted yet.
+func (cache *overlaycache) KnownUnvetted(ctx context.Context, nodeIDs storj.NodeIDList) (unvetted storj.NodeIDList, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if len(nodeIDs) == 0 {
+		return nil, nil
+	}
+
+	args := make([]interface{}, 0, len(nodeIDs))
+	for _, id := range nodeIDs {
+		args = append(args, id.Bytes())
+	}
+
+	rows, err := cache.db.QueryContext(ctx, cache.db.Rebind(`
+		SELECT id FROM nodes
+		WHERE id IN (?`+strings.Repeat(", ?", len(nodeIDs)-1)+`)
+		AND vetted_at IS NULL
+	`), args...)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	for rows.Next() {
+		var id storj.NodeID
+		if err := rows.Scan(&id); err != nil {
+			return nil, Error.Wrap(err)
+		}
+		unvetted = append(unvetted, id)
+	}
+	return unvetted, Error.Wrap(rows.Err())
+}
+
+// UnvettedNodes returns the storage nodes which aren't vetted yet, the nodes closest to being vetted first.
+func (cache *overlaycache) UnvettedNodes(ctx context.Context, limit int) (_ []*overlay.VettingStatus, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if limit <= 0 || limit > storage.LookupLimit {
+		limit = storage.LookupLimit
+	}
+
+	rows, err := cache.db.QueryContext(ctx, cache.db.Rebind(`
+		SELECT id, total_audit_count, total_uptime_count FROM nodes
+		WHERE type = ? AND vetted_at IS NULL AND exit_finished_at IS NULL AND disqualified IS NULL
+		ORDER BY total_audit_count DESC, total_uptime_count DESC, id
+		LIMIT ?
+	`), int(pb.NodeType_STORAGE), limit)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	var statuses []*overlay.VettingStatus
+	for rows.Next() {
+		status := &overlay.VettingStatus{}
+		if err := rows.Scan(&status.NodeID, &status.AuditCount, &status.UptimeCount); err != nil {
+			return nil, Error.Wrap(err)
+		}
+		statuses = append(statuses, status)
+	}
+	return statuses, Error.Wrap(rows.Err())
+}
+
+// DisqualifyNode disqualifies the node, nodes which are already disqualified are left unchanged.
+func (cache *overlaycache) DisqualifyNode(ctx context.Context, nodeID storj.NodeID, disqualifiedAt time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	result, err := cache.db.ExecContext(ctx, cache.db.Rebind(`
+		UPDATE nodes SET disqualified = COALESCE(disqualified, ?)
+		WHERE id = ?
+	`), disqualifiedAt, nodeID.Bytes())
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	if affected == 0 {
+		return overlay.ErrNodeNotFound.New("%v", nodeID)
+	}
+	return nil
+}
+
+// DisqualifiedNodes returns the disqualified nodes, the most recently disqualified first.
+func (cache *overlaycache) DisqualifiedNodes(ctx context.Context, limit int) (_ []*overlay.DisqualifiedNode, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if limit <= 0 || limit > storage.LookupLimit {
+		limit = storage.LookupLimit
+	}
+
+	rows, err := cache.db.QueryContext(ctx, cache.db.Rebind(`
+		SELECT id, disqualified FROM nodes
+		WHERE disqualified IS NOT NULL
+		ORDER BY disqualified DESC, id
+		LIMIT ?
+	`), limit)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	var nodes []*overlay.DisqualifiedNode
+	for rows.Next() {
+		node := &overlay.DisqualifiedNode{}
+		if err := rows.Scan(&node.NodeID, &node.Disqualified); err != nil {
+			return nil, Error.Wrap(err)
+		}
+		nodes = append(nodes, node)
+	}
+	return nodes, Error.Wrap(rows.Err())
+}
+
 // Paginate will run through
 func (cache *overlaycache) Paginate(ctx context.Context, offset int64, limit int) ([]*overlay.NodeDossier, bool, error) {
 	cursor := storj.NodeID{}
@@ -247,8 +426,8 @@ func (cache *overlaycache) Paginate(ctx context.Context, offset int64, limit int
 	return infos, more, nil
 }
 
-// Update updates node address
-func (cache *overlaycache) UpdateAddress(ctx context.Context, info *pb.Node) (err error) {
+// UpdateAddress updates node address and the network it was resolved to
+func (cache *overlaycache) UpdateAddress(ctx context.Context, info *pb.Node, lastNet string, defaults overlay.NodeSelectionConfig) (err error) {
 	if info == nil || info.Id.IsZero() {
 		return overlay.ErrEmptyNode
 	}
@@ -271,6 +450,7 @@ func (cache *overlaycache) UpdateAddress(ctx context.Context, info *pb.Node) (er
 			ctx,
 			dbx.Node_Id(info.Id.Bytes()),
 			dbx.Node_Address(address.Address),
+			dbx.Node_LastNet(lastNet),
 			dbx.Node_Protocol(int(address.Transport)),
 			dbx.Node_Type(int(pb.NodeType_INVALID)),
 			dbx.Node_Email(""),
@@ -290,9 +470,15 @@ func (cache *overlaycache) UpdateAddress(ctx context.Context, info *pb.Node) (er
 			dbx.Node_UptimeSuccessCount(0),
 			dbx.Node_TotalUptimeCount(0),
 			dbx.Node_UptimeRatio(1),
+			dbx.Node_AuditReputationAlpha(defaults.AuditReputationAlpha0),
+			dbx.Node_AuditReputationBeta(defaults.AuditReputationBeta0),
+			dbx.Node_UptimeReputationAlpha(defaults.UptimeReputationAlpha0),
+			dbx.Node_UptimeReputationBeta(defaults.UptimeReputationBeta0),
 			dbx.Node_LastContactSuccess(time.Now()),
 			dbx.Node_LastContactFailure(time.Time{}),
 			dbx.Node_Contained(false),
+			dbx.Node_ExitSuccess(false),
+			dbx.Node_Create_Fields{},
 		)
 		if err != nil {
 			return Error.Wrap(errs.Combine(err, tx.Rollback()))
@@ -302,6 +488,10 @@ func (cache *overlaycache) UpdateAddress(ctx context.Context, info *pb.Node) (er
 			Address:  dbx.Node_Address(address.Address),
 			Protocol: dbx.Node_Protocol(int(address.Transport)),
 		}
+		// an empty network wasn't resolved, the stored one is kept
+		if lastNet != "" {
+			update.LastNet = dbx.Node_LastNet(lastNet)
+		}
 
 		_, err := tx.Update_Node_By_Id(ctx, dbx.Node_Id(info.Id.Bytes()), update)
 		if err != nil {
@@ -337,12 +527,16 @@ func (cache *overlaycache) CreateStats(ctx context.Context, nodeID storj.NodeID,
 		}
 
 		updateFields := dbx.Node_Update_Fields{
-			AuditSuccessCount:  dbx.Node_AuditSuccessCount(startingStats.AuditSuccessCount),
-			TotalAuditCount:    dbx.Node_TotalAuditCount(startingStats.AuditCount),
-			AuditSuccessRatio:  dbx.Node_AuditSuccessRatio(auditSuccessRatio),
-			UptimeSuccessCount: dbx.Node_UptimeSuccessCount(startingStats.UptimeSuccessCount),
-			TotalUptimeCount:   dbx.Node_TotalUptimeCount(startingStats.UptimeCount),
-			UptimeRatio:        dbx.Node_UptimeRatio(uptimeRatio),
+			AuditSuccessCount:     dbx.Node_AuditSuccessCount(startingStats.AuditSuccessCount),
+			TotalAuditCount:       dbx.Node_TotalAuditCount(startingStats.AuditCount),
+			AuditSuccessRatio:     dbx.Node_AuditSuccessRatio(auditSuccessRatio),
+			UptimeSuccessCount:    dbx.Node_UptimeSuccessCount(startingStats.UptimeSuccessCount),
+			TotalUptimeCount:      dbx.Node_TotalUptimeCount(startingStats.UptimeCount),
+			UptimeRatio:           dbx.Node_UptimeRatio(uptimeRatio),
+			AuditReputationAlpha:  dbx.Node_AuditReputationAlpha(startingStats.AuditReputationAlpha),
+			AuditReputationBeta:   dbx.Node_AuditReputationBeta(startingStats.AuditReputationBeta),
+			UptimeReputationAlpha: dbx.Node_UptimeReputationAlpha(startingStats.UptimeReputationAlpha),
+			UptimeReputationBeta:  dbx.Node_UptimeReputationBeta(startingStats.UptimeReputationBeta),
 		}
 
 		dbNode, err = tx.Update_Node_By_Id(ctx, dbx.Node_Id(nodeID.Bytes()), updateFields)
@@ -394,13 +588,39 @@ func (cache *overlaycache) UpdateStats(ctx context.Context, updateReq *overlay.U
 		totalUptimeCount,
 	)
 
+	auditAlpha, auditBeta := overlay.UpdateReputation(
+		updateReq.AuditSuccess,
+		dbNode.AuditReputationAlpha,
+		dbNode.AuditReputationBeta,
+		updateReq.AuditLambda,
+		updateReq.AuditWeight,
+	)
+
+	uptimeAlpha, uptimeBeta := overlay.UpdateReputation(
+		updateReq.IsUp,
+		dbNode.UptimeReputationAlpha,
+		dbNode.UptimeReputationBeta,
+		updateReq.UptimeLambda,
+		updateReq.UptimeWeight,
+	)
+
 	updateFields := dbx.Node_Update_Fields{
-		AuditSuccessCount:  dbx.Node_AuditSuccessCount(auditSuccessCount),
-		TotalAuditCount:    dbx.Node_TotalAuditCount(totalAuditCount),
-		AuditSuccessRatio:  dbx.Node_AuditSuccessRatio(auditSuccessRatio),
-		UptimeSuccessCount: dbx.Node_UptimeSuccessCount(uptimeSuccessCount),
-		TotalUptimeCount:   dbx.Node_TotalUptimeCount(totalUptimeCount),
-		UptimeRatio:        dbx.Node_UptimeRatio(uptimeRatio),
+		AuditSuccessCount:     dbx.Node_AuditSuccessCount(auditSuccessCount),
+		TotalAuditCount:       dbx.Node_TotalAuditCount(totalAuditCount),
+		AuditSuccessRatio:     dbx.Node_AuditSuccessRatio(auditSuccessRatio),
+		UptimeSuccessCount:    dbx.Node_UptimeSuccessCount(uptimeSuccessCount),
+		TotalUptimeCount:      dbx.Node_TotalUptimeCount(totalUptimeCount),
+		UptimeRatio:           dbx.Node_UptimeRatio(uptimeRatio),
+		AuditReputationAlpha:  dbx.Node_AuditReputationAlpha(auditAlpha),
+		AuditReputationBeta:   dbx.Node_AuditReputationBeta(auditBeta),
+		UptimeReputationAlpha: dbx.Node_UptimeReputationAlpha(uptimeAlpha),
+		UptimeReputationBeta:  dbx.Node_UptimeReputationBeta(uptimeBeta),
+	}
+
+	// disqualification is permanent, the node isn't reinstated when its reputation recovers,
+	// a low uptime reputation only keeps the node from being selected until it's back online
+	if dbNode.Disqualified == nil && overlay.ReputationScore(auditAlpha, auditBeta) < updateReq.AuditDQ {
+		updateFields.Disqualified = dbx.Node_Disqualified(time.Now().UTC())
 	}
 
 	if updateReq.IsUp {
@@ -468,7 +688,7 @@ func (cache *overlaycache) UpdateNodeInfo(ctx context.Context, nodeID storj.Node
 }
 
 // UpdateUptime updates a single storagenode's uptime stats in the db
-func (cache *overlaycache) UpdateUptime(ctx context.Context, nodeID storj.NodeID, isUp bool) (stats *overlay.NodeStats, err error) {
+func (cache *overlaycache) UpdateUptime(ctx context.Context, nodeID storj.NodeID, isUp bool, lambda, weight float64) (stats *overlay.NodeStats, err error) {
 	defer mon.Task()(&ctx)(&err)
 
 	tx, err := cache.db.Open(ctx)
@@ -496,6 +716,16 @@ func (cache *overlaycache) UpdateUptime(ctx context.Context, nodeID storj.NodeID
 	updateFields.TotalUptimeCount = dbx.Node_TotalUptimeCount(totalUptimeCount)
 	updateFields.UptimeRatio = dbx.Node_UptimeRatio(uptimeRatio)
 
+	uptimeAlpha, uptimeBeta := overlay.UpdateReputation(
+		isUp,
+		dbNode.UptimeReputationAlpha,
+		dbNode.UptimeReputationBeta,
+		lambda,
+		weight,
+	)
+	updateFields.UptimeReputationAlpha = dbx.Node_UptimeReputationAlpha(uptimeAlpha)
+	updateFields.UptimeReputationBeta = dbx.Node_UptimeReputationBeta(uptimeBeta)
+
 	if isUp {
 		updateFields.LastContactSuccess = dbx.Node_LastContactSuccess(time.Now())
 	} else {
@@ -516,6 +746,31 @@ func (cache *overlaycache) UpdateUptime(ctx context.Context, nodeID storj.NodeID
 	return getNodeStats(dbNode), Error.Wrap(tx.Commit())
 }
 
+// UpdateExitStatus updates the graceful exit status of a single storagenode in the db
+func (cache *overlaycache) UpdateExitStatus(ctx context.Context, request *overlay.ExitStatusRequest) (stats *overlay.NodeDossier, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	updateFields := dbx.Node_Update_Fields{
+		ExitSuccess: dbx.Node_ExitSuccess(request.ExitSuccess),
+	}
+	if !request.ExitInitiatedAt.IsZero() {
+		updateFields.ExitInitiatedAt = dbx.Node_ExitInitiatedAt(request.ExitInitiatedAt)
+	}
+	if !request.ExitFinishedAt.IsZero() {
+		updateFields.ExitFinishedAt = dbx.Node_ExitFinishedAt(request.ExitFinishedAt)
+	}
+
+	dbNode, err := cache.db.Update_Node_By_Id(ctx, dbx.Node_Id(request.NodeID.Bytes()), updateFields)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	if dbNode == nil {
+		return nil, overlay.ErrNodeNotFound.New("%s", request.NodeID)
+	}
+
+	return convertDBNode(dbNode)
+}
+
 func convertDBNode(info *dbx.Node) (*overlay.NodeDossier, error) {
 	if info == nil {
 		return nil, Error.New("missing info")
@@ -544,7 +799,8 @@ func convertDBNode(info *dbx.Node) (*overlay.NodeDossier, error) {
 				Transport: pb.NodeTransport(info.Protocol),
 			},
 		},
-		Type: pb.NodeType(info.Type),
+		LastNet: info.LastNet,
+		Type:    pb.NodeType(info.Type),
 		Operator: pb.NodeOperator{
 			Email:  info.Email,
 			Wallet: info.Wallet,
@@ -563,6 +819,13 @@ func convertDBNode(info *dbx.Node) (*overlay.NodeDossier, error) {
 			UptimeSuccessCount: info.UptimeSuccessCount,
 			LastContactSuccess: info.LastContactSuccess,
 			LastContactFailure: info.LastContactFailure,
+			VettedAt:           info.VettedAt,
+			Disqualified:       info.Disqualified,
+
+			AuditReputationAlpha:  info.AuditReputationAlpha,
+			AuditReputationBeta:   info.AuditReputationBeta,
+			UptimeReputationAlpha: info.UptimeReputationAlpha,
+			UptimeReputationBeta:  info.UptimeReputationBeta,
 		},
 		Version: pb.NodeVersion{
 			Version:    ver.String(),
@@ -571,6 +834,11 @@ func convertDBNode(info *dbx.Node) (*overlay.NodeDossier, error) {
 			Release:    info.Release,
 		},
 		Contained: info.Contained,
+		ExitStatus: overlay.ExitStatus{
+			ExitInitiatedAt: info.ExitInitiatedAt,
+			ExitFinishedAt:  info.ExitFinishedAt,
+			ExitSuccess:     info.ExitSuccess,
+		},
 	}
 
 	return node, nil
@@ -587,6 +855,13 @@ func getNodeStats(dbNode *dbx.Node) *overlay.NodeStats {
 		UptimeCount:        dbNode.TotalUptimeCount,
 		LastContactSuccess: dbNode.LastContactSuccess,
 		LastContactFailure: dbNode.LastContactFailure,
+		VettedAt:           dbNode.VettedAt,
+		Disqualified:       dbNode.Disqualified,
+
+		AuditReputationAlpha:  dbNode.AuditReputationAlpha,
+		AuditReputationBeta:   dbNode.AuditReputationBeta,
+		UptimeReputationAlpha: dbNode.UptimeReputationAlpha,
+		UptimeReputationBeta:  dbNode.UptimeReputationBeta,
 	}
 	return nodeStats
 }
@@ -6,6 +6,7 @@ package storage
 import (
 	"context"
 	"io"
+	"time"
 
 	"github.com/zeebo/errs"
 )
@@ -37,6 +38,7 @@ type BlobReader interface {
 // BlobWriter is an interface that groups Read, ReadAt, Seek and Close.
 type BlobWriter interface {
 	io.Writer
+	io.Seeker
 	// Cancel discards the blob.
 	Cancel() error
 	// Commit ensures that the blob is readable by others.
@@ -54,6 +56,18 @@ type Blobs interface {
 	Open(ctx context.Context, ref BlobRef) (BlobReader, error)
 	// Delete deletes the blob with the namespace and key
 	Delete(ctx context.Context, ref BlobRef) error
+	// Trash moves the blob with the namespace and key into the trash
+	Trash(ctx context.Context, ref BlobRef) error
+	// RestoreTrash moves the trashed blobs of the namespace back and returns their keys
+	RestoreTrash(ctx context.Context, namespace []byte) ([][]byte, error)
+	// EmptyTrash permanently deletes the blobs trashed before trashedBefore
+	EmptyTrash(ctx context.Context, trashedBefore time.Time) error
+	// TrashUsed returns the space used by the trashed blobs of each namespace
+	TrashUsed(ctx context.Context) (map[string]int64, error)
 	// FreeSpace return how much free space left for writing
 	FreeSpace() (int64, error)
+	// FilesystemID returns the ID of the filesystem the blobs are stored on
+	FilesystemID() (string, error)
+	// Walk calls fn for every committed blob
+	Walk(ctx context.Context, fn func(ref BlobRef) error) error
 }
@@ -150,6 +150,35 @@ func (client *Client) PutAndCommit(key storage.Key, value storage.Value) error {
 	})
 }
 
+// CompareAndSwap atomically compares and swaps oldValue with newValue
+func (client *Client) CompareAndSwap(key storage.Key, oldValue, newValue storage.Value) error {
+	if key.IsZero() {
+		return storage.ErrEmptyKey.New("")
+	}
+
+	return client.update(func(bucket *bolt.Bucket) error {
+		data := bucket.Get([]byte(key))
+		if data == nil {
+			if oldValue != nil {
+				return storage.ErrKeyNotFound.New(key.String())
+			}
+			if newValue == nil {
+				return nil
+			}
+			return bucket.Put(key, newValue)
+		}
+
+		if oldValue == nil || !bytes.Equal(data, oldValue) {
+			return storage.ErrValueChanged.New(key.String())
+		}
+
+		if newValue == nil {
+			return bucket.Delete(key)
+		}
+		return bucket.Put(key, newValue)
+	})
+}
+
 // Get looks up the provided key from boltdb returning either an error or the result.
 func (client *Client) Get(key storage.Key) (storage.Value, error) {
 	if key.IsZero() {
@@ -19,6 +19,9 @@ var ErrKeyNotFound = errs.Class("key not found")
 // ErrEmptyKey is returned when an empty key is used in Put
 var ErrEmptyKey = errs.Class("empty key")
 
+// ErrValueChanged is returned when the current value of the key does not match the oldValue in CompareAndSwap
+var ErrValueChanged = errs.Class("value changed")
+
 // ErrEmptyQueue is returned when attempting to Dequeue from an empty queue
 var ErrEmptyQueue = errs.Class("empty queue")
 
@@ -60,6 +63,10 @@ type KeyValueStore interface {
 	GetAll(Keys) (Values, error)
 	// Delete deletes key and the value
 	Delete(Key) error
+	// CompareAndSwap atomically replaces the value of key with newValue if it is
+	// still oldValue. A nil oldValue expects the key to not exist, a nil newValue
+	// deletes the key.
+	CompareAndSwap(key Key, oldValue, newValue Value) error
 	// List lists all keys starting from start and upto limit items
 	List(start Key, limit int) (Keys, error)
 	// Iterate iterates over items based on opts
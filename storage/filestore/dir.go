@@ -4,13 +4,16 @@
 package filestore
 
 import (
+	"context"
 	"encoding/base32"
 	"io"
 	"io/ioutil"
 	"math"
 	"os"
 	"path/filepath"
+	"strings"
 	"sync"
+	"time"
 
 	"github.com/zeebo/errs"
 
@@ -22,6 +25,9 @@ const (
 	dirPermission  = 0700
 )
 
+// trashDayFormat is the name format of the directories containing the blobs trashed on a day
+const trashDayFormat = "2006-01-02"
+
 var pathEncoding = base32.NewEncoding("abcdefghijklmnopqrstuvwxyz234567").WithPadding(base32.NoPadding)
 
 // Dir represents single folder for storing blobs
@@ -81,6 +87,11 @@ func (dir *Dir) DeleteTemporary(file *os.File) error {
 
 // blobToPath converts blob reference to a filepath in permanent storage
 func (dir *Dir) blobToPath(ref storage.BlobRef) (string, error) {
+	return refToPath(dir.blobdir(), ref)
+}
+
+// refToPath converts blob reference to a filepath in the base directory
+func refToPath(base string, ref storage.BlobRef) (string, error) {
 	if !ref.IsValid() {
 		return "", storage.ErrInvalidBlobRef.New("")
 	}
@@ -91,7 +102,247 @@ func (dir *Dir) blobToPath(ref storage.BlobRef) (string, error) {
 		// ensure we always have at least
 		key = "11" + key
 	}
-	return filepath.Join(dir.blobdir(), namespace, key[:2], key[2:]), nil
+	return filepath.Join(base, namespace, key[:2], key[2:]), nil
+}
+
+// Walk calls fn for every blob in permanent storage, files which don't
+// correspond to a blob reference are skipped
+func (dir *Dir) Walk(ctx context.Context, fn func(ref storage.BlobRef) error) error {
+	namespaces, err := ioutil.ReadDir(dir.blobdir())
+	if err != nil {
+		return err
+	}
+
+	for _, namespaceInfo := range namespaces {
+		if !namespaceInfo.IsDir() {
+			continue
+		}
+		namespace, err := pathEncoding.DecodeString(namespaceInfo.Name())
+		if err != nil {
+			continue
+		}
+
+		err = walkNamespace(ctx, filepath.Join(dir.blobdir(), namespaceInfo.Name()), namespace, fn)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// walkNamespace calls fn for every blob in the namespace directory
+func walkNamespace(ctx context.Context, namespacePath string, namespace []byte, fn func(ref storage.BlobRef) error) error {
+	prefixes, err := ioutil.ReadDir(namespacePath)
+	if err != nil {
+		return err
+	}
+
+	for _, prefixInfo := range prefixes {
+		if !prefixInfo.IsDir() {
+			continue
+		}
+
+		files, err := ioutil.ReadDir(filepath.Join(namespacePath, prefixInfo.Name()))
+		if err != nil {
+			return err
+		}
+
+		for _, fileInfo := range files {
+			if fileInfo.IsDir() {
+				continue
+			}
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+
+			encoded := prefixInfo.Name() + fileInfo.Name()
+			// short keys are padded with "11", see refToPath
+			encoded = strings.TrimPrefix(encoded, "11")
+			key, err := pathEncoding.DecodeString(encoded)
+			if err != nil {
+				continue
+			}
+
+			if err := fn(storage.BlobRef{Namespace: namespace, Key: key}); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// trashDayDir returns the directory for the blobs trashed on the day of now,
+// trashed blobs use the same layout as the permanent storage
+func (dir *Dir) trashDayDir(now time.Time) string {
+	return filepath.Join(dir.trashdir(), now.UTC().Format(trashDayFormat))
+}
+
+// trashDays returns the days which have trashed blobs
+func (dir *Dir) trashDays() ([]time.Time, error) {
+	infos, err := ioutil.ReadDir(dir.trashdir())
+	if err != nil {
+		return nil, err
+	}
+
+	var days []time.Time
+	for _, info := range infos {
+		if !info.IsDir() {
+			continue
+		}
+		day, err := time.Parse(trashDayFormat, info.Name())
+		if err != nil {
+			continue
+		}
+		days = append(days, day)
+	}
+	return days, nil
+}
+
+// Trash moves the blob with the specified ref into the trash of the day of now
+func (dir *Dir) Trash(ref storage.BlobRef, now time.Time) error {
+	path, err := dir.blobToPath(ref)
+	if err != nil {
+		return err
+	}
+	trashPath, err := refToPath(dir.trashDayDir(now), ref)
+	if err != nil {
+		return err
+	}
+
+	mkdirErr := os.MkdirAll(filepath.Dir(trashPath), dirPermission)
+	if mkdirErr != nil && !os.IsExist(mkdirErr) {
+		return mkdirErr
+	}
+
+	err = rename(path, trashPath)
+	// ignore concurrent delete
+	if os.IsNotExist(err) {
+		return nil
+	}
+	return err
+}
+
+// RestoreTrash moves the trashed blobs of the namespace back to the permanent
+// storage and returns their keys. Blobs which have been recreated meanwhile are kept
+// and their trashed copies are removed.
+func (dir *Dir) RestoreTrash(ctx context.Context, namespace []byte) (keys [][]byte, err error) {
+	days, err := dir.trashDays()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, day := range days {
+		dayDir := dir.trashDayDir(day)
+		namespacePath := filepath.Join(dayDir, pathEncoding.EncodeToString(namespace))
+		if _, err := os.Stat(namespacePath); os.IsNotExist(err) {
+			continue
+		}
+
+		err := walkNamespace(ctx, namespacePath, namespace, func(ref storage.BlobRef) error {
+			trashPath, err := refToPath(dayDir, ref)
+			if err != nil {
+				return err
+			}
+			path, err := dir.blobToPath(ref)
+			if err != nil {
+				return err
+			}
+
+			mkdirErr := os.MkdirAll(filepath.Dir(path), dirPermission)
+			if mkdirErr != nil && !os.IsExist(mkdirErr) {
+				return mkdirErr
+			}
+
+			// linking fails instead of replacing a blob recreated meanwhile
+			err = os.Link(trashPath, path)
+			switch {
+			case os.IsExist(err):
+				return ignoreNotExist(os.Remove(trashPath))
+			case os.IsNotExist(err):
+				// the trash was emptied concurrently
+				return nil
+			case err != nil:
+				return err
+			}
+			if err := ignoreNotExist(os.Remove(trashPath)); err != nil {
+				return err
+			}
+			keys = append(keys, ref.Key)
+			return nil
+		})
+		if err != nil {
+			return keys, err
+		}
+	}
+	return keys, nil
+}
+
+// TrashUsed returns the space used by the trashed blobs of each namespace
+func (dir *Dir) TrashUsed(ctx context.Context) (map[string]int64, error) {
+	days, err := dir.trashDays()
+	if err != nil {
+		return nil, err
+	}
+
+	used := map[string]int64{}
+	for _, day := range days {
+		dayDir := dir.trashDayDir(day)
+		namespaces, err := ioutil.ReadDir(dayDir)
+		if os.IsNotExist(err) {
+			// the trash was emptied concurrently
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		for _, namespaceInfo := range namespaces {
+			if !namespaceInfo.IsDir() {
+				continue
+			}
+			namespace, err := pathEncoding.DecodeString(namespaceInfo.Name())
+			if err != nil {
+				continue
+			}
+
+			err = filepath.Walk(filepath.Join(dayDir, namespaceInfo.Name()), func(path string, info os.FileInfo, err error) error {
+				if err != nil {
+					return ignoreNotExist(err)
+				}
+				if err := ctx.Err(); err != nil {
+					return err
+				}
+				if info.Mode().IsRegular() {
+					used[string(namespace)] += info.Size()
+				}
+				return nil
+			})
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	return used, nil
+}
+
+// EmptyTrash permanently deletes the blobs trashed on the days before trashedBefore
+func (dir *Dir) EmptyTrash(ctx context.Context, trashedBefore time.Time) error {
+	days, err := dir.trashDays()
+	if err != nil {
+		return err
+	}
+
+	cutoff := trashedBefore.UTC().Truncate(24 * time.Hour)
+	var group errs.Group
+	for _, day := range days {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if day.Before(cutoff) {
+			group.Add(os.RemoveAll(dir.trashDayDir(day)))
+		}
+	}
+	return group.Err()
 }
 
 // blobToTrashPath converts blob reference to a filepath in transient storage
@@ -149,6 +400,9 @@ func (dir *Dir) Open(ref storage.BlobRef) (*os.File, error) {
 	}
 	file, err := openFileReadOnly(path, blobPermission)
 	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, err
+		}
 		return nil, Error.New("unable to open %q: %v", path, err)
 	}
 	return file, nil
@@ -224,23 +478,26 @@ func (dir *Dir) GarbageCollect() error {
 		dir.mu.Unlock()
 	}
 
-	// remove anything left in the trashdir
-	_ = removeAllContent(dir.trashdir())
+	// remove any deleted files left in the trashdir, the days of trashed blobs are kept
+	_ = removeFiles(dir.trashdir())
 	return nil
 }
 
-// removeAllContent deletes everything in the folder
-func removeAllContent(path string) error {
+// removeFiles deletes the files in the folder, subfolders are kept
+func removeFiles(path string) error {
 	dir, err := os.Open(path)
 	if err != nil {
 		return err
 	}
 
 	for {
-		files, err := dir.Readdirnames(100)
+		files, err := dir.Readdir(100)
 		for _, file := range files {
+			if file.IsDir() {
+				continue
+			}
 			// the file might be still in use, so ignore the error
-			_ = os.RemoveAll(filepath.Join(path, file))
+			_ = os.Remove(filepath.Join(path, file.Name()))
 		}
 		if err == io.EOF || len(files) == 0 {
 			return dir.Close()
@@ -265,3 +522,11 @@ func (dir *Dir) Info() (DiskInfo, error) {
 	}
 	return diskInfoFromPath(path)
 }
+
+// ignoreNotExist ignores errors about missing files
+func ignoreNotExist(err error) error {
+	if os.IsNotExist(err) {
+		return nil
+	}
+	return err
+}
@@ -6,6 +6,7 @@ package filestore
 import (
 	"context"
 	"os"
+	"time"
 
 	"github.com/zeebo/errs"
 
@@ -57,6 +58,30 @@ func (store *Store) Delete(ctx context.Context, ref storage.BlobRef) error {
 	return Error.Wrap(err)
 }
 
+// Trash moves the blob with the specified ref into the trash
+func (store *Store) Trash(ctx context.Context, ref storage.BlobRef) error {
+	err := store.dir.Trash(ref, time.Now())
+	return Error.Wrap(err)
+}
+
+// RestoreTrash moves the trashed blobs of the namespace back and returns their keys
+func (store *Store) RestoreTrash(ctx context.Context, namespace []byte) ([][]byte, error) {
+	keys, err := store.dir.RestoreTrash(ctx, namespace)
+	return keys, Error.Wrap(err)
+}
+
+// EmptyTrash permanently deletes the blobs trashed before trashedBefore
+func (store *Store) EmptyTrash(ctx context.Context, trashedBefore time.Time) error {
+	err := store.dir.EmptyTrash(ctx, trashedBefore)
+	return Error.Wrap(err)
+}
+
+// TrashUsed returns the space used by the trashed blobs of each namespace
+func (store *Store) TrashUsed(ctx context.Context) (map[string]int64, error) {
+	used, err := store.dir.TrashUsed(ctx)
+	return used, Error.Wrap(err)
+}
+
 // GarbageCollect tries to delete any files that haven't yet been deleted
 func (store *Store) GarbageCollect(ctx context.Context) error {
 	err := store.dir.GarbageCollect()
@@ -73,6 +98,11 @@ func (store *Store) Create(ctx context.Context, ref storage.BlobRef, size int64)
 	return newBlobWriter(ref, store, file), nil
 }
 
+// Walk calls fn for every blob in the store
+func (store *Store) Walk(ctx context.Context, fn func(ref storage.BlobRef) error) error {
+	return Error.Wrap(store.dir.Walk(ctx, fn))
+}
+
 // FreeSpace returns how much space left in underlying directory
 func (store *Store) FreeSpace() (int64, error) {
 	info, err := store.dir.Info()
@@ -81,3 +111,12 @@ func (store *Store) FreeSpace() (int64, error) {
 	}
 	return info.AvailableSpace, nil
 }
+
+// FilesystemID returns the ID of the filesystem of the underlying directory
+func (store *Store) FilesystemID() (string, error) {
+	info, err := store.dir.Info()
+	if err != nil {
+		return "", err
+	}
+	return info.ID, nil
+}
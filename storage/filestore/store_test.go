@@ -11,6 +11,7 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -226,3 +227,110 @@ func TestDeleteWhileReading(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestWalk(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	store, err := filestore.NewAt(ctx.Dir("store"))
+	require.NoError(t, err)
+
+	expected := map[string]storage.BlobRef{}
+	for i := 0; i < 10; i++ {
+		ref := storage.BlobRef{
+			Namespace: randomValue()[:1+i%2],
+			Key:       randomValue()[:1+i],
+		}
+		expected[string(ref.Namespace)+"/"+string(ref.Key)] = ref
+
+		writer, err := store.Create(ctx, ref, -1)
+		require.NoError(t, err)
+		_, err = writer.Write(randomValue())
+		require.NoError(t, err)
+		require.NoError(t, writer.Commit())
+	}
+
+	found := map[string]storage.BlobRef{}
+	err = store.Walk(ctx, func(ref storage.BlobRef) error {
+		found[string(ref.Namespace)+"/"+string(ref.Key)] = ref
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, expected, found)
+}
+
+func TestTrash(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	store, err := filestore.NewAt(ctx.Dir("store"))
+	require.NoError(t, err)
+
+	namespace, other := []byte{1}, []byte{2}
+	create := func(ref storage.BlobRef) {
+		writer, err := store.Create(ctx, ref, -1)
+		require.NoError(t, err)
+		_, err = writer.Write(randomValue())
+		require.NoError(t, err)
+		require.NoError(t, writer.Commit())
+	}
+
+	trashed := storage.BlobRef{Namespace: namespace, Key: []byte{1}}
+	recreated := storage.BlobRef{Namespace: namespace, Key: []byte{2}}
+	otherNamespace := storage.BlobRef{Namespace: other, Key: []byte{1}}
+	for _, ref := range []storage.BlobRef{trashed, recreated, otherNamespace} {
+		create(ref)
+		require.NoError(t, store.Trash(ctx, ref))
+
+		_, err := store.Open(ctx, ref)
+		require.True(t, os.IsNotExist(err))
+	}
+	// trashing a missing blob is ignored
+	require.NoError(t, store.Trash(ctx, storage.BlobRef{Namespace: namespace, Key: []byte{3}}))
+
+	used, err := store.TrashUsed(ctx)
+	require.NoError(t, err)
+	require.Len(t, used, 2)
+	require.NotZero(t, used[string(namespace)])
+	require.NotZero(t, used[string(other)])
+
+	create(recreated)
+
+	// garbage collection keeps the trash
+	require.NoError(t, store.GarbageCollect(ctx))
+
+	keys, err := store.RestoreTrash(ctx, namespace)
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{trashed.Key}, keys)
+
+	for _, ref := range []storage.BlobRef{trashed, recreated} {
+		reader, err := store.Open(ctx, ref)
+		require.NoError(t, err)
+		require.NoError(t, reader.Close())
+	}
+
+	// the trashed copy of the recreated blob is removed
+	used, err = store.TrashUsed(ctx)
+	require.NoError(t, err)
+	require.Zero(t, used[string(namespace)])
+	require.NotZero(t, used[string(other)])
+
+	// the trash of today is kept until tomorrow
+	require.NoError(t, store.EmptyTrash(ctx, time.Now()))
+	keys, err = store.RestoreTrash(ctx, other)
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{otherNamespace.Key}, keys)
+
+	require.NoError(t, store.Trash(ctx, trashed))
+	require.NoError(t, store.EmptyTrash(ctx, time.Now().Add(24*time.Hour)))
+
+	keys, err = store.RestoreTrash(ctx, namespace)
+	require.NoError(t, err)
+	require.Empty(t, keys)
+	_, err = store.Open(ctx, trashed)
+	require.True(t, os.IsNotExist(err))
+
+	used, err = store.TrashUsed(ctx)
+	require.NoError(t, err)
+	require.Empty(t, used)
+}
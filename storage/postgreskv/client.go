@@ -114,6 +114,69 @@ func (client *Client) DeletePath(bucket, key storage.Key) error {
 	return nil
 }
 
+// CompareAndSwap atomically compares and swaps oldValue with newValue
+func (client *Client) CompareAndSwap(key storage.Key, oldValue, newValue storage.Value) error {
+	return client.CompareAndSwapPath(storage.Key(defaultBucket), key, oldValue, newValue)
+}
+
+// CompareAndSwapPath atomically compares and swaps oldValue with newValue in the given bucket
+func (client *Client) CompareAndSwapPath(bucket, key storage.Key, oldValue, newValue storage.Value) error {
+	if key.IsZero() {
+		return storage.ErrEmptyKey.New("")
+	}
+
+	var q string
+	var args []interface{}
+	switch {
+	case oldValue == nil && newValue == nil:
+		q = "SELECT 1 FROM pathdata WHERE bucket = $1::BYTEA AND fullpath = $2::BYTEA"
+		var exists int
+		err := client.pgConn.QueryRow(q, []byte(bucket), []byte(key)).Scan(&exists)
+		if err == sql.ErrNoRows {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		return storage.ErrValueChanged.New(key.String())
+	case oldValue == nil:
+		q = `
+			INSERT INTO pathdata (bucket, fullpath, metadata)
+				VALUES ($1::BYTEA, $2::BYTEA, $3::BYTEA)
+				ON CONFLICT DO NOTHING
+		`
+		args = []interface{}{[]byte(bucket), []byte(key), []byte(newValue)}
+	case newValue == nil:
+		q = "DELETE FROM pathdata WHERE bucket = $1::BYTEA AND fullpath = $2::BYTEA AND metadata = $3::BYTEA"
+		args = []interface{}{[]byte(bucket), []byte(key), []byte(oldValue)}
+	default:
+		q = "UPDATE pathdata SET metadata = $4::BYTEA WHERE bucket = $1::BYTEA AND fullpath = $2::BYTEA AND metadata = $3::BYTEA"
+		args = []interface{}{[]byte(bucket), []byte(key), []byte(oldValue), []byte(newValue)}
+	}
+
+	result, err := client.pgConn.Exec(q, args...)
+	if err != nil {
+		return err
+	}
+	numRows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if numRows > 0 {
+		return nil
+	}
+
+	if oldValue == nil {
+		return storage.ErrValueChanged.New(key.String())
+	}
+	// distinguish a missing key from a changed value
+	_, err = client.GetPath(bucket, key)
+	if err != nil {
+		return err
+	}
+	return storage.ErrValueChanged.New(key.String())
+}
+
 // List returns either a list of known keys, in order, or an error.
 func (client *Client) List(first storage.Key, limit int) (storage.Keys, error) {
 	return storage.ListKeys(client, first, limit)
@@ -22,14 +22,15 @@ type Client struct {
 	ForceError int
 
 	CallCount struct {
-		Get         int
-		Put         int
-		List        int
-		GetAll      int
-		ReverseList int
-		Delete      int
-		Close       int
-		Iterate     int
+		Get            int
+		Put            int
+		List           int
+		GetAll         int
+		ReverseList    int
+		Delete         int
+		CompareAndSwap int
+		Close          int
+		Iterate        int
 	}
 
 	version int
@@ -166,6 +167,53 @@ func (store *Client) Delete(key storage.Key) error {
 	return nil
 }
 
+// CompareAndSwap atomically compares and swaps oldValue with newValue
+func (store *Client) CompareAndSwap(key storage.Key, oldValue, newValue storage.Value) error {
+	defer store.locked()()
+
+	store.version++
+	store.CallCount.CompareAndSwap++
+	if store.forcedError() {
+		return errInternal
+	}
+
+	if key.IsZero() {
+		return storage.ErrEmptyKey.New("")
+	}
+
+	keyIndex, found := store.indexOf(key)
+	if !found {
+		if oldValue != nil {
+			return storage.ErrKeyNotFound.New(key.String())
+		}
+		if newValue == nil {
+			return nil
+		}
+
+		store.Items = append(store.Items, storage.ListItem{})
+		copy(store.Items[keyIndex+1:], store.Items[keyIndex:])
+		store.Items[keyIndex] = storage.ListItem{
+			Key:   storage.CloneKey(key),
+			Value: storage.CloneValue(newValue),
+		}
+		return nil
+	}
+
+	kv := &store.Items[keyIndex]
+	if oldValue == nil || !bytes.Equal(kv.Value, oldValue) {
+		return storage.ErrValueChanged.New(key.String())
+	}
+
+	if newValue == nil {
+		copy(store.Items[keyIndex:], store.Items[keyIndex+1:])
+		store.Items = store.Items[:len(store.Items)-1]
+		return nil
+	}
+
+	kv.Value = storage.CloneValue(newValue)
+	return nil
+}
+
 // List lists all keys starting from start and upto limit items
 func (store *Client) List(first storage.Key, limit int) (storage.Keys, error) {
 	store.mu.Lock()
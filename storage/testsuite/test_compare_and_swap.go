@@ -0,0 +1,56 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package testsuite
+
+import (
+	"bytes"
+	"testing"
+
+	"storj.io/storj/storage"
+)
+
+func testCompareAndSwap(t *testing.T, store storage.KeyValueStore) {
+	key := storage.Key("compare-and-swap")
+	defer func() { _ = store.Delete(key) }()
+
+	if err := store.CompareAndSwap(nil, nil, storage.Value("a")); !storage.ErrEmptyKey.Has(err) {
+		t.Fatalf("swapping an empty key should fail with empty key: %v", err)
+	}
+
+	if err := store.CompareAndSwap(key, storage.Value("a"), storage.Value("b")); !storage.ErrKeyNotFound.Has(err) {
+		t.Fatalf("swapping a missing key should fail with key not found: %v", err)
+	}
+
+	if err := store.CompareAndSwap(key, nil, storage.Value("a")); err != nil {
+		t.Fatalf("failed to create %q: %v", key, err)
+	}
+
+	if err := store.CompareAndSwap(key, nil, storage.Value("b")); !storage.ErrValueChanged.Has(err) {
+		t.Fatalf("creating an existing key should fail with value changed: %v", err)
+	}
+
+	if err := store.CompareAndSwap(key, storage.Value("b"), storage.Value("c")); !storage.ErrValueChanged.Has(err) {
+		t.Fatalf("swapping a different value should fail with value changed: %v", err)
+	}
+
+	if err := store.CompareAndSwap(key, storage.Value("a"), storage.Value("b")); err != nil {
+		t.Fatalf("failed to swap %q: %v", key, err)
+	}
+
+	value, err := store.Get(key)
+	if err != nil {
+		t.Fatalf("failed to get %q: %v", key, err)
+	}
+	if !bytes.Equal(value, storage.Value("b")) {
+		t.Fatalf("invalid value for %q: got %q", key, value)
+	}
+
+	if err := store.CompareAndSwap(key, storage.Value("b"), nil); err != nil {
+		t.Fatalf("failed to delete %q: %v", key, err)
+	}
+
+	if _, err := store.Get(key); !storage.ErrKeyNotFound.Has(err) {
+		t.Fatalf("%q should be deleted: %v", key, err)
+	}
+}
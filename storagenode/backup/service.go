@@ -0,0 +1,148 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package backup implements periodic online backups of the storage node database.
+package backup
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+	monkit "gopkg.in/spacemonkeygo/monkit.v2"
+
+	"storj.io/storj/internal/sync2"
+)
+
+var (
+	mon = monkit.Package()
+
+	// Error is the default error class for backup errors
+	Error = errs.Class("backup")
+)
+
+const (
+	backupPrefix = "info-"
+	backupSuffix = ".db"
+	timeFormat   = "20060102T150405Z"
+)
+
+// Config defines parameters for the database backups.
+type Config struct {
+	Dir      string        `help:"directory where the database backups are stored, backups are disabled when empty" default:"$CONFDIR/backups"`
+	Interval time.Duration `help:"how frequently the database is backed up" default:"6h0m0s"`
+	Keep     int           `help:"number of the most recent backups to keep" default:"4"`
+}
+
+// DB is the database which is backed up.
+type DB interface {
+	// Backup writes a consistent copy of the database to path
+	Backup(ctx context.Context, path string) error
+}
+
+// Service periodically backs up the database into the backup directory.
+type Service struct {
+	log    *zap.Logger
+	db     DB
+	config Config
+
+	Loop sync2.Cycle
+}
+
+// NewService creates a new backup service.
+func NewService(log *zap.Logger, db DB, config Config) *Service {
+	return &Service{
+		log:    log,
+		db:     db,
+		config: config,
+		Loop:   *sync2.NewCycle(config.Interval),
+	}
+}
+
+// Run runs the backup service.
+func (service *Service) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if service.config.Dir == "" {
+		service.log.Info("database backups disabled")
+		return nil
+	}
+
+	return service.Loop.Run(ctx, func(ctx context.Context) error {
+		path, err := service.Backup(ctx, time.Now())
+		if err != nil {
+			service.log.Error("database backup failed", zap.Error(err))
+			return nil
+		}
+		service.log.Info("database backed up", zap.String("path", path))
+		return nil
+	})
+}
+
+// Close stops the backup service.
+func (service *Service) Close() (err error) {
+	service.Loop.Close()
+	return nil
+}
+
+// Backup backs up the database and removes the backups exceeding the configured count.
+func (service *Service) Backup(ctx context.Context, now time.Time) (path string, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	path = filepath.Join(service.config.Dir, backupPrefix+now.UTC().Format(timeFormat)+backupSuffix)
+	if err := service.db.Backup(ctx, path); err != nil {
+		return "", Error.Wrap(err)
+	}
+
+	backups, err := List(service.config.Dir)
+	if err != nil {
+		return path, err
+	}
+
+	keep := service.config.Keep
+	if keep < 1 {
+		keep = 1
+	}
+	var group errs.Group
+	for len(backups) > keep {
+		group.Add(os.Remove(backups[0]))
+		backups = backups[1:]
+	}
+	return path, Error.Wrap(group.Err())
+}
+
+// List returns the paths of the backups in dir, ordered from the oldest to the newest.
+func List(dir string) ([]string, error) {
+	matches, err := filepath.Glob(filepath.Join(dir, backupPrefix+"*"+backupSuffix))
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	var backups []string
+	for _, match := range matches {
+		name := strings.TrimSuffix(strings.TrimPrefix(filepath.Base(match), backupPrefix), backupSuffix)
+		if _, err := time.Parse(timeFormat, name); err != nil {
+			continue
+		}
+		backups = append(backups, match)
+	}
+	sort.Strings(backups)
+	return backups, nil
+}
+
+// Latest returns the path of the newest backup in dir.
+func Latest(dir string) (string, error) {
+	backups, err := List(dir)
+	if err != nil {
+		return "", err
+	}
+	if len(backups) == 0 {
+		return "", Error.New("no backups in %q", dir)
+	}
+	return backups[len(backups)-1], nil
+}
@@ -0,0 +1,106 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package backup_test
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/testidentity"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/storagenode/backup"
+	"storj.io/storj/storagenode/storagenodedb"
+)
+
+func TestBackupAndRestore(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	log := zaptest.NewLogger(t)
+	satellite := testidentity.MustPregeneratedSignedIdentity(0, storj.LatestIDVersion())
+
+	config := storagenodedb.Config{
+		Storage:  ctx.Dir("storage"),
+		Info2:    filepath.Join(ctx.Dir("storage"), "info.db"),
+		Pieces:   ctx.Dir("storage"),
+		Kademlia: filepath.Join(ctx.Dir("kademlia"), "kademlia.db"),
+	}
+
+	openDB := func() *storagenodedb.DB {
+		db, err := storagenodedb.New(log, config)
+		require.NoError(t, err)
+		require.NoError(t, db.CreateTables())
+		return db
+	}
+
+	usage := func(db *storagenodedb.DB) int64 {
+		summary, err := db.Bandwidth().Summary(ctx, time.Time{}, time.Now().Add(time.Hour))
+		require.NoError(t, err)
+		return summary.Total()
+	}
+
+	db := openDB()
+	require.NoError(t, db.Bandwidth().Add(ctx, satellite.ID, pb.PieceAction_GET, 100, time.Now()))
+
+	backupDir := ctx.Dir("backups")
+	service := backup.NewService(log, db, backup.Config{Dir: backupDir, Interval: time.Hour, Keep: 2})
+
+	now := time.Now()
+	for i := 0; i < 3; i++ {
+		_, err := service.Backup(ctx, now.Add(time.Duration(i)*time.Minute))
+		require.NoError(t, err)
+	}
+
+	// only the most recent backups are kept
+	backups, err := backup.List(backupDir)
+	require.NoError(t, err)
+	require.Len(t, backups, 2)
+
+	latest, err := backup.Latest(backupDir)
+	require.NoError(t, err)
+	assert.Equal(t, backups[1], latest)
+
+	problems, err := storagenodedb.CheckIntegrityOf(ctx, latest)
+	require.NoError(t, err)
+	assert.Empty(t, problems)
+
+	// changes after the backup are lost when restoring
+	require.NoError(t, db.Bandwidth().Add(ctx, satellite.ID, pb.PieceAction_GET, 50, time.Now()))
+	assert.Equal(t, int64(150), usage(db))
+
+	// the database can't be restored while it is in use
+	assert.Error(t, storagenodedb.Restore(ctx, latest, config.Info2))
+	require.NoError(t, db.Close())
+
+	require.NoError(t, storagenodedb.Restore(ctx, latest, config.Info2))
+
+	db = openDB()
+	defer ctx.Check(db.Close)
+	assert.Equal(t, int64(100), usage(db))
+
+	problems, err = db.CheckIntegrity(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, problems)
+
+	// corrupt backups are not restored
+	corrupt := filepath.Join(backupDir, "corrupt.db")
+	require.NoError(t, ioutil.WriteFile(corrupt, []byte("not a database"), 0600))
+	assert.Error(t, storagenodedb.Restore(ctx, corrupt, config.Info2))
+
+	// corrupt databases are replaced
+	corruptInfo := filepath.Join(ctx.Dir("corrupt"), "info.db")
+	require.NoError(t, ioutil.WriteFile(corruptInfo, []byte("not a database"), 0600))
+	assert.NoError(t, storagenodedb.Restore(ctx, latest, corruptInfo))
+
+	_, err = backup.Latest(ctx.Dir("empty"))
+	assert.Error(t, err)
+}
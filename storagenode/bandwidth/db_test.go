@@ -97,5 +97,25 @@ func TestDB(t *testing.T) {
 		usageBySatellite, err = bandwidthdb.SummaryBySatellite(ctx, now.Add(time.Hour), now.Add(10*time.Hour))
 		require.NoError(t, err)
 		require.Equal(t, expectedUsageBySatellite, usageBySatellite)
+
+		// usage grouped by day
+		day := time.Date(2019, 5, 1, 0, 0, 0, 0, time.UTC)
+		require.NoError(t, bandwidthdb.Add(ctx, satellite0, pb.PieceAction_GET, 100, day.Add(time.Hour)))
+		require.NoError(t, bandwidthdb.Add(ctx, satellite1, pb.PieceAction_GET, 200, day.Add(23*time.Hour)))
+		require.NoError(t, bandwidthdb.Add(ctx, satellite0, pb.PieceAction_PUT, 300, day.Add(49*time.Hour)))
+
+		usageByDay, err := bandwidthdb.SummaryByDay(ctx, day, day.Add(72*time.Hour))
+		require.NoError(t, err)
+		require.Equal(t, map[time.Time]*bandwidth.Usage{
+			day:                     {Get: 300},
+			day.Add(48 * time.Hour): {Put: 300},
+		}, usageByDay)
+
+		// first usage of each satellite
+		first, err := bandwidthdb.FirstUsageBySatellite(ctx)
+		require.NoError(t, err)
+		require.Len(t, first, 2)
+		require.True(t, first[satellite0].Equal(day.Add(time.Hour)), first[satellite0])
+		require.True(t, first[satellite1].Equal(day.Add(23*time.Hour)), first[satellite1])
 	})
 }
@@ -16,19 +16,23 @@ type DB interface {
 	Add(ctx context.Context, satelliteID storj.NodeID, action pb.PieceAction, amount int64, created time.Time) error
 	Summary(ctx context.Context, from, to time.Time) (*Usage, error)
 	SummaryBySatellite(ctx context.Context, from, to time.Time) (map[storj.NodeID]*Usage, error)
+	// SummaryByDay returns the usage of each UTC day, the days are truncated to midnight
+	SummaryByDay(ctx context.Context, from, to time.Time) (map[time.Time]*Usage, error)
+	// FirstUsageBySatellite returns when the bandwidth of each satellite was used for the first time
+	FirstUsageBySatellite(ctx context.Context) (map[storj.NodeID]time.Time, error)
 }
 
 // Usage contains bandwidth usage information based on the type
 type Usage struct {
-	Invalid int64
-	Unknown int64
+	Invalid int64 `json:"invalid"`
+	Unknown int64 `json:"unknown"`
 
-	Put       int64
-	Get       int64
-	GetAudit  int64
-	GetRepair int64
-	PutRepair int64
-	Delete    int64
+	Put       int64 `json:"put"`
+	Get       int64 `json:"get"`
+	GetAudit  int64 `json:"getAudit"`
+	GetRepair int64 `json:"getRepair"`
+	PutRepair int64 `json:"putRepair"`
+	Delete    int64 `json:"delete"`
 }
 
 // Include adds specified action to the appropriate field.
@@ -82,6 +86,11 @@ func TotalMonthlySummary(ctx context.Context, db DB) (*Usage, error) {
 	return db.Summary(ctx, getBeginningOfMonth(), time.Now())
 }
 
+// MonthlySummaryBySatellite returns bandwidth usage of each satellite for current month
+func MonthlySummaryBySatellite(ctx context.Context, db DB) (map[storj.NodeID]*Usage, error) {
+	return db.SummaryBySatellite(ctx, getBeginningOfMonth(), time.Now())
+}
+
 func getBeginningOfMonth() time.Time {
 	t := time.Now()
 	y, m, _ := t.Date()
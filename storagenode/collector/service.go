@@ -1,7 +1,8 @@
 // Copyright (C) 2019 Storj Labs, Inc.
 // See LICENSE for copying information.
 
-// Package collector implements expired piece deletion from storage node.
+// Package collector implements expired piece deletion from storage node
+// and emptying the trash of deleted pieces.
 package collector
 
 import (
@@ -13,14 +14,17 @@ import (
 
 	"storj.io/storj/internal/memory"
 	"storj.io/storj/internal/sync2"
+	"storj.io/storj/pkg/storj"
 	"storj.io/storj/storagenode/pieces"
+	"storj.io/storj/storagenode/trust"
 )
 
 var mon = monkit.Package()
 
 // Config defines parameters for storage node Collector.
 type Config struct {
-	Interval time.Duration `help:"how frequently expired pieces are collected" default:"1h0m0s"`
+	Interval       time.Duration `help:"how frequently expired pieces are collected" default:"1h0m0s"`
+	TrashRetention time.Duration `help:"how long deleted pieces are kept in the trash before they are permanently deleted" default:"168h0m0s"`
 }
 
 // Service implements collecting expired pieces on the storage node.
@@ -28,16 +32,20 @@ type Service struct {
 	log        *zap.Logger
 	pieces     *pieces.Store
 	pieceinfos pieces.DB
+	trust      *trust.Pool
+	config     Config
 
 	Loop sync2.Cycle
 }
 
 // NewService creates a new collector service.
-func NewService(log *zap.Logger, pieces *pieces.Store, pieceinfos pieces.DB, config Config) *Service {
+func NewService(log *zap.Logger, pieces *pieces.Store, pieceinfos pieces.DB, trust *trust.Pool, config Config) *Service {
 	return &Service{
 		log:        log,
 		pieces:     pieces,
 		pieceinfos: pieceinfos,
+		trust:      trust,
+		config:     config,
 		Loop:       *sync2.NewCycle(config.Interval),
 	}
 }
@@ -61,10 +69,26 @@ func (service *Service) Close() (err error) {
 	return nil
 }
 
-// Collect collects pieces that have expired by now.
+// Collect moves pieces that have expired by now and the pieces of satellites
+// removed from the trust sources into the trash and empties the trash of the
+// pieces deleted before the trash retention.
 func (service *Service) Collect(ctx context.Context, now time.Time) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
+	if err := service.pieces.EmptyTrash(ctx, now.Add(-service.config.TrashRetention)); err != nil {
+		service.log.Error("unable to empty trash", zap.Error(err))
+	}
+
+	if service.trust != nil {
+		for _, satellite := range service.trust.GetRemovedSatellites(ctx) {
+			if err := service.cleanupSatellite(ctx, satellite, now); err != nil {
+				service.log.Error("unable to clean up removed satellite", zap.Stringer("satellite id", satellite), zap.Error(err))
+				continue
+			}
+			service.trust.ForgetSatellite(ctx, satellite)
+		}
+	}
+
 	const maxBatches = 100
 	const batchSize = 1000
 
@@ -86,7 +110,7 @@ func (service *Service) Collect(ctx context.Context, now time.Time) (err error)
 		}
 
 		for _, expired := range infos {
-			err := service.pieces.Delete(ctx, expired.SatelliteID, expired.PieceID)
+			err := service.pieces.Trash(ctx, expired.SatelliteID, expired.PieceID)
 			if err != nil {
 				errfailed := service.pieceinfos.DeleteFailed(ctx, expired.SatelliteID, expired.PieceID, now)
 				if errfailed != nil {
@@ -109,3 +133,35 @@ func (service *Service) Collect(ctx context.Context, now time.Time) (err error)
 
 	return nil
 }
+
+// cleanupSatellite moves all the pieces of a satellite into the trash.
+func (service *Service) cleanupSatellite(ctx context.Context, satellite storj.NodeID, now time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	const batchSize = 1000
+
+	var count int64
+	defer func() {
+		service.log.Info("removed satellite cleaned up", zap.Stringer("satellite id", satellite), zap.Int64("count", count))
+	}()
+
+	for {
+		pieceIDs, err := service.pieceinfos.GetPieceIDs(ctx, satellite, now, batchSize, 0)
+		if err != nil {
+			return err
+		}
+		if len(pieceIDs) == 0 {
+			return nil
+		}
+
+		for _, pieceID := range pieceIDs {
+			if err := service.pieces.Trash(ctx, satellite, pieceID); err != nil {
+				return err
+			}
+			if err := service.pieceinfos.Delete(ctx, satellite, pieceID); err != nil {
+				return err
+			}
+			count++
+		}
+	}
+}
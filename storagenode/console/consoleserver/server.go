@@ -0,0 +1,222 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package consoleserver implements the JSON dashboard API of the storage node.
+package consoleserver
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+
+	"storj.io/storj/internal/errs2"
+	"storj.io/storj/storagenode/console"
+)
+
+const (
+	contentType = "Content-Type"
+
+	applicationJSON = "application/json"
+
+	monthFormat = "2006-01"
+)
+
+// Error is storage node console web error type
+var Error = errs.Class("storagenode console web error")
+
+// Config contains configuration for storage node console web server
+type Config struct {
+	Address string `help:"server address of the JSON dashboard API, empty disables it" default:""`
+}
+
+// Server represents storage node console web server
+type Server struct {
+	log *zap.Logger
+
+	service  *console.Service
+	listener net.Listener
+
+	server http.Server
+}
+
+// NewServer creates new instance of storage node console web server
+func NewServer(logger *zap.Logger, service *console.Service, listener net.Listener) *Server {
+	server := Server{
+		log:      logger,
+		service:  service,
+		listener: listener,
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/api/node", server.handler(server.nodeHandler))
+	mux.Handle("/api/disk", server.handler(server.diskHandler))
+	mux.Handle("/api/bandwidth", server.handler(server.bandwidthHandler))
+	mux.Handle("/api/orders", server.handler(server.ordersHandler))
+	mux.Handle("/api/satellites", server.handler(server.satellitesHandler))
+	mux.Handle("/api/earnings", server.handler(server.earningsHandler))
+
+	server.server = http.Server{
+		Handler: mux,
+	}
+
+	return &server
+}
+
+// handler wraps a function returning the response data into a JSON http handler
+func (server *Server) handler(fn func(req *http.Request) (interface{}, int, error)) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set(contentType, applicationJSON)
+
+		if req.Method != http.MethodGet {
+			server.writeError(w, http.StatusMethodNotAllowed, Error.New("method not allowed"))
+			return
+		}
+
+		data, status, err := fn(req)
+		if err != nil {
+			server.writeError(w, status, err)
+			return
+		}
+
+		if err := json.NewEncoder(w).Encode(data); err != nil {
+			server.log.Error("failed to write json response", zap.Error(err))
+		}
+	})
+}
+
+// writeError writes the error as a JSON response
+func (server *Server) writeError(w http.ResponseWriter, status int, err error) {
+	if status == http.StatusInternalServerError {
+		server.log.Error("dashboard api request failed", zap.Error(err))
+	}
+
+	w.WriteHeader(status)
+	err = json.NewEncoder(w).Encode(struct {
+		Error string `json:"error"`
+	}{
+		Error: err.Error(),
+	})
+	if err != nil {
+		server.log.Error("failed to write json error response", zap.Error(err))
+	}
+}
+
+// nodeHandler returns the uptime and version status of the node
+func (server *Server) nodeHandler(req *http.Request) (interface{}, int, error) {
+	data, err := server.service.GetNode(req.Context())
+	return data, http.StatusInternalServerError, err
+}
+
+// diskHandler returns the disk usage per satellite
+func (server *Server) diskHandler(req *http.Request) (interface{}, int, error) {
+	data, err := server.service.GetDiskUsage(req.Context())
+	return data, http.StatusInternalServerError, err
+}
+
+// bandwidthHandler returns the bandwidth usage per action and per day,
+// the period is specified with the from and to RFC3339 query parameters
+// and defaults to the current month.
+func (server *Server) bandwidthHandler(req *http.Request) (interface{}, int, error) {
+	now := time.Now()
+	from := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location())
+	to := now
+
+	query := req.URL.Query()
+	if value := query.Get("from"); value != "" {
+		var err error
+		from, err = time.Parse(time.RFC3339, value)
+		if err != nil {
+			return nil, http.StatusBadRequest, Error.New("invalid from: %v", err)
+		}
+	}
+	if value := query.Get("to"); value != "" {
+		var err error
+		to, err = time.Parse(time.RFC3339, value)
+		if err != nil {
+			return nil, http.StatusBadRequest, Error.New("invalid to: %v", err)
+		}
+	}
+	if to.Before(from) {
+		return nil, http.StatusBadRequest, Error.New("to is before from")
+	}
+
+	data, err := server.service.GetBandwidthUsage(req.Context(), from, to)
+	return data, http.StatusInternalServerError, err
+}
+
+// ordersHandler returns the number of orders by their settlement status
+func (server *Server) ordersHandler(req *http.Request) (interface{}, int, error) {
+	data, err := server.service.GetOrderStatus(req.Context())
+	return data, http.StatusInternalServerError, err
+}
+
+// earningsHandler returns the estimated earnings per satellite and month,
+// the months are specified with the from and to query parameters in the
+// form 2006-01 and default to the current month.
+func (server *Server) earningsHandler(req *http.Request) (interface{}, int, error) {
+	now := time.Now().UTC()
+	from := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+	to := from
+
+	query := req.URL.Query()
+	if value := query.Get("from"); value != "" {
+		var err error
+		from, err = time.Parse(monthFormat, value)
+		if err != nil {
+			return nil, http.StatusBadRequest, Error.New("invalid from: %v", err)
+		}
+	}
+	if value := query.Get("to"); value != "" {
+		var err error
+		to, err = time.Parse(monthFormat, value)
+		if err != nil {
+			return nil, http.StatusBadRequest, Error.New("invalid to: %v", err)
+		}
+	}
+	if to.Before(from) {
+		return nil, http.StatusBadRequest, Error.New("to is before from")
+	}
+
+	data, err := server.service.GetEarnings(req.Context(), from, to)
+	return data, http.StatusInternalServerError, err
+}
+
+// satellitesHandler returns the trusted satellites
+func (server *Server) satellitesHandler(req *http.Request) (interface{}, int, error) {
+	data, err := server.service.GetSatellites(req.Context())
+	return data, http.StatusInternalServerError, err
+}
+
+// Run starts the server that hosts the dashboard api
+func (server *Server) Run(ctx context.Context) error {
+	ctx, cancel := context.WithCancel(ctx)
+	var group errgroup.Group
+	group.Go(func() error {
+		<-ctx.Done()
+		return errs2.IgnoreCanceled(server.server.Shutdown(context.Background()))
+	})
+	group.Go(func() error {
+		defer cancel()
+		err := server.server.Serve(server.listener)
+		if err == http.ErrServerClosed {
+			return nil
+		}
+		return err
+	})
+
+	return group.Wait()
+}
+
+// Close closes server and underlying listener
+func (server *Server) Close() error {
+	return server.server.Close()
+}
+
+// Addr returns the address the server is listening on
+func (server *Server) Addr() string { return server.listener.Addr().String() }
@@ -0,0 +1,116 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package consoleserver_test
+
+import (
+	"encoding/json"
+	"math/rand"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/internal/memory"
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/testplanet"
+	"storj.io/storj/storagenode/console"
+	"storj.io/storj/storagenode/orders"
+)
+
+func TestDashboardAPI(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+
+		expectedData := make([]byte, 100*memory.KiB)
+		_, err := rand.Read(expectedData)
+		require.NoError(t, err)
+
+		err = planet.Uplinks[0].Upload(ctx, satellite, "testbucket", "test/path", expectedData)
+		require.NoError(t, err)
+
+		for _, storageNode := range planet.StorageNodes {
+			baseURL := "http://" + storageNode.Console.Endpoint.Addr()
+
+			get := func(path string, status int, data interface{}) {
+				resp, err := http.Get(baseURL + path)
+				require.NoError(t, err)
+				defer ctx.Check(resp.Body.Close)
+
+				require.Equal(t, status, resp.StatusCode)
+				require.Equal(t, "application/json", resp.Header.Get("Content-Type"))
+				require.NoError(t, json.NewDecoder(resp.Body).Decode(data))
+			}
+
+			var node console.Node
+			get("/api/node", http.StatusOK, &node)
+			assert.Equal(t, storageNode.ID(), node.NodeID)
+			assert.True(t, node.Uptime > 0)
+			assert.True(t, node.VersionAllowed)
+
+			var disk console.DiskUsage
+			get("/api/disk", http.StatusOK, &disk)
+			assert.Equal(t, disk.Allocated-disk.Used, disk.Available)
+			var satelliteUsed int64
+			for _, usage := range disk.BySatellite {
+				assert.Equal(t, satellite.ID(), usage.SatelliteID)
+				satelliteUsed += usage.Used
+			}
+			assert.Equal(t, disk.Used, satelliteUsed)
+
+			var bandwidth console.BandwidthUsage
+			get("/api/bandwidth", http.StatusOK, &bandwidth)
+			assert.Equal(t, bandwidth.ByAction.Total(), bandwidth.Total)
+			var dailyTotal int64
+			for _, day := range bandwidth.ByDay {
+				dailyTotal += day.Usage.Total()
+			}
+			assert.Equal(t, bandwidth.Total, dailyTotal)
+			if disk.Used > 0 {
+				assert.True(t, bandwidth.ByAction.Put > 0)
+			}
+
+			var empty console.BandwidthUsage
+			from := time.Now().Add(-48 * time.Hour).UTC()
+			get("/api/bandwidth?"+url.Values{
+				"from": {from.Format(time.RFC3339)},
+				"to":   {from.Add(time.Hour).Format(time.RFC3339)},
+			}.Encode(), http.StatusOK, &empty)
+			assert.Equal(t, int64(0), empty.Total)
+			assert.Empty(t, empty.ByDay)
+
+			var invalid struct {
+				Error string `json:"error"`
+			}
+			get("/api/bandwidth?from=yesterday", http.StatusBadRequest, &invalid)
+			assert.NotEmpty(t, invalid.Error)
+
+			counts, err := storageNode.DB.Orders().CountByStatus(ctx)
+			require.NoError(t, err)
+			var orderStatus console.OrderStatus
+			get("/api/orders", http.StatusOK, &orderStatus)
+			assert.Equal(t, counts[orders.StatusUnsent], orderStatus.Unsent)
+			assert.Equal(t, counts[orders.StatusAccepted], orderStatus.Accepted)
+			assert.Equal(t, counts[orders.StatusRejected], orderStatus.Rejected)
+			assert.Equal(t, counts[orders.StatusExpired], orderStatus.Expired)
+			assert.NotNil(t, orderStatus.RejectReasons)
+
+			var earnings console.Earnings
+			get("/api/earnings", http.StatusOK, &earnings)
+			assert.NotNil(t, earnings.BySatellite)
+			assert.InDelta(t, earnings.Total, earnings.HeldBack+earnings.Payable, 1e-9)
+
+			get("/api/earnings?from=2019-02&to=2019-01", http.StatusBadRequest, &invalid)
+			assert.NotEmpty(t, invalid.Error)
+
+			var satellites console.Satellites
+			get("/api/satellites", http.StatusOK, &satellites)
+			assert.Contains(t, satellites.Satellites, satellite.ID())
+		}
+	})
+}
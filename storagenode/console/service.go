@@ -0,0 +1,272 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package console implements the data sources of the storage node dashboard API.
+package console
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+	monkit "gopkg.in/spacemonkeygo/monkit.v2"
+
+	"storj.io/storj/internal/version"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/storagenode/bandwidth"
+	"storj.io/storj/storagenode/earnings"
+	"storj.io/storj/storagenode/orders"
+	"storj.io/storj/storagenode/pieces"
+	"storj.io/storj/storagenode/piecestore"
+	"storj.io/storj/storagenode/trust"
+)
+
+var (
+	mon = monkit.Package()
+
+	// Error is the default error class for storage node console errors
+	Error = errs.Class("storagenode console")
+)
+
+// Service provides the data of the storage node dashboard.
+type Service struct {
+	log       *zap.Logger
+	nodeID    storj.NodeID
+	pieceInfo pieces.DB
+	bandwidth bandwidth.DB
+	orders    orders.DB
+	trust     *trust.Pool
+	earnings  *earnings.Service
+
+	version     *version.Service
+	versionInfo version.Info
+
+	config    piecestore.OldConfig
+	startTime time.Time
+}
+
+// NewService creates a new storage node console service.
+func NewService(log *zap.Logger, nodeID storj.NodeID, pieceInfo pieces.DB, bandwidth bandwidth.DB, orders orders.DB, trust *trust.Pool, earnings *earnings.Service, versionService *version.Service, versionInfo version.Info, config piecestore.OldConfig) *Service {
+	return &Service{
+		log:         log,
+		nodeID:      nodeID,
+		pieceInfo:   pieceInfo,
+		bandwidth:   bandwidth,
+		orders:      orders,
+		trust:       trust,
+		earnings:    earnings,
+		version:     versionService,
+		versionInfo: versionInfo,
+		config:      config,
+		startTime:   time.Now(),
+	}
+}
+
+// Node contains general information about the storage node.
+type Node struct {
+	NodeID    storj.NodeID  `json:"nodeID"`
+	StartedAt time.Time     `json:"startedAt"`
+	Uptime    time.Duration `json:"uptime"`
+
+	Version        version.Info `json:"version"`
+	VersionAllowed bool         `json:"versionAllowed"`
+}
+
+// GetNode returns general information about the storage node.
+func (service *Service) GetNode(ctx context.Context) (_ *Node, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return &Node{
+		NodeID:         service.nodeID,
+		StartedAt:      service.startTime,
+		Uptime:         time.Since(service.startTime),
+		Version:        service.versionInfo,
+		VersionAllowed: service.version.IsAllowed(),
+	}, nil
+}
+
+// SatelliteDiskUsage contains the disk space used by the pieces of a satellite.
+type SatelliteDiskUsage struct {
+	SatelliteID storj.NodeID `json:"satelliteID"`
+	Used        int64        `json:"used"`
+}
+
+// DiskUsage contains the disk space usage of the storage node.
+type DiskUsage struct {
+	Allocated   int64                `json:"allocated"`
+	Used        int64                `json:"used"`
+	Available   int64                `json:"available"`
+	BySatellite []SatelliteDiskUsage `json:"bySatellite"`
+}
+
+// GetDiskUsage returns the disk space usage of the storage node.
+func (service *Service) GetDiskUsage(ctx context.Context) (_ *DiskUsage, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	allocated, err := service.config.TotalAllocatedDiskSpace()
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	bySatellite, err := service.pieceInfo.SpaceUsedBySatellite(ctx)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	usage := &DiskUsage{
+		Allocated:   allocated.Int64(),
+		BySatellite: []SatelliteDiskUsage{},
+	}
+	for satelliteID, used := range bySatellite {
+		usage.Used += used
+		usage.BySatellite = append(usage.BySatellite, SatelliteDiskUsage{
+			SatelliteID: satelliteID,
+			Used:        used,
+		})
+	}
+	sort.Slice(usage.BySatellite, func(i, k int) bool {
+		return usage.BySatellite[i].SatelliteID.Less(usage.BySatellite[k].SatelliteID)
+	})
+	usage.Available = usage.Allocated - usage.Used
+
+	return usage, nil
+}
+
+// DailyBandwidthUsage contains the bandwidth used during a day.
+type DailyBandwidthUsage struct {
+	Day   time.Time       `json:"day"`
+	Usage bandwidth.Usage `json:"usage"`
+}
+
+// BandwidthUsage contains the bandwidth usage of the storage node for a period.
+type BandwidthUsage struct {
+	From      time.Time             `json:"from"`
+	To        time.Time             `json:"to"`
+	Allocated int64                 `json:"allocated"`
+	Total     int64                 `json:"total"`
+	ByAction  bandwidth.Usage       `json:"byAction"`
+	ByDay     []DailyBandwidthUsage `json:"byDay"`
+}
+
+// GetBandwidthUsage returns the bandwidth usage between from and to.
+func (service *Service) GetBandwidthUsage(ctx context.Context, from, to time.Time) (_ *BandwidthUsage, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	total, err := service.bandwidth.Summary(ctx, from, to)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	byDay, err := service.bandwidth.SummaryByDay(ctx, from, to)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	usage := &BandwidthUsage{
+		From:      from,
+		To:        to,
+		Allocated: service.config.AllocatedBandwidth.Int64(),
+		Total:     total.Total(),
+		ByAction:  *total,
+		ByDay:     []DailyBandwidthUsage{},
+	}
+	for day, dayUsage := range byDay {
+		usage.ByDay = append(usage.ByDay, DailyBandwidthUsage{
+			Day:   day,
+			Usage: *dayUsage,
+		})
+	}
+	sort.Slice(usage.ByDay, func(i, k int) bool {
+		return usage.ByDay[i].Day.Before(usage.ByDay[k].Day)
+	})
+
+	return usage, nil
+}
+
+// OrderStatus contains the number of orders by their settlement status.
+type OrderStatus struct {
+	Unsent   int64 `json:"unsent"`
+	Accepted int64 `json:"accepted"`
+	Rejected int64 `json:"rejected"`
+	Expired  int64 `json:"expired"`
+	// RejectReasons contains the number of rejected orders by the reason of the rejection.
+	RejectReasons map[string]int64 `json:"rejectReasons"`
+}
+
+// GetOrderStatus returns the number of orders by their settlement status.
+func (service *Service) GetOrderStatus(ctx context.Context) (_ *OrderStatus, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	counts, err := service.orders.CountByStatus(ctx)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	reasons, err := service.orders.CountRejectReasons(ctx)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	return &OrderStatus{
+		Unsent:        counts[orders.StatusUnsent],
+		Accepted:      counts[orders.StatusAccepted],
+		Rejected:      counts[orders.StatusRejected],
+		Expired:       counts[orders.StatusExpired],
+		RejectReasons: reasons,
+	}, nil
+}
+
+// Satellites contains the satellites trusted by the storage node.
+type Satellites struct {
+	// TrustAll is set when all satellites are trusted,
+	// in that case only the satellites seen so far are listed.
+	TrustAll   bool           `json:"trustAll"`
+	Satellites []storj.NodeID `json:"satellites"`
+}
+
+// GetSatellites returns the satellites trusted by the storage node.
+func (service *Service) GetSatellites(ctx context.Context) (_ *Satellites, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return &Satellites{
+		TrustAll:   service.trust.TrustsAll(),
+		Satellites: service.trust.GetSatellites(ctx),
+	}, nil
+}
+
+// Earnings contains the estimated earnings of the storage node.
+type Earnings struct {
+	From  time.Time `json:"from"`
+	To    time.Time `json:"to"`
+	Total float64   `json:"total"`
+	// HeldBack is the part of the total held back by the satellites.
+	HeldBack float64 `json:"heldBack"`
+	Payable  float64 `json:"payable"`
+
+	BySatellite []earnings.SatelliteEarnings `json:"bySatellite"`
+}
+
+// GetEarnings returns the estimated earnings of each satellite in the months between from and to.
+func (service *Service) GetEarnings(ctx context.Context, from, to time.Time) (_ *Earnings, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	bySatellite, err := service.earnings.Estimate(ctx, from, to, time.Now())
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	result := &Earnings{
+		From:        from,
+		To:          to,
+		BySatellite: []earnings.SatelliteEarnings{},
+	}
+	for _, satellite := range bySatellite {
+		result.Total += satellite.Total
+		result.HeldBack += satellite.HeldBack
+		result.Payable += satellite.Payable
+		result.BySatellite = append(result.BySatellite, satellite)
+	}
+	return result, nil
+}
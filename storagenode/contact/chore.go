@@ -0,0 +1,141 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package contact implements checking in with the trusted satellites.
+package contact
+
+import (
+	"context"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+	monkit "gopkg.in/spacemonkeygo/monkit.v2"
+
+	"storj.io/storj/internal/sync2"
+	"storj.io/storj/pkg/kademlia"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/pkg/transport"
+	"storj.io/storj/storagenode/monitor"
+	"storj.io/storj/storagenode/trust"
+)
+
+var (
+	// Error is the default error class for contact.
+	Error = errs.Class("contact")
+
+	// ErrUnreachable is returned when a satellite couldn't ping the node back.
+	ErrUnreachable = errs.Class("node unreachable")
+
+	mon = monkit.Package()
+)
+
+// Config contains configurable values for checking in with the satellites.
+type Config struct {
+	Interval time.Duration `help:"how frequently the node checks in with the trusted satellites" default:"1h0m0s"`
+}
+
+// Chore checks in with every trusted satellite, reporting the address,
+// capacity and version of the node.
+type Chore struct {
+	log       *zap.Logger
+	transport transport.Client
+	kademlia  *kademlia.Kademlia
+	trust     *trust.Pool
+	monitor   *monitor.Service
+
+	Loop sync2.Cycle
+}
+
+// NewChore creates a new contact chore.
+func NewChore(log *zap.Logger, transport transport.Client, kademlia *kademlia.Kademlia, trust *trust.Pool, monitor *monitor.Service, config Config) *Chore {
+	return &Chore{
+		log:       log,
+		transport: transport,
+		kademlia:  kademlia,
+		trust:     trust,
+		monitor:   monitor,
+
+		Loop: *sync2.NewCycle(config.Interval),
+	}
+}
+
+// Run checks in with the trusted satellites on every interval.
+func (chore *Chore) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return chore.Loop.Run(ctx, func(ctx context.Context) error {
+		chore.CheckinAll(ctx)
+		return nil
+	})
+}
+
+// CheckinAll checks in with all the trusted satellites, the errors are only logged.
+func (chore *Chore) CheckinAll(ctx context.Context) {
+	defer mon.Task()(&ctx)(nil)
+
+	var group errgroup.Group
+	for _, satelliteID := range chore.trust.GetSatellites(ctx) {
+		satelliteID := satelliteID
+		group.Go(func() error {
+			err := chore.Checkin(ctx, satelliteID)
+			switch {
+			case ErrUnreachable.Has(err):
+				chore.log.Error("satellite is unable to reach the node, check that the external address is correct and the port is forwarded",
+					zap.Stringer("satellite", satelliteID),
+					zap.String("address", chore.kademlia.Local().Address.GetAddress()),
+					zap.Error(err))
+			case err != nil:
+				chore.log.Warn("unable to check in with satellite", zap.Stringer("satellite", satelliteID), zap.Error(err))
+			}
+			return nil
+		})
+	}
+	_ = group.Wait() // doesn't return errors
+}
+
+// Checkin reports the node info to the satellite. ErrUnreachable is returned
+// when the satellite isn't able to ping the node back.
+func (chore *Chore) Checkin(ctx context.Context, satelliteID storj.NodeID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	self := chore.kademlia.Local()
+
+	capacity, err := chore.monitor.Capacity(ctx, satelliteID)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	satellite, err := chore.kademlia.FindNode(ctx, satelliteID)
+	if err != nil {
+		return Error.New("unable to find satellite on the network: %v", err)
+	}
+
+	conn, err := chore.transport.DialNode(ctx, &satellite)
+	if err != nil {
+		return Error.New("unable to connect to the satellite: %v", err)
+	}
+	defer func() { err = errs.Combine(err, Error.Wrap(conn.Close())) }()
+
+	response, err := pb.NewNodeClient(conn).Checkin(ctx, &pb.CheckinRequest{
+		Address:  self.Address.GetAddress(),
+		Capacity: capacity,
+		Operator: &self.Operator,
+		Version:  &self.Version,
+	})
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	if !response.PingNodeSuccess {
+		return ErrUnreachable.New("%s", response.PingErrorMessage)
+	}
+	return nil
+}
+
+// Close stops the contact chore.
+func (chore *Chore) Close() error {
+	chore.Loop.Close()
+	return nil
+}
@@ -0,0 +1,58 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package contact_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/testplanet"
+	"storj.io/storj/pkg/overlay"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/storagenode"
+	"storj.io/storj/storagenode/contact"
+)
+
+func TestCheckin(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 2, UplinkCount: 0,
+		Reconfigure: testplanet.Reconfigure{
+			StorageNode: func(index int, config *storagenode.Config) {
+				if index == 1 {
+					// nothing is listening on the discard port
+					config.Kademlia.ExternalAddress = "127.0.0.1:9"
+				}
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		reachable, unreachable := planet.StorageNodes[0], planet.StorageNodes[1]
+
+		err := reachable.Contact.Chore.Checkin(ctx, satellite.ID())
+		require.NoError(t, err)
+
+		dossier, err := satellite.Overlay.Service.Get(ctx, reachable.ID())
+		require.NoError(t, err)
+		assert.Equal(t, reachable.Addr(), dossier.Address.Address)
+		assert.Equal(t, pb.NodeType_STORAGE, dossier.Type)
+		assert.True(t, dossier.Capacity.FreeDisk > 0)
+		assert.True(t, satellite.Overlay.Service.IsOnline(dossier))
+
+		err = unreachable.Contact.Chore.Checkin(ctx, satellite.ID())
+		require.Error(t, err)
+		assert.True(t, contact.ErrUnreachable.Has(err), err.Error())
+		assert.Contains(t, err.Error(), "failed to ping the node back at 127.0.0.1:9")
+
+		// the unverified address is not stored
+		dossier, err = satellite.Overlay.Service.Get(ctx, unreachable.ID())
+		if err == nil {
+			assert.NotEqual(t, "127.0.0.1:9", dossier.Address.Address)
+		} else {
+			assert.True(t, overlay.ErrNodeNotFound.Has(err), err.Error())
+		}
+	})
+}
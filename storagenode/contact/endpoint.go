@@ -0,0 +1,33 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package contact
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"storj.io/storj/pkg/identity"
+	"storj.io/storj/pkg/pb"
+)
+
+// Endpoint answers the pings of the satellites verifying that the node is reachable.
+type Endpoint struct {
+	log *zap.Logger
+}
+
+// NewEndpoint creates a new contact endpoint.
+func NewEndpoint(log *zap.Logger) *Endpoint {
+	return &Endpoint{log: log}
+}
+
+// PingNode is called by the satellites after the node checked in.
+func (endpoint *Endpoint) PingNode(ctx context.Context, req *pb.ContactPingRequest) (_ *pb.ContactPingResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if peer, err := identity.PeerIdentityFromContext(ctx); err == nil {
+		endpoint.log.Debug("pinged", zap.Stringer("satellite", peer.ID))
+	}
+	return &pb.ContactPingResponse{}, nil
+}
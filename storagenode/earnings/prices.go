@@ -0,0 +1,106 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package earnings
+
+import (
+	"strconv"
+	"strings"
+
+	"storj.io/storj/pkg/storj"
+)
+
+// Prices contains the payout rates of a satellite in USD.
+type Prices struct {
+	// Egress is paid per TB downloaded by uplinks.
+	Egress float64 `json:"egress"`
+	// Repair is paid per TB downloaded for repairing segments.
+	Repair float64 `json:"repair"`
+	// Audit is paid per TB downloaded for audits.
+	Audit float64 `json:"audit"`
+	// Storage is paid per TB stored for a month.
+	Storage float64 `json:"storage"`
+}
+
+// ParsePrices parses prices in the form egress=20,repair=10,audit=10,storage=1.5,
+// the rates which aren't specified are taken from defaults.
+func ParsePrices(s string, defaults Prices) (Prices, error) {
+	prices := defaults
+	for _, rate := range strings.Split(s, ",") {
+		rate = strings.TrimSpace(rate)
+		if rate == "" {
+			continue
+		}
+
+		parts := strings.SplitN(rate, "=", 2)
+		if len(parts) != 2 {
+			return Prices{}, Error.New("invalid rate %q", rate)
+		}
+
+		value, err := strconv.ParseFloat(strings.TrimSpace(parts[1]), 64)
+		if err != nil || value < 0 {
+			return Prices{}, Error.New("invalid value of rate %q", rate)
+		}
+
+		switch strings.TrimSpace(parts[0]) {
+		case "egress":
+			prices.Egress = value
+		case "repair":
+			prices.Repair = value
+		case "audit":
+			prices.Audit = value
+		case "storage":
+			prices.Storage = value
+		default:
+			return Prices{}, Error.New("unknown rate %q", rate)
+		}
+	}
+	return prices, nil
+}
+
+// ParseSatellitePrices parses semicolon-separated satellite prices in the form
+// id:egress=20,storage=1.5, the rates which aren't specified are taken from defaults.
+func ParseSatellitePrices(s string, defaults Prices) (map[storj.NodeID]Prices, error) {
+	satellites := map[storj.NodeID]Prices{}
+	for _, entry := range strings.Split(s, ";") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		parts := strings.SplitN(entry, ":", 2)
+		if len(parts) != 2 {
+			return nil, Error.New("invalid satellite prices %q", entry)
+		}
+
+		id, err := storj.NodeIDFromString(strings.TrimSpace(parts[0]))
+		if err != nil {
+			return nil, Error.New("invalid satellite id in %q: %v", entry, err)
+		}
+
+		prices, err := ParsePrices(parts[1], defaults)
+		if err != nil {
+			return nil, err
+		}
+		satellites[id] = prices
+	}
+	return satellites, nil
+}
+
+// ParseHeldBack parses the comma-separated percentages held back in each month.
+func ParseHeldBack(s string) ([]float64, error) {
+	var schedule []float64
+	for _, value := range strings.Split(s, ",") {
+		value = strings.TrimSpace(value)
+		if value == "" {
+			continue
+		}
+
+		percent, err := strconv.ParseFloat(value, 64)
+		if err != nil || percent < 0 || percent > 100 {
+			return nil, Error.New("invalid held back percentage %q", value)
+		}
+		schedule = append(schedule, percent)
+	}
+	return schedule, nil
+}
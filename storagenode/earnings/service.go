@@ -0,0 +1,223 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package earnings implements estimating the payouts of the storage node.
+package earnings
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+	monkit "gopkg.in/spacemonkeygo/monkit.v2"
+
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/storagenode/bandwidth"
+	"storj.io/storj/storagenode/orders"
+	"storj.io/storj/storagenode/pieces"
+)
+
+var (
+	mon = monkit.Package()
+
+	// Error is the default error class for earnings errors
+	Error = errs.Class("earnings")
+)
+
+// terabyte is the unit the prices are specified in.
+const terabyte = 1e12
+
+// Config defines the payout rates used for estimating the earnings.
+type Config struct {
+	Prices          string `help:"payout rates in USD: egress, repair and audit per TB downloaded, storage per TB stored for a month" default:"egress=20,repair=10,audit=10,storage=1.5"`
+	SatellitePrices string `help:"semicolon-separated payout rates of satellites overriding the default rates, in the form id:egress=20,storage=1.5" default:""`
+	HeldBack        string `help:"comma-separated percentages of the earnings held back in each month since the first use of a satellite" default:"75,75,75,50,50,50,25,25,25"`
+}
+
+// Service estimates the earnings from the settled orders and the stored pieces.
+type Service struct {
+	log       *zap.Logger
+	orders    orders.DB
+	pieceInfo pieces.DB
+	bandwidth bandwidth.DB
+
+	prices          Prices
+	satellitePrices map[storj.NodeID]Prices
+	heldBack        []float64
+}
+
+// NewService creates a new earnings service.
+func NewService(log *zap.Logger, orders orders.DB, pieceInfo pieces.DB, bandwidth bandwidth.DB, config Config) (*Service, error) {
+	prices, err := ParsePrices(config.Prices, Prices{})
+	if err != nil {
+		return nil, err
+	}
+
+	satellitePrices, err := ParseSatellitePrices(config.SatellitePrices, prices)
+	if err != nil {
+		return nil, err
+	}
+
+	heldBack, err := ParseHeldBack(config.HeldBack)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Service{
+		log:             log,
+		orders:          orders,
+		pieceInfo:       pieceInfo,
+		bandwidth:       bandwidth,
+		prices:          prices,
+		satellitePrices: satellitePrices,
+		heldBack:        heldBack,
+	}, nil
+}
+
+// SatelliteEarnings contains the estimated earnings from a satellite in a month.
+type SatelliteEarnings struct {
+	SatelliteID storj.NodeID `json:"satelliteID"`
+	Month       time.Time    `json:"month"`
+	Prices      Prices       `json:"prices"`
+
+	EgressBytes      int64   `json:"egressBytes"`
+	RepairBytes      int64   `json:"repairBytes"`
+	AuditBytes       int64   `json:"auditBytes"`
+	StorageByteHours float64 `json:"storageByteHours"`
+
+	// Egress, Repair, Audit and Storage are the earnings in USD.
+	Egress  float64 `json:"egress"`
+	Repair  float64 `json:"repair"`
+	Audit   float64 `json:"audit"`
+	Storage float64 `json:"storage"`
+
+	Total float64 `json:"total"`
+	// HeldBackPercent is the percentage of the total held back by the satellite.
+	HeldBackPercent float64 `json:"heldBackPercent"`
+	HeldBack        float64 `json:"heldBack"`
+	Payable         float64 `json:"payable"`
+}
+
+// Estimate returns the estimated earnings of each satellite in the months
+// between from and to, the months are truncated to UTC calendar months.
+//
+// The bandwidth earnings include only the orders accepted by the satellites.
+// The storage earnings include only the pieces which are currently stored,
+// hence the estimate is a lower bound when pieces have been deleted.
+func (service *Service) Estimate(ctx context.Context, from, to, now time.Time) (_ []SatelliteEarnings, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	firstUsage, err := service.bandwidth.FirstUsageBySatellite(ctx)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	var all []SatelliteEarnings
+	for month := monthOf(from); !month.After(to); month = month.AddDate(0, 1, 0) {
+		earnings, err := service.estimateMonth(ctx, month, now, firstUsage)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, earnings...)
+	}
+	return all, nil
+}
+
+// estimateMonth returns the estimated earnings of each satellite in the month.
+func (service *Service) estimateMonth(ctx context.Context, month, now time.Time, firstUsage map[storj.NodeID]time.Time) (_ []SatelliteEarnings, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	monthEnd := month.AddDate(0, 1, 0)
+	hoursInMonth := monthEnd.Sub(month).Hours()
+
+	settled, err := service.orders.SumArchived(ctx, orders.StatusAccepted, month, monthEnd)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	storageEnd := monthEnd
+	if now.Before(storageEnd) {
+		storageEnd = now
+	}
+	byteHours, err := service.pieceInfo.SpaceUsedByteHours(ctx, month, storageEnd)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	satellites := map[storj.NodeID]struct{}{}
+	for satelliteID := range settled {
+		satellites[satelliteID] = struct{}{}
+	}
+	for satelliteID := range byteHours {
+		satellites[satelliteID] = struct{}{}
+	}
+
+	var all []SatelliteEarnings
+	for satelliteID := range satellites {
+		prices := service.pricesOf(satelliteID)
+		amounts := settled[satelliteID]
+
+		earnings := SatelliteEarnings{
+			SatelliteID: satelliteID,
+			Month:       month,
+			Prices:      prices,
+
+			EgressBytes:      amounts[pb.PieceAction_GET],
+			RepairBytes:      amounts[pb.PieceAction_GET_REPAIR],
+			AuditBytes:       amounts[pb.PieceAction_GET_AUDIT],
+			StorageByteHours: byteHours[satelliteID],
+		}
+
+		earnings.Egress = float64(earnings.EgressBytes) / terabyte * prices.Egress
+		earnings.Repair = float64(earnings.RepairBytes) / terabyte * prices.Repair
+		earnings.Audit = float64(earnings.AuditBytes) / terabyte * prices.Audit
+		earnings.Storage = earnings.StorageByteHours / hoursInMonth / terabyte * prices.Storage
+		earnings.Total = earnings.Egress + earnings.Repair + earnings.Audit + earnings.Storage
+
+		first, ok := firstUsage[satelliteID]
+		if !ok {
+			first = month
+		}
+		earnings.HeldBackPercent = service.heldBackPercent(monthsBetween(monthOf(first), month))
+		earnings.HeldBack = earnings.Total * earnings.HeldBackPercent / 100
+		earnings.Payable = earnings.Total - earnings.HeldBack
+
+		all = append(all, earnings)
+	}
+
+	sort.Slice(all, func(i, k int) bool {
+		return all[i].SatelliteID.Less(all[k].SatelliteID)
+	})
+	return all, nil
+}
+
+// pricesOf returns the prices of the satellite.
+func (service *Service) pricesOf(satelliteID storj.NodeID) Prices {
+	if prices, ok := service.satellitePrices[satelliteID]; ok {
+		return prices
+	}
+	return service.prices
+}
+
+// heldBackPercent returns the percentage held back in the month with the
+// index since the first use of the satellite.
+func (service *Service) heldBackPercent(index int) float64 {
+	if index < 0 || index >= len(service.heldBack) {
+		return 0
+	}
+	return service.heldBack[index]
+}
+
+// monthOf returns the start of the UTC month of t.
+func monthOf(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+}
+
+// monthsBetween returns the number of months from the start of month from to the start of month to.
+func monthsBetween(from, to time.Time) int {
+	return (to.Year()-from.Year())*12 + int(to.Month()) - int(from.Month())
+}
@@ -0,0 +1,178 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package earnings_test
+
+import (
+	"crypto/rand"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/testidentity"
+	"storj.io/storj/pkg/auth/signing"
+	"storj.io/storj/pkg/identity"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/storagenode"
+	"storj.io/storj/storagenode/earnings"
+	"storj.io/storj/storagenode/orders"
+	"storj.io/storj/storagenode/pieces"
+	"storj.io/storj/storagenode/storagenodedb/storagenodedbtest"
+)
+
+const terabyte = 1e12
+
+func TestParsePrices(t *testing.T) {
+	defaults := earnings.Prices{Egress: 20, Repair: 10, Audit: 10, Storage: 1.5}
+
+	prices, err := earnings.ParsePrices(" egress=30, storage=2 ", defaults)
+	require.NoError(t, err)
+	assert.Equal(t, earnings.Prices{Egress: 30, Repair: 10, Audit: 10, Storage: 2}, prices)
+
+	for _, invalid := range []string{"egress", "egress=x", "egress=-1", "bandwidth=1"} {
+		_, err := earnings.ParsePrices(invalid, defaults)
+		assert.Error(t, err, invalid)
+	}
+
+	id := testidentity.MustPregeneratedSignedIdentity(0, storj.LatestIDVersion()).ID
+	satellites, err := earnings.ParseSatellitePrices(id.String()+":repair=5;", defaults)
+	require.NoError(t, err)
+	assert.Equal(t, map[storj.NodeID]earnings.Prices{
+		id: {Egress: 20, Repair: 5, Audit: 10, Storage: 1.5},
+	}, satellites)
+
+	_, err = earnings.ParseSatellitePrices("invalid:egress=1", defaults)
+	assert.Error(t, err)
+
+	heldBack, err := earnings.ParseHeldBack("75, 50,25")
+	require.NoError(t, err)
+	assert.Equal(t, []float64{75, 50, 25}, heldBack)
+
+	_, err = earnings.ParseHeldBack("101")
+	assert.Error(t, err)
+}
+
+func TestEstimate(t *testing.T) {
+	storagenodedbtest.Run(t, func(t *testing.T, db storagenode.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		storagenode := testidentity.MustPregeneratedSignedIdentity(0, storj.LatestIDVersion())
+		satellite0 := testidentity.MustPregeneratedSignedIdentity(1, storj.LatestIDVersion())
+		satellite1 := testidentity.MustPregeneratedSignedIdentity(2, storj.LatestIDVersion())
+		uplink := testidentity.MustPregeneratedSignedIdentity(3, storj.LatestIDVersion())
+
+		now := time.Now().UTC()
+		month := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+		hoursInMonth := month.AddDate(0, 1, 0).Sub(month).Hours()
+
+		// satellite0 has been used for four months, satellite1 is new
+		require.NoError(t, db.Bandwidth().Add(ctx, satellite0.ID, pb.PieceAction_PUT, 1, month.AddDate(0, -4, 0)))
+
+		// a piece stored since before the start of the month
+		piecehash, err := signing.SignPieceHash(signing.SignerFromFullIdentity(uplink), &pb.PieceHash{
+			PieceId: storj.NewPieceID(),
+			Hash:    []byte{1, 2, 3, 4, 5},
+		})
+		require.NoError(t, err)
+		require.NoError(t, db.PieceInfo().Add(ctx, &pieces.Info{
+			SatelliteID:     satellite0.ID,
+			PieceID:         piecehash.PieceId,
+			PieceSize:       terabyte,
+			PieceCreation:   month.AddDate(0, 0, -1),
+			UplinkPieceHash: piecehash,
+			Uplink:          uplink.PeerIdentity(),
+		}))
+
+		settle := func(satellite *identity.FullIdentity, action pb.PieceAction, amount int64, status orders.Status) {
+			var serialNumber storj.SerialNumber
+			_, err := rand.Read(serialNumber[:])
+			require.NoError(t, err)
+
+			expiration, err := ptypes.TimestampProto(now.Add(time.Hour))
+			require.NoError(t, err)
+
+			limit, err := signing.SignOrderLimit(signing.SignerFromFullIdentity(satellite), &pb.OrderLimit2{
+				SerialNumber:    serialNumber,
+				SatelliteId:     satellite.ID,
+				UplinkId:        uplink.ID,
+				StorageNodeId:   storagenode.ID,
+				PieceId:         storj.NewPieceID(),
+				Limit:           amount,
+				Action:          action,
+				PieceExpiration: expiration,
+				OrderExpiration: expiration,
+			})
+			require.NoError(t, err)
+
+			order, err := signing.SignOrder(signing.SignerFromFullIdentity(uplink), &pb.Order2{
+				SerialNumber: serialNumber,
+				Amount:       amount,
+			})
+			require.NoError(t, err)
+
+			require.NoError(t, db.Orders().Enqueue(ctx, &orders.Info{
+				Limit:  limit,
+				Order:  order,
+				Uplink: uplink.PeerIdentity(),
+			}))
+			require.NoError(t, db.Orders().Archive(ctx, satellite.ID, serialNumber, status, ""))
+		}
+
+		settle(satellite0, pb.PieceAction_GET, terabyte, orders.StatusAccepted)
+		settle(satellite0, pb.PieceAction_GET_AUDIT, terabyte/2, orders.StatusAccepted)
+		settle(satellite1, pb.PieceAction_GET, terabyte, orders.StatusAccepted)
+		settle(satellite1, pb.PieceAction_GET_REPAIR, terabyte, orders.StatusAccepted)
+		// rejected orders aren't paid
+		settle(satellite1, pb.PieceAction_GET, terabyte, orders.StatusRejected)
+
+		service, err := earnings.NewService(zaptest.NewLogger(t), db.Orders(), db.PieceInfo(), db.Bandwidth(), earnings.Config{
+			Prices:          "egress=20,repair=10,audit=10,storage=1.5",
+			SatellitePrices: satellite1.ID.String() + ":egress=30",
+			HeldBack:        "75,75,75,50,50,50,25,25,25",
+		})
+		require.NoError(t, err)
+
+		estimates, err := service.Estimate(ctx, now, now, now)
+		require.NoError(t, err)
+		require.Len(t, estimates, 2)
+
+		bySatellite := map[storj.NodeID]earnings.SatelliteEarnings{}
+		for _, estimate := range estimates {
+			assert.Equal(t, month, estimate.Month)
+			assert.InDelta(t, estimate.Total, estimate.HeldBack+estimate.Payable, 1e-9)
+			bySatellite[estimate.SatelliteID] = estimate
+		}
+
+		estimate0 := bySatellite[satellite0.ID]
+		assert.Equal(t, int64(terabyte), estimate0.EgressBytes)
+		assert.Equal(t, int64(terabyte/2), estimate0.AuditBytes)
+		assert.InDelta(t, 20, estimate0.Egress, 1e-9)
+		assert.InDelta(t, 5, estimate0.Audit, 1e-9)
+		// storage is counted only from the start of the month
+		assert.InDelta(t, now.Sub(month).Hours()/hoursInMonth*1.5, estimate0.Storage, 1e-3)
+		assert.Equal(t, float64(50), estimate0.HeldBackPercent)
+
+		estimate1 := bySatellite[satellite1.ID]
+		assert.Equal(t, int64(terabyte), estimate1.EgressBytes)
+		assert.InDelta(t, 30, estimate1.Egress, 1e-9)
+		assert.InDelta(t, 10, estimate1.Repair, 1e-9)
+		assert.Zero(t, estimate1.Storage)
+		assert.InDelta(t, 40, estimate1.Total, 1e-9)
+		assert.Equal(t, float64(75), estimate1.HeldBackPercent)
+		assert.InDelta(t, 10, estimate1.Payable, 1e-9)
+
+		// nothing is earned in the previous months
+		estimates, err = service.Estimate(ctx, month.AddDate(0, -2, 0), month.AddDate(0, -1, 0), now)
+		require.NoError(t, err)
+		for _, estimate := range estimates {
+			assert.Zero(t, estimate.Egress)
+		}
+	})
+}
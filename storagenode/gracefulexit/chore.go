@@ -0,0 +1,248 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package gracefulexit
+
+import (
+	"context"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+
+	"storj.io/storj/internal/sync2"
+	"storj.io/storj/pkg/auth/signing"
+	"storj.io/storj/pkg/kademlia"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/pkg/transport"
+	"storj.io/storj/storagenode/pieces"
+	"storj.io/storj/uplink/piecestore"
+)
+
+// Config contains configurable values for graceful exit.
+type Config struct {
+	ChoreInterval time.Duration `help:"how often to continue the initiated graceful exits" default:"15m0s"`
+}
+
+// Chore transfers the pieces of the satellites the node is exiting from.
+type Chore struct {
+	log    *zap.Logger
+	config Config
+
+	signer    signing.Signer
+	transport transport.Client
+	kademlia  *kademlia.Kademlia
+	store     *pieces.Store
+	pieceinfo pieces.DB
+	db        DB
+
+	Loop sync2.Cycle
+}
+
+// NewChore creates a new graceful exit chore.
+func NewChore(log *zap.Logger, signer signing.Signer, transport transport.Client, kademlia *kademlia.Kademlia, store *pieces.Store, pieceinfo pieces.DB, db DB, config Config) *Chore {
+	return &Chore{
+		log:       log,
+		config:    config,
+		signer:    signer,
+		transport: transport,
+		kademlia:  kademlia,
+		store:     store,
+		pieceinfo: pieceinfo,
+		db:        db,
+
+		Loop: *sync2.NewCycle(config.ChoreInterval),
+	}
+}
+
+// Run continues the unfinished graceful exits on every interval.
+func (chore *Chore) Run(ctx context.Context) error {
+	return chore.Loop.Run(ctx, func(ctx context.Context) error {
+		exits, err := chore.db.ListGracefulExits(ctx)
+		if err != nil {
+			chore.log.Error("listing graceful exits", zap.Error(err))
+			return nil
+		}
+
+		var group errgroup.Group
+		for _, exit := range exits {
+			if exit.FinishedAt != nil {
+				continue
+			}
+			satelliteID := exit.SatelliteID
+			group.Go(func() error {
+				if err := chore.Exit(ctx, satelliteID); err != nil {
+					chore.log.Error("graceful exit", zap.Stringer("satellite", satelliteID), zap.Error(err))
+				}
+				return nil
+			})
+		}
+		_ = group.Wait() // doesn't return errors
+
+		return nil
+	})
+}
+
+// Exit connects to the satellite and transfers the pieces the satellite asks for,
+// until the satellite reports the exit as finished.
+func (chore *Chore) Exit(ctx context.Context, satelliteID storj.NodeID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	log := chore.log.Named(satelliteID.String())
+
+	satellite, err := chore.kademlia.FindNode(ctx, satelliteID)
+	if err != nil {
+		return Error.New("unable to find satellite on the network: %v", err)
+	}
+
+	conn, err := chore.transport.DialNode(ctx, &satellite)
+	if err != nil {
+		return Error.New("unable to connect to the satellite: %v", err)
+	}
+	defer func() { err = errs.Combine(err, conn.Close()) }()
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	client, err := pb.NewSatelliteGracefulExitClient(conn).Process(ctx)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	// the satellite limits the number of transfers in progress
+	var transfers sync.WaitGroup
+	defer transfers.Wait()
+
+	var sendMu sync.Mutex
+	send := func(message *pb.StorageNodeMessage) error {
+		sendMu.Lock()
+		defer sendMu.Unlock()
+		return client.Send(message)
+	}
+
+	for {
+		message, err := client.Recv()
+		if err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return Error.Wrap(err)
+		}
+
+		switch {
+		case message.TransferPiece != nil:
+			transfers.Add(1)
+			go func() {
+				defer transfers.Done()
+				response := chore.transfer(ctx, log, satelliteID, message.TransferPiece)
+				if err := send(response); err != nil {
+					log.Error("failed to send transfer result", zap.Error(err))
+				}
+			}()
+
+		case message.DeletePiece != nil:
+			pieceID := message.DeletePiece.PieceId
+			err := errs.Combine(
+				chore.pieceinfo.Delete(ctx, satelliteID, pieceID),
+				chore.store.Delete(ctx, satelliteID, pieceID),
+			)
+			if err != nil {
+				log.Error("failed to delete transferred piece", zap.Stringer("piece", pieceID), zap.Error(err))
+			}
+
+		case message.ExitCompleted != nil:
+			log.Info("graceful exit completed",
+				zap.Int64("transferred", message.ExitCompleted.PiecesTransferred),
+				zap.Int64("failed", message.ExitCompleted.PiecesFailed))
+			return Error.Wrap(chore.db.CompleteGracefulExit(ctx, satelliteID, time.Now().UTC(), StatusSucceeded))
+
+		case message.ExitFailed != nil:
+			log.Info("graceful exit failed",
+				zap.Stringer("reason", message.ExitFailed.Reason),
+				zap.Int64("transferred", message.ExitFailed.PiecesTransferred),
+				zap.Int64("failed", message.ExitFailed.PiecesFailed))
+			return Error.Wrap(chore.db.CompleteGracefulExit(ctx, satelliteID, time.Now().UTC(), StatusFailed))
+		}
+	}
+}
+
+// transfer uploads a piece to the replacement node and returns the result for the satellite.
+func (chore *Chore) transfer(ctx context.Context, log *zap.Logger, satelliteID storj.NodeID, transfer *pb.TransferPiece) *pb.StorageNodeMessage {
+	pieceID := transfer.PieceId
+	failed := func(code pb.TransferFailed_Error, err error) *pb.StorageNodeMessage {
+		log.Info("piece transfer failed", zap.Stringer("piece", pieceID), zap.Error(err))
+		if err := chore.db.IncrementProgress(ctx, satelliteID, 0, 0, 1); err != nil {
+			log.Error("failed to update graceful exit progress", zap.Error(err))
+		}
+		return &pb.StorageNodeMessage{
+			Failed: &pb.TransferFailed{PieceId: pieceID, Error: code},
+		}
+	}
+
+	info, err := chore.pieceinfo.Get(ctx, satelliteID, pieceID)
+	if err != nil {
+		return failed(pb.TransferFailed_NOT_FOUND, err)
+	}
+
+	reader, err := chore.store.Reader(ctx, satelliteID, pieceID)
+	if err != nil {
+		return failed(pb.TransferFailed_NOT_FOUND, err)
+	}
+	defer func() {
+		if err := reader.Close(); err != nil {
+			log.Warn("failed to close piece reader", zap.Error(err))
+		}
+	}()
+
+	limit := transfer.AddressedOrderLimit
+	conn, err := chore.transport.DialNode(ctx, &pb.Node{
+		Id:      limit.Limit.StorageNodeId,
+		Address: limit.StorageNodeAddress,
+	})
+	if err != nil {
+		return failed(pb.TransferFailed_STORAGE_NODE_UNAVAILABLE, err)
+	}
+	client := piecestore.NewClient(log.Named("piecestore"), chore.signer, conn, piecestore.DefaultConfig)
+	defer func() {
+		if err := client.Close(); err != nil {
+			log.Warn("failed to close connection", zap.Error(err))
+		}
+	}()
+
+	upload, err := client.Upload(ctx, limit.Limit)
+	if err != nil {
+		return failed(pb.TransferFailed_STORAGE_NODE_UNAVAILABLE, err)
+	}
+
+	if _, err := io.CopyN(upload, reader, reader.Size()); err != nil {
+		return failed(pb.TransferFailed_UNKNOWN, errs.Combine(err, upload.Cancel()))
+	}
+
+	hash, err := upload.Commit()
+	if err != nil {
+		return failed(pb.TransferFailed_HASH_VERIFICATION, err)
+	}
+
+	if err := chore.db.IncrementProgress(ctx, satelliteID, reader.Size(), 1, 0); err != nil {
+		log.Error("failed to update graceful exit progress", zap.Error(err))
+	}
+
+	return &pb.StorageNodeMessage{
+		Succeeded: &pb.TransferSucceeded{
+			PieceId:              pieceID,
+			OriginalPieceHash:    info.UplinkPieceHash,
+			ReplacementPieceHash: hash,
+			OriginalOrderLimit:   reader.Header().OrderLimit,
+		},
+	}
+}
+
+// Close stops the graceful exit chore.
+func (chore *Chore) Close() error {
+	chore.Loop.Close()
+	return nil
+}
@@ -0,0 +1,44 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package gracefulexit
+
+import (
+	"context"
+	"time"
+
+	"storj.io/storj/pkg/storj"
+)
+
+// Status is the status of a graceful exit.
+type Status byte
+
+// Statuses of a graceful exit.
+const (
+	StatusInProgress Status = iota
+	StatusSucceeded
+	StatusFailed
+)
+
+// Progress is the progress of a graceful exit from a satellite.
+type Progress struct {
+	SatelliteID       storj.NodeID
+	InitiatedAt       time.Time
+	FinishedAt        *time.Time
+	Status            Status
+	BytesTransferred  int64
+	PiecesTransferred int64
+	PiecesFailed      int64
+}
+
+// DB implements storing the progress of graceful exits.
+type DB interface {
+	// InitiateGracefulExit starts a graceful exit from the satellite.
+	InitiateGracefulExit(ctx context.Context, satelliteID storj.NodeID, initiatedAt time.Time) error
+	// IncrementProgress increments the transferred bytes and the transferred and failed piece counts of an exit.
+	IncrementProgress(ctx context.Context, satelliteID storj.NodeID, bytes, successfulTransfers, failedTransfers int64) error
+	// CompleteGracefulExit marks the exit from the satellite as finished.
+	CompleteGracefulExit(ctx context.Context, satelliteID storj.NodeID, finishedAt time.Time, status Status) error
+	// ListGracefulExits returns the progress of all graceful exits.
+	ListGracefulExits(ctx context.Context) ([]Progress, error)
+}
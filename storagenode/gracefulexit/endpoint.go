@@ -0,0 +1,92 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package gracefulexit
+
+import (
+	"context"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	monkit "gopkg.in/spacemonkeygo/monkit.v2"
+
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/storagenode/trust"
+)
+
+var (
+	// Error is the default error class for graceful exit.
+	Error = errs.Class("graceful exit error")
+
+	mon = monkit.Package()
+)
+
+// Endpoint implements the private graceful exit service of the storage node.
+type Endpoint struct {
+	log   *zap.Logger
+	trust *trust.Pool
+	db    DB
+}
+
+// NewEndpoint creates a new graceful exit endpoint.
+func NewEndpoint(log *zap.Logger, trust *trust.Pool, db DB) *Endpoint {
+	return &Endpoint{
+		log:   log,
+		trust: trust,
+		db:    db,
+	}
+}
+
+// InitiateGracefulExit starts a graceful exit from a satellite, the pieces are
+// transferred by the chore.
+func (endpoint *Endpoint) InitiateGracefulExit(ctx context.Context, req *pb.InitiateGracefulExitRequest) (_ *pb.ExitProgress, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := endpoint.trust.VerifySatelliteID(ctx, req.NodeId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, Error.Wrap(err).Error())
+	}
+
+	exits, err := endpoint.db.ListGracefulExits(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, Error.Wrap(err).Error())
+	}
+	for _, exit := range exits {
+		if exit.SatelliteID == req.NodeId {
+			return nil, status.Error(codes.AlreadyExists, Error.New("graceful exit from %s already initiated", req.NodeId).Error())
+		}
+	}
+
+	if err := endpoint.db.InitiateGracefulExit(ctx, req.NodeId, time.Now().UTC()); err != nil {
+		return nil, status.Error(codes.Internal, Error.Wrap(err).Error())
+	}
+	endpoint.log.Info("graceful exit initiated", zap.Stringer("satellite", req.NodeId))
+
+	return &pb.ExitProgress{SatelliteId: req.NodeId}, nil
+}
+
+// GetExitProgress returns the progress of all graceful exits.
+func (endpoint *Endpoint) GetExitProgress(ctx context.Context, req *pb.GetExitProgressRequest) (_ *pb.GetExitProgressResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	exits, err := endpoint.db.ListGracefulExits(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, Error.Wrap(err).Error())
+	}
+
+	response := &pb.GetExitProgressResponse{}
+	for _, exit := range exits {
+		response.Progress = append(response.Progress, &pb.ExitProgress{
+			SatelliteId:       exit.SatelliteID,
+			BytesTransferred:  exit.BytesTransferred,
+			PiecesTransferred: exit.PiecesTransferred,
+			PiecesFailed:      exit.PiecesFailed,
+			Finished:          exit.FinishedAt != nil,
+			Successful:        exit.Status == StatusSucceeded,
+		})
+	}
+
+	return response, nil
+}
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/timestamp"
 	"github.com/zeebo/errs"
 	"go.uber.org/zap"
 	monkit "gopkg.in/spacemonkeygo/monkit.v2"
@@ -19,6 +20,7 @@ import (
 	"storj.io/storj/storagenode/bandwidth"
 	"storj.io/storj/storagenode/pieces"
 	"storj.io/storj/storagenode/piecestore"
+	"storj.io/storj/storagenode/scrubber"
 )
 
 var (
@@ -34,18 +36,20 @@ type Endpoint struct {
 	pieceInfo pieces.DB
 	kademlia  *kademlia.Kademlia
 	usageDB   bandwidth.DB
+	scrubber  *scrubber.Service
 
 	startTime time.Time
 	config    piecestore.OldConfig
 }
 
 // NewEndpoint creates piecestore inspector instance
-func NewEndpoint(log *zap.Logger, pieceInfo pieces.DB, kademlia *kademlia.Kademlia, usageDB bandwidth.DB, config piecestore.OldConfig) *Endpoint {
+func NewEndpoint(log *zap.Logger, pieceInfo pieces.DB, kademlia *kademlia.Kademlia, usageDB bandwidth.DB, scrubber *scrubber.Service, config piecestore.OldConfig) *Endpoint {
 	return &Endpoint{
 		log:       log,
 		pieceInfo: pieceInfo,
 		kademlia:  kademlia,
 		usageDB:   usageDB,
+		scrubber:  scrubber,
 		config:    config,
 		startTime: time.Now(),
 	}
@@ -67,9 +71,14 @@ func (inspector *Endpoint) retrieveStats(ctx context.Context) (*pb.StatSummaryRe
 
 	totalUsedBandwidth := usage.Total()
 
+	allocatedDiskSpace, err := inspector.config.TotalAllocatedDiskSpace()
+	if err != nil {
+		return nil, err
+	}
+
 	return &pb.StatSummaryResponse{
 		UsedSpace:          totalUsedSpace,
-		AvailableSpace:     inspector.config.AllocatedDiskSpace.Int64() - totalUsedSpace,
+		AvailableSpace:     allocatedDiskSpace.Int64() - totalUsedSpace,
 		UsedIngress:        ingress,
 		UsedEgress:         egress,
 		UsedBandwidth:      totalUsedBandwidth,
@@ -122,6 +131,15 @@ func (inspector *Endpoint) getDashboardData(ctx context.Context) (*pb.DashboardR
 		queried = nil
 	}
 
+	var scrubbed *timestamp.Timestamp
+	if lastScrubbed := inspector.scrubber.LastScrubbed(); !lastScrubbed.IsZero() {
+		scrubbed, err = ptypes.TimestampProto(lastScrubbed)
+		if err != nil {
+			inspector.log.Warn("last scrub time bad", zap.Error(err))
+			scrubbed = nil
+		}
+	}
+
 	return &pb.DashboardResponse{
 		NodeId:           inspector.kademlia.Local().Id,
 		NodeConnections:  int64(len(nodes)),
@@ -132,6 +150,8 @@ func (inspector *Endpoint) getDashboardData(ctx context.Context) (*pb.DashboardR
 		LastQueried:      queried,
 		Uptime:           ptypes.DurationProto(time.Since(inspector.startTime)),
 		Stats:            statsSummary,
+		CorruptPieces:    int64(len(inspector.scrubber.CorruptPieces())),
+		LastScrubbed:     scrubbed,
 	}, nil
 }
 
@@ -146,3 +166,26 @@ func (inspector *Endpoint) Dashboard(ctx context.Context, in *pb.DashboardReques
 	}
 	return data, nil
 }
+
+// CorruptPieces returns the pieces found corrupted by the scrubber
+func (inspector *Endpoint) CorruptPieces(ctx context.Context, in *pb.CorruptPiecesRequest) (out *pb.CorruptPiecesResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	corrupt := inspector.scrubber.CorruptPieces()
+	out = &pb.CorruptPiecesResponse{
+		Pieces: make([]*pb.CorruptPiece, 0, len(corrupt)),
+	}
+	for _, piece := range corrupt {
+		detectedAt, err := ptypes.TimestampProto(piece.DetectedAt)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		out.Pieces = append(out.Pieces, &pb.CorruptPiece{
+			SatelliteId: piece.SatelliteID,
+			PieceId:     piece.PieceID,
+			Missing:     piece.Missing,
+			DetectedAt:  detectedAt,
+		})
+	}
+	return out, nil
+}
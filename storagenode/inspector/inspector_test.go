@@ -78,7 +78,8 @@ func TestInspectorStats(t *testing.T) {
 			assert.Equal(t, availableBandwidth-response.UsedBandwidth, response.AvailableBandwidth)
 			assert.Equal(t, availableSpace-response.UsedSpace, response.AvailableSpace)
 
-			assert.Equal(t, response.UsedSpace, response.UsedBandwidth-response.UsedEgress)
+			// the used space includes the 4 KiB header of the stored piece
+			assert.Equal(t, response.UsedSpace, response.UsedBandwidth-response.UsedEgress+4096)
 			if response.UsedEgress > 0 {
 				downloaded++
 				assert.Equal(t, response.UsedBandwidth-response.UsedIngress, response.UsedEgress)
@@ -136,3 +137,30 @@ func TestInspectorDashboard(t *testing.T) {
 		}
 	})
 }
+
+func TestInspectorCorruptPieces(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		expectedData := make([]byte, 100*memory.KiB)
+		_, err := rand.Read(expectedData)
+		require.NoError(t, err)
+
+		err = planet.Uplinks[0].Upload(ctx, planet.Satellites[0], "testbucket", "test/path", expectedData)
+		require.NoError(t, err)
+
+		for _, storageNode := range planet.StorageNodes {
+			storageNode.Scrubber.Loop.Pause()
+			require.NoError(t, storageNode.Scrubber.Scrub(ctx))
+
+			response, err := storageNode.Storage2.Inspector.Dashboard(ctx, &pb.DashboardRequest{})
+			require.NoError(t, err)
+			assert.Equal(t, int64(0), response.CorruptPieces)
+			assert.NotNil(t, response.LastScrubbed)
+
+			corrupt, err := storageNode.Storage2.Inspector.CorruptPieces(ctx, &pb.CorruptPiecesRequest{})
+			require.NoError(t, err)
+			assert.Empty(t, corrupt.Pieces)
+		}
+	})
+}
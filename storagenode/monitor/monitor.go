@@ -14,6 +14,7 @@ import (
 	"storj.io/storj/internal/sync2"
 	"storj.io/storj/pkg/kademlia"
 	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
 	"storj.io/storj/storagenode/bandwidth"
 	"storj.io/storj/storagenode/pieces"
 )
@@ -30,6 +31,14 @@ type Config struct {
 	Interval time.Duration `help:"how frequently Kademlia bucket should be refreshed with node stats" default:"1h0m0s"`
 }
 
+// Allocation is the disk space and bandwidth reserved for a satellite,
+// the satellite can't use more than its allocation.
+// Zero values mean that the satellite shares the remaining allocation.
+type Allocation struct {
+	DiskSpace int64
+	Bandwidth int64
+}
+
 // Service which monitors disk usage and updates kademlia network as necessary.
 type Service struct {
 	log                *zap.Logger
@@ -39,13 +48,14 @@ type Service struct {
 	usageDB            bandwidth.DB
 	allocatedDiskSpace int64
 	allocatedBandwidth int64
+	allocations        map[storj.NodeID]Allocation
 	Loop               sync2.Cycle
 }
 
 // TODO: should it be responsible for monitoring actual bandwidth as well?
 
 // NewService creates a new storage node monitoring service.
-func NewService(log *zap.Logger, routingTable *kademlia.RoutingTable, store *pieces.Store, pieceInfo pieces.DB, usageDB bandwidth.DB, allocatedDiskSpace, allocatedBandwidth int64, interval time.Duration) *Service {
+func NewService(log *zap.Logger, routingTable *kademlia.RoutingTable, store *pieces.Store, pieceInfo pieces.DB, usageDB bandwidth.DB, allocatedDiskSpace, allocatedBandwidth int64, allocations map[storj.NodeID]Allocation, interval time.Duration) *Service {
 	return &Service{
 		log:                log,
 		routingTable:       routingTable,
@@ -54,6 +64,7 @@ func NewService(log *zap.Logger, routingTable *kademlia.RoutingTable, store *pie
 		usageDB:            usageDB,
 		allocatedDiskSpace: allocatedDiskSpace,
 		allocatedBandwidth: allocatedBandwidth,
+		allocations:        allocations,
 		Loop:               *sync2.NewCycle(interval),
 	}
 }
@@ -123,20 +134,13 @@ func (service *Service) Close() (err error) {
 }
 
 func (service *Service) updateNodeInformation(ctx context.Context) error {
-	usedSpace, err := service.usedSpace(ctx)
+	// satellites without an allocation see the shared capacity
+	capacity, err := service.Capacity(ctx, storj.NodeID{})
 	if err != nil {
 		return Error.Wrap(err)
 	}
 
-	usedBandwidth, err := service.usedBandwidth(ctx)
-	if err != nil {
-		return Error.Wrap(err)
-	}
-
-	service.routingTable.UpdateSelf(&pb.NodeCapacity{
-		FreeBandwidth: service.allocatedBandwidth - usedBandwidth,
-		FreeDisk:      service.allocatedDiskSpace - usedSpace,
-	})
+	service.routingTable.UpdateSelf(capacity)
 
 	return nil
 }
@@ -146,6 +150,15 @@ func (service *Service) usedSpace(ctx context.Context) (int64, error) {
 	if err != nil {
 		return 0, err
 	}
+
+	// trashed pieces use space until the trash is emptied
+	usedTrash, err := service.store.SpaceUsedByTrash(ctx)
+	if err != nil {
+		return 0, err
+	}
+	for _, size := range usedTrash {
+		usedSpace += size
+	}
 	return usedSpace, nil
 }
 
@@ -157,22 +170,82 @@ func (service *Service) usedBandwidth(ctx context.Context) (int64, error) {
 	return usage.Total(), nil
 }
 
-// AvailableSpace returns available disk space for upload
-func (service *Service) AvailableSpace(ctx context.Context) (int64, error) {
-	usedSpace, err := service.pieceInfo.SpaceUsed(ctx)
+// Capacity returns the capacity available for the satellite.
+func (service *Service) Capacity(ctx context.Context, satelliteID storj.NodeID) (*pb.NodeCapacity, error) {
+	freeDisk, err := service.AvailableSpace(ctx, satelliteID)
+	if err != nil {
+		return nil, err
+	}
+
+	freeBandwidth, err := service.AvailableBandwidth(ctx, satelliteID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.NodeCapacity{
+		FreeBandwidth: freeBandwidth,
+		FreeDisk:      freeDisk,
+	}, nil
+}
+
+// AvailableSpace returns available disk space for uploads from the satellite
+func (service *Service) AvailableSpace(ctx context.Context, satelliteID storj.NodeID) (int64, error) {
+	usedSpace, err := service.pieceInfo.SpaceUsedBySatellite(ctx)
+	if err != nil {
+		return 0, Error.Wrap(err)
+	}
+
+	// trashed pieces use space until the trash is emptied
+	usedTrash, err := service.store.SpaceUsedByTrash(ctx)
 	if err != nil {
 		return 0, Error.Wrap(err)
 	}
-	allocatedSpace := service.allocatedDiskSpace
-	return allocatedSpace - usedSpace, nil
+	for id, size := range usedTrash {
+		usedSpace[id] += size
+	}
+	return service.available(satelliteID, service.allocatedDiskSpace, usedSpace,
+		func(allocation Allocation) int64 { return allocation.DiskSpace }), nil
 }
 
-// AvailableBandwidth returns available bandwidth for upload/download
-func (service *Service) AvailableBandwidth(ctx context.Context) (int64, error) {
-	usage, err := bandwidth.TotalMonthlySummary(ctx, service.usageDB)
+// AvailableBandwidth returns available bandwidth for uploads/downloads of the satellite
+func (service *Service) AvailableBandwidth(ctx context.Context, satelliteID storj.NodeID) (int64, error) {
+	usage, err := bandwidth.MonthlySummaryBySatellite(ctx, service.usageDB)
 	if err != nil {
 		return 0, Error.Wrap(err)
 	}
-	allocatedBandwidth := service.allocatedBandwidth
-	return allocatedBandwidth - usage.Total(), nil
+
+	usedBandwidth := make(map[storj.NodeID]int64, len(usage))
+	for id, satelliteUsage := range usage {
+		usedBandwidth[id] = satelliteUsage.Total()
+	}
+	return service.available(satelliteID, service.allocatedBandwidth, usedBandwidth,
+		func(allocation Allocation) int64 { return allocation.Bandwidth }), nil
+}
+
+// available calculates the amount available for the satellite.
+//
+// The unused part of the other satellites' allocations is reserved for them and
+// a satellite with an allocation is limited to its own allocation.
+func (service *Service) available(satelliteID storj.NodeID, total int64, used map[storj.NodeID]int64, allocated func(Allocation) int64) int64 {
+	available := total
+	for _, amount := range used {
+		available -= amount
+	}
+
+	for id, allocation := range service.allocations {
+		if id == satelliteID {
+			continue
+		}
+		if unused := allocated(allocation) - used[id]; unused > 0 {
+			available -= unused
+		}
+	}
+
+	if allocation, ok := service.allocations[satelliteID]; ok && allocated(allocation) > 0 {
+		if remaining := allocated(allocation) - used[satelliteID]; remaining < available {
+			available = remaining
+		}
+	}
+
+	return available
 }
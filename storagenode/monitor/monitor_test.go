@@ -6,14 +6,22 @@ package monitor_test
 import (
 	"crypto/rand"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
 
 	"storj.io/storj/internal/memory"
 	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/testidentity"
 	"storj.io/storj/internal/testplanet"
 	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/storagenode"
+	"storj.io/storj/storagenode/monitor"
+	"storj.io/storj/storagenode/pieces"
+	"storj.io/storj/storagenode/storagenodedb/storagenodedbtest"
 )
 
 func TestMonitor(t *testing.T) {
@@ -55,3 +63,79 @@ func TestMonitor(t *testing.T) {
 		assert.NotZero(t, nodeAssertions, "No storage node were verifed")
 	})
 }
+
+func TestSatelliteAllocations(t *testing.T) {
+	storagenodedbtest.Run(t, func(t *testing.T, db storagenode.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		allocated := testidentity.MustPregeneratedSignedIdentity(0, storj.LatestIDVersion()).ID
+		reserved := testidentity.MustPregeneratedSignedIdentity(1, storj.LatestIDVersion()).ID
+		shared := testidentity.MustPregeneratedSignedIdentity(2, storj.LatestIDVersion()).ID
+
+		store := pieces.NewStore(zaptest.NewLogger(t), db.Pieces())
+		service := monitor.NewService(zaptest.NewLogger(t), nil, store, db.PieceInfo(), db.Bandwidth(),
+			1000, 2000, map[storj.NodeID]monitor.Allocation{
+				allocated: {DiskSpace: 300, Bandwidth: 400},
+				reserved:  {DiskSpace: 200},
+			}, time.Hour)
+
+		uplink := testidentity.MustPregeneratedSignedIdentity(3, storj.LatestIDVersion())
+		addPiece := func(satelliteID storj.NodeID, size int64) {
+			pieceID := storj.NewPieceID()
+			require.NoError(t, db.PieceInfo().Add(ctx, &pieces.Info{
+				SatelliteID:     satelliteID,
+				PieceID:         pieceID,
+				PieceSize:       size,
+				PieceCreation:   time.Now(),
+				UplinkPieceHash: &pb.PieceHash{PieceId: pieceID},
+				Uplink:          uplink.PeerIdentity(),
+			}))
+		}
+
+		addPiece(allocated, 100)
+		addPiece(shared, 150)
+		require.NoError(t, db.Bandwidth().Add(ctx, allocated, pb.PieceAction_GET, 100, time.Now()))
+		require.NoError(t, db.Bandwidth().Add(ctx, shared, pb.PieceAction_PUT, 500, time.Now()))
+
+		// limited to the remaining part of its own allocation
+		capacity, err := service.Capacity(ctx, allocated)
+		require.NoError(t, err)
+		assert.Equal(t, int64(200), capacity.FreeDisk)
+		assert.Equal(t, int64(300), capacity.FreeBandwidth)
+
+		// the unused allocation of the other satellites is reserved
+		capacity, err = service.Capacity(ctx, shared)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1000-100-150-200-200), capacity.FreeDisk)
+		assert.Equal(t, int64(2000-100-500-300), capacity.FreeBandwidth)
+
+		// an allocation without bandwidth shares the remaining bandwidth
+		capacity, err = service.Capacity(ctx, reserved)
+		require.NoError(t, err)
+		assert.Equal(t, int64(200), capacity.FreeDisk)
+		assert.Equal(t, int64(2000-100-500-300), capacity.FreeBandwidth)
+
+		// trashed pieces use space until the trash is emptied
+		pieceID := storj.NewPieceID()
+		writer, err := store.Writer(ctx, shared, pieceID)
+		require.NoError(t, err)
+		_, err = writer.Write(make([]byte, 50))
+		require.NoError(t, err)
+		require.NoError(t, writer.Commit(&pb.PieceHeader{}))
+		require.NoError(t, store.Trash(ctx, shared, pieceID))
+
+		trash, err := store.SpaceUsedByTrash(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, writer.StoredSize(), trash[shared])
+
+		capacity, err = service.Capacity(ctx, shared)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1000-100-150-200-200)-writer.StoredSize(), capacity.FreeDisk)
+
+		require.NoError(t, store.EmptyTrash(ctx, time.Now().Add(48*time.Hour)))
+		capacity, err = service.Capacity(ctx, shared)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1000-100-150-200-200), capacity.FreeDisk)
+	})
+}
@@ -6,6 +6,7 @@ package orders_test
 import (
 	"crypto/rand"
 	"testing"
+	"time"
 
 	"storj.io/storj/internal/testidentity"
 
@@ -15,6 +16,7 @@ import (
 
 	"storj.io/storj/internal/testcontext"
 	"storj.io/storj/pkg/auth/signing"
+	"storj.io/storj/pkg/identity"
 	"storj.io/storj/pkg/pb"
 	"storj.io/storj/pkg/storj"
 	"storj.io/storj/storagenode"
@@ -86,23 +88,25 @@ func TestOrders(t *testing.T) {
 		require.NoError(t, err)
 		require.Empty(t, cmp.Diff([]*orders.Info{info}, unsent, cmp.Comparer(pb.Equal)))
 
-		// list by group
-		unsentGrouped, err := ordersdb.ListUnsentBySatellite(ctx)
+		counts, err := ordersdb.CountByStatus(ctx)
 		require.NoError(t, err)
+		require.Equal(t, map[orders.Status]int64{orders.StatusUnsent: 1}, counts)
 
-		expectedGrouped := map[storj.NodeID][]*orders.Info{
-			satellite0.ID: []*orders.Info{
-				{Limit: limit, Order: order},
-			},
-		}
-		require.Empty(t, cmp.Diff(expectedGrouped, unsentGrouped, cmp.Comparer(pb.Equal)))
+		// list by satellite
+		satellites, err := ordersdb.ListUnsentSatellites(ctx)
+		require.NoError(t, err)
+		require.Equal(t, []storj.NodeID{satellite0.ID}, satellites)
+
+		unsentForSatellite, err := ordersdb.ListUnsentForSatellite(ctx, satellite0.ID, 100)
+		require.NoError(t, err)
+		require.Empty(t, cmp.Diff([]*orders.Info{{Limit: limit, Order: order}}, unsentForSatellite, cmp.Comparer(pb.Equal)))
 
 		// test archival
-		err = ordersdb.Archive(ctx, satellite0.ID, serialNumber, orders.StatusAccepted)
+		err = ordersdb.Archive(ctx, satellite0.ID, serialNumber, orders.StatusAccepted, "")
 		require.NoError(t, err)
 
 		// duplicate archive
-		err = ordersdb.Archive(ctx, satellite0.ID, serialNumber, orders.StatusRejected)
+		err = ordersdb.Archive(ctx, satellite0.ID, serialNumber, orders.StatusRejected, "duplicate")
 		require.Error(t, err)
 
 		// shouldn't be in unsent list
@@ -126,6 +130,122 @@ func TestOrders(t *testing.T) {
 			},
 		}, archived, cmp.Comparer(pb.Equal)))
 
+		counts, err = ordersdb.CountByStatus(ctx)
+		require.NoError(t, err)
+		require.Equal(t, map[orders.Status]int64{orders.StatusAccepted: 1}, counts)
+
+	})
+}
+
+func TestOrdersArchive(t *testing.T) {
+	storagenodedbtest.Run(t, func(t *testing.T, db storagenode.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		ordersdb := db.Orders()
+
+		storagenode := testidentity.MustPregeneratedSignedIdentity(0, storj.LatestIDVersion())
+		satellite0 := testidentity.MustPregeneratedSignedIdentity(1, storj.LatestIDVersion())
+		satellite1 := testidentity.MustPregeneratedSignedIdentity(2, storj.LatestIDVersion())
+		uplink := testidentity.MustPregeneratedSignedIdentity(3, storj.LatestIDVersion())
+
+		now := time.Now()
+
+		enqueue := func(satellite *identity.FullIdentity, expiration time.Time) storj.SerialNumber {
+			serialNumber := newRandomSerial()
+			orderExpiration, err := ptypes.TimestampProto(expiration)
+			require.NoError(t, err)
+
+			limit, err := signing.SignOrderLimit(signing.SignerFromFullIdentity(satellite), &pb.OrderLimit2{
+				SerialNumber:    serialNumber,
+				SatelliteId:     satellite.ID,
+				UplinkId:        uplink.ID,
+				StorageNodeId:   storagenode.ID,
+				PieceId:         storj.NewPieceID(),
+				Limit:           100,
+				Action:          pb.PieceAction_GET,
+				PieceExpiration: orderExpiration,
+				OrderExpiration: orderExpiration,
+			})
+			require.NoError(t, err)
+
+			order, err := signing.SignOrder(signing.SignerFromFullIdentity(uplink), &pb.Order2{
+				SerialNumber: serialNumber,
+				Amount:       50,
+			})
+			require.NoError(t, err)
+
+			require.NoError(t, ordersdb.Enqueue(ctx, &orders.Info{
+				Limit:  limit,
+				Order:  order,
+				Uplink: uplink.PeerIdentity(),
+			}))
+			return serialNumber
+		}
+
+		expired := enqueue(satellite0, now.Add(-time.Hour))
+		later := enqueue(satellite0, now.Add(2*time.Hour))
+		sooner := enqueue(satellite0, now.Add(time.Hour))
+		rejected := enqueue(satellite1, now.Add(time.Hour))
+
+		// orders are listed in batches, expiring first
+		batch, err := ordersdb.ListUnsentForSatellite(ctx, satellite0.ID, 2)
+		require.NoError(t, err)
+		require.Len(t, batch, 2)
+		require.Equal(t, expired, batch[0].Limit.SerialNumber)
+		require.Equal(t, sooner, batch[1].Limit.SerialNumber)
+
+		count, err := ordersdb.ArchiveExpired(ctx, now)
+		require.NoError(t, err)
+		require.Equal(t, int64(1), count)
+
+		batch, err = ordersdb.ListUnsentForSatellite(ctx, satellite0.ID, 100)
+		require.NoError(t, err)
+		require.Len(t, batch, 2)
+		require.Equal(t, sooner, batch[0].Limit.SerialNumber)
+		require.Equal(t, later, batch[1].Limit.SerialNumber)
+
+		require.NoError(t, ordersdb.Archive(ctx, satellite1.ID, rejected, orders.StatusRejected, "order limit expired"))
+
+		satellites, err := ordersdb.ListUnsentSatellites(ctx)
+		require.NoError(t, err)
+		require.Equal(t, []storj.NodeID{satellite0.ID}, satellites)
+
+		counts, err := ordersdb.CountByStatus(ctx)
+		require.NoError(t, err)
+		require.Equal(t, map[orders.Status]int64{
+			orders.StatusUnsent:   2,
+			orders.StatusRejected: 1,
+			orders.StatusExpired:  1,
+		}, counts)
+
+		reasons, err := ordersdb.CountRejectReasons(ctx)
+		require.NoError(t, err)
+		require.Equal(t, map[string]int64{"order limit expired": 1}, reasons)
+
+		archived, err := ordersdb.ListArchived(ctx, 100)
+		require.NoError(t, err)
+		require.Len(t, archived, 2)
+		for _, info := range archived {
+			if info.Status == orders.StatusRejected {
+				require.Equal(t, "order limit expired", info.Reason)
+			} else {
+				require.Empty(t, info.Reason)
+			}
+		}
+
+		// pruning the archive
+		count, err = ordersdb.CleanArchive(ctx, now.Add(-time.Hour))
+		require.NoError(t, err)
+		require.Equal(t, int64(0), count)
+
+		count, err = ordersdb.CleanArchive(ctx, time.Now().Add(time.Hour))
+		require.NoError(t, err)
+		require.Equal(t, int64(2), count)
+
+		archived, err = ordersdb.ListArchived(ctx, 100)
+		require.NoError(t, err)
+		require.Empty(t, archived)
 	})
 }
 
@@ -6,10 +6,13 @@ package orders
 import (
 	"context"
 	"io"
+	"sync"
 	"time"
 
+	"github.com/zeebo/errs"
 	"go.uber.org/zap"
 	"golang.org/x/sync/errgroup"
+	monkit "gopkg.in/spacemonkeygo/monkit.v2"
 
 	"storj.io/storj/internal/sync2"
 	"storj.io/storj/pkg/identity"
@@ -19,6 +22,8 @@ import (
 	"storj.io/storj/pkg/transport"
 )
 
+var mon = monkit.Package()
+
 // Info contains full information about an order.
 type Info struct {
 	Limit  *pb.OrderLimit2
@@ -34,6 +39,8 @@ type ArchivedInfo struct {
 
 	Status     Status
 	ArchivedAt time.Time
+	// Reason explains why the satellite rejected the order.
+	Reason string
 }
 
 // Status is the archival status of the order.
@@ -44,6 +51,8 @@ const (
 	StatusUnsent Status = iota
 	StatusAccepted
 	StatusRejected
+	// StatusExpired is used for orders which expired before they were sent.
+	StatusExpired
 )
 
 // DB implements storing orders for sending to the satellite.
@@ -52,23 +61,42 @@ type DB interface {
 	Enqueue(ctx context.Context, info *Info) error
 	// ListUnsent returns orders that haven't been sent yet.
 	ListUnsent(ctx context.Context, limit int) ([]*Info, error)
-	// ListUnsentBySatellite returns orders that haven't been sent yet grouped by satellite.
-	ListUnsentBySatellite(ctx context.Context) (map[storj.NodeID][]*Info, error)
-
-	// Archive marks order as being handled.
-	Archive(ctx context.Context, satellite storj.NodeID, serial storj.SerialNumber, status Status) error
+	// ListUnsentSatellites returns the satellites which have orders that haven't been sent yet.
+	ListUnsentSatellites(ctx context.Context) ([]storj.NodeID, error)
+	// ListUnsentForSatellite returns up to limit orders of the satellite that haven't been sent yet.
+	ListUnsentForSatellite(ctx context.Context, satellite storj.NodeID, limit int) ([]*Info, error)
+
+	// Archive marks order as being handled, reason explains why the order was rejected.
+	Archive(ctx context.Context, satellite storj.NodeID, serial storj.SerialNumber, status Status, reason string) error
+	// ArchiveExpired archives the unsent orders which expired before now as expired.
+	ArchiveExpired(ctx context.Context, now time.Time) (int64, error)
+	// CleanArchive deletes the orders archived before archivedBefore.
+	CleanArchive(ctx context.Context, archivedBefore time.Time) (int64, error)
 
 	// ListArchived returns orders that have been sent.
 	ListArchived(ctx context.Context, limit int) ([]*ArchivedInfo, error)
+	// CountByStatus returns the number of unsent and archived orders by their status.
+	CountByStatus(ctx context.Context) (map[Status]int64, error)
+	// CountRejectReasons returns the number of rejected orders by the reason of the rejection.
+	CountRejectReasons(ctx context.Context) (map[string]int64, error)
+	// SumArchived returns the order amounts archived with status between from and to by satellite and action.
+	SumArchived(ctx context.Context, status Status, from, to time.Time) (map[storj.NodeID]map[pb.PieceAction]int64, error)
 }
 
 // SenderConfig defines configuration for sending orders.
 type SenderConfig struct {
-	Interval time.Duration `help:"duration between sending" default:"1h0m0s"`
-	Timeout  time.Duration `help:"timeout for sending" default:"1h0m0s"`
+	Interval         time.Duration `help:"duration between sending" default:"1h0m0s"`
+	Timeout          time.Duration `help:"timeout for sending" default:"1h0m0s"`
+	BatchSize        int           `help:"maximum number of orders sent to a satellite in a single settlement" default:"1000"`
+	MaxBackoff       time.Duration `help:"maximum duration to wait before retrying a satellite after failed settlements" default:"24h0m0s"`
+	ArchiveRetention time.Duration `help:"how long settled and expired orders are kept in the archive" default:"2160h0m0s"`
 }
 
 // Sender sends every interval unsent orders to the satellite.
+//
+// Orders are sent in batches of BatchSize. When the settlement with a satellite
+// fails, the satellite is retried with an exponential backoff up to MaxBackoff.
+// Orders which expire before they are sent are archived as expired.
 type Sender struct {
 	log    *zap.Logger
 	config SenderConfig
@@ -77,9 +105,19 @@ type Sender struct {
 	kademlia  *kademlia.Kademlia
 	orders    DB
 
+	mu      sync.Mutex
+	backoff map[storj.NodeID]*satelliteBackoff
+
 	Loop sync2.Cycle
 }
 
+// satelliteBackoff tracks the failed settlements with a satellite.
+type satelliteBackoff struct {
+	failures int
+	// skip is the number of cycles to skip before retrying the satellite
+	skip int
+}
+
 // NewSender creates an order sender.
 func NewSender(log *zap.Logger, transport transport.Client, kademlia *kademlia.Kademlia, orders DB, config SenderConfig) *Sender {
 	return &Sender{
@@ -88,6 +126,7 @@ func NewSender(log *zap.Logger, transport transport.Client, kademlia *kademlia.K
 		kademlia:  kademlia,
 		orders:    orders,
 		config:    config,
+		backoff:   map[storj.NodeID]*satelliteBackoff{},
 
 		Loop: *sync2.NewCycle(config.Interval),
 	}
@@ -96,53 +135,163 @@ func NewSender(log *zap.Logger, transport transport.Client, kademlia *kademlia.K
 // Run sends orders on every interval to the appropriate satellites.
 func (sender *Sender) Run(ctx context.Context) error {
 	return sender.Loop.Run(ctx, func(ctx context.Context) error {
-		sender.log.Debug("sending")
+		sender.runOnce(ctx, time.Now())
+		return nil
+	})
+}
 
-		ordersBySatellite, err := sender.orders.ListUnsentBySatellite(ctx)
+// runOnce archives the expired orders, prunes the archive and sends the unsent orders.
+func (sender *Sender) runOnce(ctx context.Context, now time.Time) {
+	sender.log.Debug("sending")
+
+	expired, err := sender.orders.ArchiveExpired(ctx, now)
+	if err != nil {
+		sender.log.Error("archiving expired orders", zap.Error(err))
+	} else if expired > 0 {
+		mon.Meter("orders_expired").Mark64(expired)
+		sender.log.Warn("archived orders which expired before they were sent", zap.Int64("count", expired))
+	}
+
+	if sender.config.ArchiveRetention > 0 {
+		pruned, err := sender.orders.CleanArchive(ctx, now.Add(-sender.config.ArchiveRetention))
 		if err != nil {
-			sender.log.Error("listing orders", zap.Error(err))
-			return nil
+			sender.log.Error("pruning order archive", zap.Error(err))
+		} else if pruned > 0 {
+			sender.log.Debug("pruned order archive", zap.Int64("count", pruned))
 		}
+	}
 
-		if len(ordersBySatellite) > 0 {
-			var group errgroup.Group
-			ctx, cancel := context.WithTimeout(ctx, sender.config.Timeout)
-			defer cancel()
+	satellites, err := sender.orders.ListUnsentSatellites(ctx)
+	if err != nil {
+		sender.log.Error("listing orders", zap.Error(err))
+		return
+	}
 
-			for satelliteID, orders := range ordersBySatellite {
-				satelliteID, orders := satelliteID, orders
-				group.Go(func() error {
+	if len(satellites) == 0 {
+		sender.log.Debug("no orders to send")
+		return
+	}
 
-					sender.Settle(ctx, satelliteID, orders)
-					return nil
-				})
-			}
-			_ = group.Wait() // doesn't return errors
-		} else {
-			sender.log.Debug("no orders to send")
+	var group errgroup.Group
+	ctx, cancel := context.WithTimeout(ctx, sender.config.Timeout)
+	defer cancel()
+
+	for _, satelliteID := range satellites {
+		satelliteID := satelliteID
+		if !sender.shouldRetry(satelliteID) {
+			sender.log.Debug("backing off satellite", zap.Stringer("satellite", satelliteID))
+			continue
 		}
 
-		return nil
-	})
+		group.Go(func() error {
+			err := sender.settleAll(ctx, satelliteID)
+			sender.updateBackoff(satelliteID, err)
+			return nil
+		})
+	}
+	_ = group.Wait() // doesn't return errors
 }
 
-// Settle uploads orders to the satellite.
-func (sender *Sender) Settle(ctx context.Context, satelliteID storj.NodeID, orders []*Info) {
+// settleAll sends the unsent orders of the satellite in batches.
+func (sender *Sender) settleAll(ctx context.Context, satelliteID storj.NodeID) error {
+	batchSize := sender.config.BatchSize
+	if batchSize <= 0 {
+		batchSize = 1000
+	}
+
+	for {
+		orders, err := sender.orders.ListUnsentForSatellite(ctx, satelliteID, batchSize)
+		if err != nil {
+			sender.log.Error("listing orders", zap.Stringer("satellite", satelliteID), zap.Error(err))
+			return err
+		}
+		if len(orders) == 0 {
+			return nil
+		}
+
+		archived, err := sender.Settle(ctx, satelliteID, orders)
+		if err != nil {
+			return err
+		}
+
+		// the remaining orders are sent on the next interval when the satellite
+		// didn't respond to all orders of the batch or the batch was the last one
+		if archived < len(orders) || len(orders) < batchSize {
+			return nil
+		}
+	}
+}
+
+// shouldRetry returns whether the satellite should be contacted in the current cycle.
+func (sender *Sender) shouldRetry(satelliteID storj.NodeID) bool {
+	sender.mu.Lock()
+	defer sender.mu.Unlock()
+
+	backoff, ok := sender.backoff[satelliteID]
+	if !ok || backoff.skip <= 0 {
+		return true
+	}
+	backoff.skip--
+	return false
+}
+
+// updateBackoff resets the backoff of the satellite on success and
+// doubles the number of cycles skipped before the next retry on failure.
+func (sender *Sender) updateBackoff(satelliteID storj.NodeID, err error) {
+	sender.mu.Lock()
+	defer sender.mu.Unlock()
+
+	if err == nil {
+		delete(sender.backoff, satelliteID)
+		return
+	}
+
+	backoff, ok := sender.backoff[satelliteID]
+	if !ok {
+		backoff = &satelliteBackoff{}
+		sender.backoff[satelliteID] = backoff
+	}
+	backoff.failures++
+
+	maxCycles := 1
+	if sender.config.Interval > 0 && sender.config.MaxBackoff > sender.config.Interval {
+		maxCycles = int(sender.config.MaxBackoff / sender.config.Interval)
+	}
+	cycles := 1
+	for i := 1; i < backoff.failures && cycles < maxCycles; i++ {
+		cycles *= 2
+	}
+	if cycles > maxCycles {
+		cycles = maxCycles
+	}
+	backoff.skip = cycles - 1
+
+	sender.log.Warn("settlement failed, backing off",
+		zap.Stringer("satellite", satelliteID),
+		zap.Int("failures", backoff.failures),
+		zap.Duration("retry in", time.Duration(cycles)*sender.config.Interval),
+		zap.Error(err))
+}
+
+// Settle uploads orders to the satellite and returns the number of archived orders.
+func (sender *Sender) Settle(ctx context.Context, satelliteID storj.NodeID, orders []*Info) (archived int, err error) {
+	defer mon.Task()(&ctx)(&err)
+
 	log := sender.log.Named(satelliteID.String())
 
 	log.Info("sending", zap.Int("count", len(orders)))
-	defer log.Info("finished")
+	defer func() { log.Info("finished", zap.Int("archived", archived)) }()
 
 	satellite, err := sender.kademlia.FindNode(ctx, satelliteID)
 	if err != nil {
 		log.Error("unable to find satellite on the network", zap.Error(err))
-		return
+		return 0, err
 	}
 
 	conn, err := sender.transport.DialNode(ctx, &satellite)
 	if err != nil {
 		log.Error("unable to connect to the satellite", zap.Error(err))
-		return
+		return 0, err
 	}
 	defer func() {
 		if err := conn.Close(); err != nil {
@@ -153,7 +302,7 @@ func (sender *Sender) Settle(ctx context.Context, satelliteID storj.NodeID, orde
 	client, err := pb.NewOrdersClient(conn).Settlement(ctx)
 	if err != nil {
 		log.Error("failed to start settlement", zap.Error(err))
-		return
+		return 0, err
 	}
 
 	var group errgroup.Group
@@ -170,36 +319,46 @@ func (sender *Sender) Settle(ctx context.Context, satelliteID storj.NodeID, orde
 		return client.CloseSend()
 	})
 
+	var recvErr error
 	for {
 		response, err := client.Recv()
 		if err != nil {
-			if err == io.EOF {
-				break
+			if err != io.EOF {
+				log.Error("failed to receive response", zap.Error(err))
+				recvErr = err
 			}
-
-			log.Error("failed to receive response", zap.Error(err))
 			break
 		}
 
 		switch response.Status {
 		case pb.SettlementResponse_ACCEPTED:
-			err = sender.orders.Archive(ctx, satelliteID, response.SerialNumber, StatusAccepted)
+			err = sender.orders.Archive(ctx, satelliteID, response.SerialNumber, StatusAccepted, "")
 			if err != nil {
 				log.Error("failed to archive order as accepted", zap.Stringer("serial", response.SerialNumber), zap.Error(err))
+				continue
 			}
+			archived++
+			mon.Meter("orders_accepted").Mark(1)
 		case pb.SettlementResponse_REJECTED:
-			err = sender.orders.Archive(ctx, satelliteID, response.SerialNumber, StatusRejected)
+			log.Warn("order rejected", zap.Stringer("serial", response.SerialNumber), zap.String("reason", response.Reason))
+			err = sender.orders.Archive(ctx, satelliteID, response.SerialNumber, StatusRejected, response.Reason)
 			if err != nil {
 				log.Error("failed to archive order as rejected", zap.Stringer("serial", response.SerialNumber), zap.Error(err))
+				continue
 			}
+			archived++
+			mon.Meter("orders_rejected").Mark(1)
 		default:
-			log.Error("unexpected response", zap.Error(err))
+			log.Error("unexpected response", zap.Stringer("serial", response.SerialNumber), zap.Stringer("status", response.Status))
 		}
 	}
 
 	if err := group.Wait(); err != nil {
 		log.Error("sending agreements returned an error", zap.Error(err))
+		return archived, errs.Combine(recvErr, err)
 	}
+
+	return archived, recvErr
 }
 
 // Close stops the sending service.
@@ -0,0 +1,57 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package orders
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/storj/internal/testidentity"
+	"storj.io/storj/pkg/storj"
+)
+
+func TestSenderBackoff(t *testing.T) {
+	sender := NewSender(zaptest.NewLogger(t), nil, nil, nil, SenderConfig{
+		Interval:   time.Hour,
+		MaxBackoff: 6 * time.Hour,
+	})
+
+	satellite := testidentity.MustPregeneratedSignedIdentity(0, storj.LatestIDVersion()).ID
+	require.True(t, sender.shouldRetry(satellite))
+
+	// skipped returns the number of cycles skipped before the satellite is retried
+	skipped := func() int {
+		count := 0
+		for !sender.shouldRetry(satellite) {
+			count++
+		}
+		return count
+	}
+
+	// the next cycle after the first failure retries
+	sender.updateBackoff(satellite, errors.New("failure"))
+	require.Equal(t, 0, skipped())
+
+	// the backoff doubles with every failure
+	sender.updateBackoff(satellite, errors.New("failure"))
+	require.Equal(t, 1, skipped())
+	sender.updateBackoff(satellite, errors.New("failure"))
+	require.Equal(t, 3, skipped())
+
+	// up to the max backoff
+	for i := 0; i < 10; i++ {
+		sender.updateBackoff(satellite, errors.New("failure"))
+	}
+	require.Equal(t, 5, skipped())
+
+	// a successful settlement resets the backoff
+	sender.updateBackoff(satellite, nil)
+	require.True(t, sender.shouldRetry(satellite))
+	sender.updateBackoff(satellite, errors.New("failure"))
+	require.Equal(t, 0, skipped())
+}
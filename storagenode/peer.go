@@ -5,6 +5,7 @@ package storagenode
 
 import (
 	"context"
+	"net"
 
 	"github.com/zeebo/errs"
 	"go.uber.org/zap"
@@ -22,13 +23,20 @@ import (
 	"storj.io/storj/pkg/storj"
 	"storj.io/storj/pkg/transport"
 	"storj.io/storj/storage"
+	"storj.io/storj/storagenode/backup"
 	"storj.io/storj/storagenode/bandwidth"
 	"storj.io/storj/storagenode/collector"
+	"storj.io/storj/storagenode/console"
+	"storj.io/storj/storagenode/console/consoleserver"
+	"storj.io/storj/storagenode/contact"
+	"storj.io/storj/storagenode/earnings"
+	"storj.io/storj/storagenode/gracefulexit"
 	"storj.io/storj/storagenode/inspector"
 	"storj.io/storj/storagenode/monitor"
 	"storj.io/storj/storagenode/orders"
 	"storj.io/storj/storagenode/pieces"
 	"storj.io/storj/storagenode/piecestore"
+	"storj.io/storj/storagenode/scrubber"
 	"storj.io/storj/storagenode/trust"
 )
 
@@ -38,14 +46,18 @@ type DB interface {
 	CreateTables() error
 	// Close closes the database
 	Close() error
+	// Backup writes a consistent copy of the database to path
+	Backup(ctx context.Context, path string) error
 
 	Pieces() storage.Blobs
+	ExtraPieces() map[string]storage.Blobs
 
 	Orders() orders.DB
 	PieceInfo() pieces.DB
 	CertDB() trust.CertDB
 	Bandwidth() bandwidth.DB
 	UsedSerials() piecestore.UsedSerials
+	GracefulExit() gracefulexit.DB
 
 	// TODO: use better interfaces
 	RoutingTable() (kdb, ndb storage.KeyValueStore)
@@ -62,6 +74,15 @@ type Config struct {
 	Storage   piecestore.OldConfig
 	Storage2  piecestore.Config
 	Collector collector.Config
+	Scrubber  scrubber.Config
+	Trust     trust.Config
+	Backup    backup.Config
+	Contact   contact.Config
+
+	GracefulExit gracefulexit.Config
+
+	Earnings earnings.Config
+	Console  consoleserver.Config
 
 	Version version.Config
 }
@@ -71,6 +92,33 @@ func (config *Config) Verify(log *zap.Logger) error {
 	return config.Kademlia.Verify(log)
 }
 
+// StorageDirs returns the storage directories of the node with their allocated disk space.
+func StorageDirs(db DB, config piecestore.OldConfig) ([]pieces.Dir, error) {
+	extraDirs, err := config.ParseExtraDirs()
+	if err != nil {
+		return nil, err
+	}
+
+	dirs := []pieces.Dir{{
+		Blobs:     db.Pieces(),
+		Allocated: config.AllocatedDiskSpace.Int64(),
+	}}
+
+	extraPieces := db.ExtraPieces()
+	for _, extra := range extraDirs {
+		blobs, ok := extraPieces[extra.Path]
+		if !ok {
+			return nil, errs.New("storage directory %q is not opened", extra.Path)
+		}
+		dirs = append(dirs, pieces.Dir{
+			ID:        extra.Path,
+			Blobs:     blobs,
+			Allocated: extra.AllocatedDiskSpace.Int64(),
+		})
+	}
+	return dirs, nil
+}
+
 // Peer is the representation of a Storage Node.
 type Peer struct {
 	// core dependencies
@@ -98,12 +146,34 @@ type Peer struct {
 		Trust     *trust.Pool
 		Store     *pieces.Store
 		Endpoint  *piecestore.Endpoint
+		Deleter   *piecestore.Deleter
 		Inspector *inspector.Endpoint
 		Monitor   *monitor.Service
 		Sender    *orders.Sender
 	}
 
 	Collector *collector.Service
+	Scrubber  *scrubber.Service
+	Backup    *backup.Service
+
+	Contact struct {
+		Endpoint *contact.Endpoint
+		Chore    *contact.Chore
+	}
+
+	GracefulExit struct {
+		Endpoint *gracefulexit.Endpoint
+		Chore    *gracefulexit.Chore
+	}
+
+	Earnings *earnings.Service
+
+	// Console contains the optional JSON dashboard API
+	Console struct {
+		Listener net.Listener
+		Service  *console.Service
+		Endpoint *consoleserver.Server
+	}
 }
 
 // New creates a new Storage Node.
@@ -189,12 +259,26 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, config Config, ver
 
 	{ // setup storage
 		trustAllSatellites := !config.Storage.SatelliteIDRestriction
-		peer.Storage2.Trust, err = trust.NewPool(peer.Kademlia.Service, trustAllSatellites, config.Storage.WhitelistedSatelliteIDs)
+		peer.Storage2.Trust, err = trust.NewPool(peer.Log.Named("trust"), peer.Kademlia.Service, trustAllSatellites, config.Storage.WhitelistedSatelliteIDs, config.Trust)
+		if err != nil {
+			return nil, errs.Combine(err, peer.Close())
+		}
+
+		dirs, err := StorageDirs(peer.DB, config.Storage)
+		if err != nil {
+			return nil, errs.Combine(err, peer.Close())
+		}
+		peer.Storage2.Store = pieces.NewMultiDirStore(peer.Log.Named("pieces"), peer.DB.PieceInfo(), dirs)
+
+		allocatedDiskSpace, err := config.Storage.TotalAllocatedDiskSpace()
 		if err != nil {
 			return nil, errs.Combine(err, peer.Close())
 		}
 
-		peer.Storage2.Store = pieces.NewStore(peer.Log.Named("pieces"), peer.DB.Pieces())
+		allocations, err := config.Storage.ParseSatelliteAllocations()
+		if err != nil {
+			return nil, errs.Combine(err, peer.Close())
+		}
 
 		peer.Storage2.Monitor = monitor.NewService(
 			log.Named("piecestore:monitor"),
@@ -202,11 +286,21 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, config Config, ver
 			peer.Storage2.Store,
 			peer.DB.PieceInfo(),
 			peer.DB.Bandwidth(),
-			config.Storage.AllocatedDiskSpace.Int64(),
+			allocatedDiskSpace.Int64(),
 			config.Storage.AllocatedBandwidth.Int64(),
+			allocations,
 			//TODO use config.Storage.Monitor.Interval, but for some reason is not set
 			config.Storage.KBucketRefreshInterval,
 		)
+		peer.Kademlia.Endpoint.SetCapacityFunc(peer.Storage2.Monitor.Capacity)
+
+		peer.Storage2.Deleter = piecestore.NewDeleter(
+			peer.Log.Named("piecestore:deleter"),
+			peer.Storage2.Store,
+			peer.DB.PieceInfo(),
+			config.Storage2.DeleteQueueSize,
+			config.Storage2.DeleteWorkers,
+		)
 
 		peer.Storage2.Endpoint, err = piecestore.NewEndpoint(
 			peer.Log.Named("piecestore"),
@@ -218,6 +312,7 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, config Config, ver
 			peer.DB.Orders(),
 			peer.DB.Bandwidth(),
 			peer.DB.UsedSerials(),
+			peer.Storage2.Deleter,
 			config.Storage2,
 		)
 		if err != nil {
@@ -225,11 +320,14 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, config Config, ver
 		}
 		pb.RegisterPiecestoreServer(peer.Server.GRPC(), peer.Storage2.Endpoint)
 
+		peer.Scrubber = scrubber.NewService(peer.Log.Named("scrubber"), peer.Storage2.Store, peer.DB.PieceInfo(), config.Scrubber)
+
 		peer.Storage2.Inspector = inspector.NewEndpoint(
 			peer.Log.Named("pieces:inspector"),
 			peer.DB.PieceInfo(),
 			peer.Kademlia.Service,
 			peer.DB.Bandwidth(),
+			peer.Scrubber,
 			config.Storage,
 		)
 		pb.RegisterPieceStoreInspectorServer(peer.Server.PrivateGRPC(), peer.Storage2.Inspector)
@@ -243,7 +341,84 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, config Config, ver
 		)
 	}
 
-	peer.Collector = collector.NewService(peer.Log.Named("collector"), peer.Storage2.Store, peer.DB.PieceInfo(), config.Collector)
+	peer.Collector = collector.NewService(peer.Log.Named("collector"), peer.Storage2.Store, peer.DB.PieceInfo(), peer.Storage2.Trust, config.Collector)
+
+	peer.Backup = backup.NewService(peer.Log.Named("backup"), peer.DB, config.Backup)
+
+	{ // setup contact
+		peer.Contact.Endpoint = contact.NewEndpoint(peer.Log.Named("contact:endpoint"))
+		pb.RegisterContactServer(peer.Server.GRPC(), peer.Contact.Endpoint)
+
+		peer.Contact.Chore = contact.NewChore(
+			peer.Log.Named("contact:chore"),
+			peer.Transport,
+			peer.Kademlia.Service,
+			peer.Storage2.Trust,
+			peer.Storage2.Monitor,
+			config.Contact,
+		)
+	}
+
+	{ // setup graceful exit
+		peer.GracefulExit.Endpoint = gracefulexit.NewEndpoint(
+			peer.Log.Named("gracefulexit:endpoint"),
+			peer.Storage2.Trust,
+			peer.DB.GracefulExit(),
+		)
+		pb.RegisterNodeGracefulExitServer(peer.Server.PrivateGRPC(), peer.GracefulExit.Endpoint)
+
+		peer.GracefulExit.Chore = gracefulexit.NewChore(
+			peer.Log.Named("gracefulexit:chore"),
+			signing.SignerFromFullIdentity(peer.Identity),
+			peer.Transport,
+			peer.Kademlia.Service,
+			peer.Storage2.Store,
+			peer.DB.PieceInfo(),
+			peer.DB.GracefulExit(),
+			config.GracefulExit,
+		)
+	}
+
+	{ // setup earnings
+		peer.Earnings, err = earnings.NewService(
+			peer.Log.Named("earnings"),
+			peer.DB.Orders(),
+			peer.DB.PieceInfo(),
+			peer.DB.Bandwidth(),
+			config.Earnings,
+		)
+		if err != nil {
+			return nil, errs.Combine(err, peer.Close())
+		}
+	}
+
+	{ // setup console
+		peer.Console.Service = console.NewService(
+			peer.Log.Named("console:service"),
+			peer.Identity.ID,
+			peer.DB.PieceInfo(),
+			peer.DB.Bandwidth(),
+			peer.DB.Orders(),
+			peer.Storage2.Trust,
+			peer.Earnings,
+			peer.Version,
+			versionInfo,
+			config.Storage,
+		)
+
+		if config.Console.Address != "" {
+			peer.Console.Listener, err = net.Listen("tcp", config.Console.Address)
+			if err != nil {
+				return nil, errs.Combine(err, peer.Close())
+			}
+
+			peer.Console.Endpoint = consoleserver.NewServer(
+				peer.Log.Named("console:endpoint"),
+				peer.Console.Service,
+				peer.Console.Listener,
+			)
+		}
+	}
 
 	return peer, nil
 }
@@ -263,15 +438,40 @@ func (peer *Peer) Run(ctx context.Context) error {
 		return errs2.IgnoreCanceled(peer.Kademlia.Service.Run(ctx))
 	})
 
+	group.Go(func() error {
+		return errs2.IgnoreCanceled(peer.Storage2.Trust.Run(ctx))
+	})
 	group.Go(func() error {
 		return errs2.IgnoreCanceled(peer.Collector.Run(ctx))
 	})
+	group.Go(func() error {
+		return errs2.IgnoreCanceled(peer.Scrubber.Run(ctx))
+	})
+	group.Go(func() error {
+		return errs2.IgnoreCanceled(peer.Backup.Run(ctx))
+	})
+	group.Go(func() error {
+		return errs2.IgnoreCanceled(peer.Contact.Chore.Run(ctx))
+	})
 	group.Go(func() error {
 		return errs2.IgnoreCanceled(peer.Storage2.Sender.Run(ctx))
 	})
+	group.Go(func() error {
+		return errs2.IgnoreCanceled(peer.Storage2.Deleter.Run(ctx))
+	})
 	group.Go(func() error {
 		return errs2.IgnoreCanceled(peer.Storage2.Monitor.Run(ctx))
 	})
+	group.Go(func() error {
+		return errs2.IgnoreCanceled(peer.GracefulExit.Chore.Run(ctx))
+	})
+
+	if peer.Console.Endpoint != nil {
+		group.Go(func() error {
+			peer.Log.Sugar().Infof("Dashboard API started on %s", peer.Console.Endpoint.Addr())
+			return errs2.IgnoreCanceled(peer.Console.Endpoint.Run(ctx))
+		})
+	}
 
 	group.Go(func() error {
 		// TODO: move the message into Server instead
@@ -298,6 +498,18 @@ func (peer *Peer) Close() error {
 
 	// close services in reverse initialization order
 
+	if peer.Console.Endpoint != nil {
+		errlist.Add(peer.Console.Endpoint.Close())
+	} else if peer.Console.Listener != nil {
+		errlist.Add(peer.Console.Listener.Close())
+	}
+
+	if peer.GracefulExit.Chore != nil {
+		errlist.Add(peer.GracefulExit.Chore.Close())
+	}
+	if peer.Contact.Chore != nil {
+		errlist.Add(peer.Contact.Chore.Close())
+	}
 	if peer.Storage2.Monitor != nil {
 		errlist.Add(peer.Storage2.Monitor.Close())
 	}
@@ -307,6 +519,15 @@ func (peer *Peer) Close() error {
 	if peer.Collector != nil {
 		errlist.Add(peer.Collector.Close())
 	}
+	if peer.Scrubber != nil {
+		errlist.Add(peer.Scrubber.Close())
+	}
+	if peer.Backup != nil {
+		errlist.Add(peer.Backup.Close())
+	}
+	if peer.Storage2.Trust != nil {
+		errlist.Add(peer.Storage2.Trust.Close())
+	}
 
 	if peer.Kademlia.Service != nil {
 		errlist.Add(peer.Kademlia.Service.Close())
@@ -0,0 +1,116 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package pieces
+
+import (
+	"context"
+	"os"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/storage"
+)
+
+// CheckStats contains the results of cross-checking the piece info database against the piece files.
+type CheckStats struct {
+	// Checked is the number of pieces in the piece info database.
+	Checked int64
+	// Missing is the number of pieces in the piece info database without a piece file.
+	Missing int64
+	// Removed is the number of pieces removed from the piece info database.
+	Removed int64
+	// Unknown is the number of piece files without an entry in the piece info database.
+	Unknown int64
+}
+
+// CheckPieceInfo verifies that every piece in the piece info database has a piece file
+// and that every piece file is in the piece info database.
+// When repair is set the entries of the missing pieces are removed from the database,
+// the unknown piece files can be added to the database with RecoverPieceInfo.
+func (store *Store) CheckPieceInfo(ctx context.Context, repair bool) (stats CheckStats, err error) {
+	if store.pieceinfo == nil {
+		return stats, Error.New("piece info database required for checking")
+	}
+
+	var satelliteID storj.NodeID
+	var pieceID storj.PieceID
+	for {
+		infos, err := store.pieceinfo.GetStoredAfter(ctx, satelliteID, pieceID, drainBatchSize)
+		if err != nil {
+			return stats, Error.Wrap(err)
+		}
+		if len(infos) == 0 {
+			break
+		}
+
+		for _, info := range infos {
+			satelliteID, pieceID = info.SatelliteID, info.PieceID
+			stats.Checked++
+
+			exists, err := store.exists(ctx, info.SatelliteID, info.PieceID)
+			if err != nil {
+				return stats, Error.Wrap(err)
+			}
+			if exists {
+				continue
+			}
+
+			stats.Missing++
+			store.log.Warn("piece file missing",
+				zap.Stringer("Satellite ID", info.SatelliteID),
+				zap.Stringer("Piece ID", info.PieceID))
+
+			if repair {
+				if err := store.pieceinfo.Delete(ctx, info.SatelliteID, info.PieceID); err != nil {
+					return stats, Error.Wrap(err)
+				}
+				stats.Removed++
+			}
+		}
+	}
+
+	for i := range store.dirs {
+		err := store.dirs[i].Blobs.Walk(ctx, func(ref storage.BlobRef) error {
+			satelliteID, err := storj.NodeIDFromBytes(ref.Namespace)
+			if err != nil {
+				stats.Unknown++
+				return nil
+			}
+			pieceID, err := storj.PieceIDFromBytes(ref.Key)
+			if err != nil {
+				stats.Unknown++
+				return nil
+			}
+			if _, err := store.pieceinfo.Get(ctx, satelliteID, pieceID); err != nil {
+				stats.Unknown++
+			}
+			return nil
+		})
+		if err != nil {
+			return stats, Error.Wrap(err)
+		}
+	}
+
+	return stats, nil
+}
+
+// exists returns whether the piece file exists in any of the storage directories.
+func (store *Store) exists(ctx context.Context, satellite storj.NodeID, pieceID storj.PieceID) (bool, error) {
+	for _, dir := range store.dirs {
+		blob, err := dir.Blobs.Open(ctx, storage.BlobRef{
+			Namespace: satellite.Bytes(),
+			Key:       pieceID.Bytes(),
+		})
+		if err != nil {
+			if os.IsNotExist(errs.Unwrap(err)) {
+				continue
+			}
+			return false, err
+		}
+		return true, blob.Close()
+	}
+	return false, nil
+}
@@ -53,6 +53,7 @@ func TestPieceInfo(t *testing.T) {
 
 			PieceID:         pieceid0,
 			PieceSize:       123,
+			PieceCreation:   now.Add(-time.Hour),
 			PieceExpiration: &now,
 
 			UplinkPieceHash: piecehash0,
@@ -72,6 +73,7 @@ func TestPieceInfo(t *testing.T) {
 
 			PieceID:         pieceid0,
 			PieceSize:       123,
+			PieceCreation:   now.Add(-time.Hour),
 			PieceExpiration: &now,
 
 			UplinkPieceHash: piecehash1,
@@ -91,6 +93,7 @@ func TestPieceInfo(t *testing.T) {
 
 			PieceID:         pieceid0,
 			PieceSize:       123,
+			PieceCreation:   now.Add(-time.Hour),
 			PieceExpiration: &now,
 
 			UplinkPieceHash: piecehash2,
@@ -122,6 +125,15 @@ func TestPieceInfo(t *testing.T) {
 		require.NoError(t, err)
 		require.Empty(t, cmp.Diff(info1, info1loaded, cmp.Comparer(pb.Equal)))
 
+		// getting pieces created before a time
+		pieceIDs, err := pieceinfos.GetPieceIDs(ctx, satellite0.ID, now, 10, 0)
+		require.NoError(t, err)
+		require.Equal(t, []storj.PieceID{pieceid0}, pieceIDs)
+
+		pieceIDs, err = pieceinfos.GetPieceIDs(ctx, satellite0.ID, now.Add(-2*time.Hour), 10, 0)
+		require.NoError(t, err)
+		require.Empty(t, pieceIDs)
+
 		// getting no expired pieces
 		expired, err := pieceinfos.GetExpired(ctx, now.Add(-10*time.Hour), 10)
 		assert.NoError(t, err)
@@ -162,3 +174,55 @@ func TestPieceInfo(t *testing.T) {
 		require.Error(t, err)
 	})
 }
+
+func TestSpaceUsedByteHours(t *testing.T) {
+	storagenodedbtest.Run(t, func(t *testing.T, db storagenode.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		pieceinfos := db.PieceInfo()
+
+		satellite0 := testidentity.MustPregeneratedSignedIdentity(0, storj.LatestIDVersion()).ID
+		satellite1 := testidentity.MustPregeneratedSignedIdentity(1, storj.LatestIDVersion()).ID
+		uplink := testidentity.MustPregeneratedSignedIdentity(2, storj.LatestIDVersion())
+
+		from := time.Date(2019, 5, 1, 0, 0, 0, 0, time.UTC)
+		to := from.Add(10 * time.Hour)
+
+		add := func(satelliteID storj.NodeID, size int64, created time.Time, expiration *time.Time) {
+			pieceID := storj.NewPieceID()
+			require.NoError(t, pieceinfos.Add(ctx, &pieces.Info{
+				SatelliteID:     satelliteID,
+				PieceID:         pieceID,
+				PieceSize:       size,
+				PieceCreation:   created,
+				PieceExpiration: expiration,
+				UplinkPieceHash: &pb.PieceHash{PieceId: pieceID},
+				Uplink:          uplink.PeerIdentity(),
+			}))
+		}
+
+		expiration := from.Add(4 * time.Hour)
+		expired := from.Add(-time.Hour)
+		// stored for the whole period
+		add(satellite0, 100, from.Add(-time.Hour), nil)
+		// created during the period
+		add(satellite0, 200, from.Add(5*time.Hour), nil)
+		// expired during the period
+		add(satellite1, 300, from.Add(-time.Hour), &expiration)
+		// outside of the period
+		add(satellite1, 400, from.Add(-2*time.Hour), &expired)
+		add(satellite1, 500, to.Add(time.Hour), nil)
+
+		byteHours, err := pieceinfos.SpaceUsedByteHours(ctx, from, to)
+		require.NoError(t, err)
+		require.Len(t, byteHours, 2)
+		assert.InDelta(t, 100*10+200*5, byteHours[satellite0], 0.1)
+		assert.InDelta(t, 300*4, byteHours[satellite1], 0.1)
+
+		byteHours, err = pieceinfos.SpaceUsedByteHours(ctx, to.Add(2*time.Hour), to.Add(3*time.Hour))
+		require.NoError(t, err)
+		assert.InDelta(t, 100+200, byteHours[satellite0], 0.1)
+		assert.InDelta(t, 500, byteHours[satellite1], 0.1)
+	})
+}
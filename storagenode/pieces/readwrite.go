@@ -5,15 +5,33 @@ package pieces
 
 import (
 	"bufio"
+	"bytes"
+	"encoding/binary"
 	"hash"
 	"io"
 
+	"github.com/gogo/protobuf/proto"
 	"github.com/zeebo/errs"
 
+	"storj.io/storj/pkg/pb"
 	"storj.io/storj/pkg/pkcrypto"
 	"storj.io/storj/storage"
 )
 
+// Piece files with a header start with a reserved area of headerSize bytes:
+//
+//   magic (8 bytes) | header length (2 bytes, big endian) | header | zero padding
+//
+// Piece files written before the header was introduced contain only the data.
+const (
+	headerSize = 4096
+	// headerPrefixSize is the size of the magic and the header length
+	headerPrefixSize = 10
+)
+
+// headerMagic identifies piece files with a header.
+var headerMagic = []byte("storjpc1")
+
 // Writer implements a piece writer that writes content to blob store and calculates a hash.
 type Writer struct {
 	buf  bufio.Writer
@@ -21,11 +39,20 @@ type Writer struct {
 	blob storage.BlobWriter
 	size int64
 
-	closed bool
+	storageDir string
+	closed     bool
+
+	// store is notified about the space used by the committed piece
+	store *Store
 }
 
 // NewWriter creates a new writer for storage.BlobWriter.
 func NewWriter(blob storage.BlobWriter, bufferSize int) (*Writer, error) {
+	// reserve space for the header, which is written on commit
+	if _, err := blob.Write(make([]byte, headerSize)); err != nil {
+		return nil, Error.Wrap(err)
+	}
+
 	w := &Writer{}
 	w.buf = *bufio.NewWriterSize(blob, bufferSize)
 	w.blob = blob
@@ -44,11 +71,17 @@ func (w *Writer) Write(data []byte) (int, error) {
 // Size returns the amount of data written so far.
 func (w *Writer) Size() int64 { return w.size }
 
+// StoredSize returns the size of the piece on disk, including the header.
+func (w *Writer) StoredSize() int64 { return headerSize + w.size }
+
 // Hash returns the hash of data written so far.
 func (w *Writer) Hash() []byte { return w.hash.Sum(nil) }
 
-// Commit commits piece to permanent storage.
-func (w *Writer) Commit() error {
+// StorageDir returns the ID of the storage directory the piece is written to.
+func (w *Writer) StorageDir() string { return w.storageDir }
+
+// Commit writes the header and commits piece to permanent storage.
+func (w *Writer) Commit(header *pb.PieceHeader) error {
 	if w.closed {
 		return Error.New("already closed")
 	}
@@ -56,7 +89,42 @@ func (w *Writer) Commit() error {
 	if err := w.buf.Flush(); err != nil {
 		return Error.Wrap(errs.Combine(err, w.blob.Cancel()))
 	}
-	return Error.Wrap(w.blob.Commit())
+	if err := w.writeHeader(header); err != nil {
+		return Error.Wrap(errs.Combine(err, w.blob.Cancel()))
+	}
+	if err := w.blob.Commit(); err != nil {
+		return Error.Wrap(err)
+	}
+	if w.store != nil {
+		w.store.addUsed(w.storageDir, w.StoredSize())
+	}
+	return nil
+}
+
+// writeHeader writes the header into the reserved area at the start of the blob.
+func (w *Writer) writeHeader(header *pb.PieceHeader) error {
+	header.FormatVersion = pb.PieceHeader_FORMAT_V1
+	headerBytes, err := proto.Marshal(header)
+	if err != nil {
+		return err
+	}
+	if headerPrefixSize+len(headerBytes) > headerSize {
+		return Error.New("header too large: %d bytes", len(headerBytes))
+	}
+
+	prefix := make([]byte, headerPrefixSize)
+	copy(prefix, headerMagic)
+	binary.BigEndian.PutUint16(prefix[len(headerMagic):], uint16(len(headerBytes)))
+
+	if _, err := w.blob.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+	if _, err := w.blob.Write(append(prefix, headerBytes...)); err != nil {
+		return err
+	}
+	// the blob is committed at the current position, hence seek back to the end of the data
+	_, err = w.blob.Seek(headerSize+w.size, io.SeekStart)
+	return err
 }
 
 // Cancel deletes any temporarily written data.
@@ -71,10 +139,12 @@ func (w *Writer) Cancel() error {
 
 // Reader implements a piece reader that reads content from blob store.
 type Reader struct {
-	buf  bufio.Reader
-	blob storage.BlobReader
-	pos  int64
-	size int64
+	buf    bufio.Reader
+	blob   storage.BlobReader
+	pos    int64
+	size   int64
+	offset int64
+	header *pb.PieceHeader
 }
 
 // NewReader creates a new reader for storage.BlobReader.
@@ -85,13 +155,60 @@ func NewReader(blob storage.BlobReader, bufferSize int) (*Reader, error) {
 	}
 
 	reader := &Reader{}
-	reader.buf = *bufio.NewReaderSize(blob, bufferSize)
 	reader.blob = blob
 	reader.size = size
+	reader.header = &pb.PieceHeader{FormatVersion: pb.PieceHeader_FORMAT_V0}
+
+	if err := reader.readHeader(); err != nil {
+		return nil, Error.Wrap(err)
+	}
+	if _, err := blob.Seek(reader.offset, io.SeekStart); err != nil {
+		return nil, Error.Wrap(err)
+	}
+	reader.buf = *bufio.NewReaderSize(blob, bufferSize)
 
 	return reader, nil
 }
 
+// readHeader reads the header of the blob, when it has one.
+func (r *Reader) readHeader() error {
+	if r.size < headerSize {
+		return nil
+	}
+
+	prefix := make([]byte, headerPrefixSize)
+	if _, err := r.blob.ReadAt(prefix, 0); err != nil {
+		return err
+	}
+	if !bytes.Equal(prefix[:len(headerMagic)], headerMagic) {
+		return nil
+	}
+
+	headerLength := int(binary.BigEndian.Uint16(prefix[len(headerMagic):]))
+	if headerPrefixSize+headerLength > headerSize {
+		return Error.New("invalid header length: %d bytes", headerLength)
+	}
+
+	headerBytes := make([]byte, headerLength)
+	if _, err := r.blob.ReadAt(headerBytes, headerPrefixSize); err != nil {
+		return err
+	}
+
+	header := &pb.PieceHeader{}
+	if err := proto.Unmarshal(headerBytes, header); err != nil {
+		return err
+	}
+
+	r.header = header
+	r.offset = headerSize
+	r.size -= headerSize
+	return nil
+}
+
+// Header returns the header of the piece, pieces written without a header
+// return an empty header with FORMAT_V0.
+func (r *Reader) Header() *pb.PieceHeader { return r.header }
+
 // Read reads data from the underlying blob, buffering as necessary.
 func (r *Reader) Read(data []byte) (int, error) {
 	n, err := r.blob.Read(data)
@@ -105,21 +222,28 @@ func (r *Reader) Seek(offset int64, whence int) (int64, error) {
 		return r.pos, nil
 	}
 
+	if whence == io.SeekStart {
+		offset += r.offset
+	}
+
 	r.buf.Reset(r.blob)
 	pos, err := r.blob.Seek(offset, whence)
-	r.pos = pos
-	return pos, Error.Wrap(err)
+	r.pos = pos - r.offset
+	return r.pos, Error.Wrap(err)
 }
 
 // ReadAt reads data at the specified offset
 func (r *Reader) ReadAt(data []byte, offset int64) (int, error) {
-	n, err := r.blob.ReadAt(data, offset)
+	n, err := r.blob.ReadAt(data, offset+r.offset)
 	return n, Error.Wrap(err)
 }
 
 // Size returns the amount of data written so far.
 func (r *Reader) Size() int64 { return r.size }
 
+// StoredSize returns the size of the piece on disk, including the header.
+func (r *Reader) StoredSize() int64 { return r.offset + r.size }
+
 // Close closes the reader.
 func (r *Reader) Close() error {
 	r.buf.Reset(nil)
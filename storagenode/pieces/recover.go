@@ -0,0 +1,207 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package pieces
+
+import (
+	"bytes"
+	"context"
+	"crypto/x509"
+	"io"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/storj/pkg/auth/signing"
+	"storj.io/storj/pkg/identity"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/peertls"
+	"storj.io/storj/pkg/pkcrypto"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/storage"
+)
+
+// ErrUnrecoverable is returned when the piece info cannot be recovered from the piece file.
+var ErrUnrecoverable = errs.Class("unrecoverable piece")
+
+// Signees returns the signees for verifying the signatures of trusted satellites.
+type Signees interface {
+	GetSignee(ctx context.Context, id storj.NodeID) (signing.Signee, error)
+}
+
+// RecoverStats contains the results of recovering the piece info database.
+type RecoverStats struct {
+	// Recovered is the number of pieces added to the piece info database.
+	Recovered int64
+	// Existing is the number of pieces already in the piece info database.
+	Existing int64
+	// Unrecoverable is the number of pieces without a valid header.
+	Unrecoverable int64
+}
+
+// RecoverPieceInfo rebuilds the piece info database from the headers of the
+// stored piece files. Pieces already in the database are left unchanged and
+// pieces of satellites without a signee in satellites are unrecoverable.
+func (store *Store) RecoverPieceInfo(ctx context.Context, satellites Signees) (stats RecoverStats, err error) {
+	if store.pieceinfo == nil {
+		return stats, Error.New("piece info database required for recovery")
+	}
+
+	signees := map[storj.NodeID]signing.Signee{}
+
+	for i := range store.dirs {
+		dir := &store.dirs[i]
+		err := dir.Blobs.Walk(ctx, func(ref storage.BlobRef) error {
+			satelliteID, err := storj.NodeIDFromBytes(ref.Namespace)
+			if err != nil {
+				stats.Unrecoverable++
+				return nil
+			}
+			pieceID, err := storj.PieceIDFromBytes(ref.Key)
+			if err != nil {
+				stats.Unrecoverable++
+				return nil
+			}
+
+			if _, err := store.pieceinfo.Get(ctx, satelliteID, pieceID); err == nil {
+				stats.Existing++
+				return nil
+			}
+
+			signee, ok := signees[satelliteID]
+			if !ok {
+				signee, err = satellites.GetSignee(ctx, satelliteID)
+				if err != nil {
+					if ctx.Err() != nil {
+						return ctx.Err()
+					}
+					store.log.Warn("unable to get satellite signee", zap.Stringer("Satellite ID", satelliteID), zap.Error(err))
+					signee = nil
+				}
+				signees[satelliteID] = signee
+			}
+			if signee == nil {
+				stats.Unrecoverable++
+				return nil
+			}
+
+			info, err := store.recoverInfo(ctx, dir, signee, pieceID)
+			if err != nil {
+				if !ErrUnrecoverable.Has(err) {
+					return err
+				}
+				store.log.Warn("unable to recover piece",
+					zap.String("dir", dir.ID),
+					zap.Stringer("Satellite ID", satelliteID),
+					zap.Stringer("Piece ID", pieceID),
+					zap.Error(err))
+				stats.Unrecoverable++
+				return nil
+			}
+
+			if err := store.pieceinfo.Add(ctx, info); err != nil {
+				return err
+			}
+			stats.Recovered++
+			return nil
+		})
+		if err != nil {
+			return stats, Error.Wrap(err)
+		}
+	}
+	return stats, nil
+}
+
+// recoverInfo reconstructs the piece info from the piece header, verifies the
+// signatures of the order limit and the uplink piece hash and verifies the
+// piece data against the uplink piece hash.
+func (store *Store) recoverInfo(ctx context.Context, dir *Dir, satellite signing.Signee, pieceID storj.PieceID) (*Info, error) {
+	satelliteID := satellite.ID()
+
+	blob, err := dir.Blobs.Open(ctx, storage.BlobRef{
+		Namespace: satelliteID.Bytes(),
+		Key:       pieceID.Bytes(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	reader, err := NewReader(blob, readBufferSize.Int())
+	if err != nil {
+		return nil, errs.Combine(ErrUnrecoverable.Wrap(err), blob.Close())
+	}
+	defer func() { _ = reader.Close() }()
+
+	header := reader.Header()
+	if header.FormatVersion == pb.PieceHeader_FORMAT_V0 {
+		return nil, ErrUnrecoverable.New("piece has no header")
+	}
+	if header.UplinkPieceHash == nil {
+		return nil, ErrUnrecoverable.New("header missing uplink piece hash")
+	}
+	if header.OrderLimit == nil {
+		return nil, ErrUnrecoverable.New("header missing order limit")
+	}
+	if header.OrderLimit.SatelliteId != satelliteID || header.OrderLimit.PieceId != pieceID || header.UplinkPieceHash.PieceId != pieceID {
+		return nil, ErrUnrecoverable.New("header does not match piece")
+	}
+	if err := signing.VerifyOrderLimitSignature(satellite, header.OrderLimit); err != nil {
+		return nil, ErrUnrecoverable.New("unable to verify order limit: %v", err)
+	}
+
+	hash := pkcrypto.NewHash()
+	if _, err := io.CopyN(hash, reader, reader.Size()); err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(hash.Sum(nil), header.UplinkPieceHash.Hash) {
+		return nil, ErrUnrecoverable.New("piece data does not match uplink piece hash")
+	}
+
+	if len(header.UplinkCertificateChain) <= peertls.CAIndex {
+		return nil, ErrUnrecoverable.New("header missing uplink certificate chain")
+	}
+	chain, err := pkcrypto.CertsFromDER(header.UplinkCertificateChain)
+	if err != nil {
+		return nil, ErrUnrecoverable.Wrap(err)
+	}
+	uplink, err := identity.PeerIdentityFromChain(chain)
+	if err != nil {
+		return nil, ErrUnrecoverable.Wrap(err)
+	}
+	if uplink.ID != header.OrderLimit.UplinkId {
+		return nil, ErrUnrecoverable.New("uplink certificate chain does not match order limit")
+	}
+	if err := peertls.VerifyPeerCertChains(nil, [][]*x509.Certificate{chain}); err != nil {
+		return nil, ErrUnrecoverable.Wrap(err)
+	}
+	if err := signing.VerifyPieceHashSignature(signing.SigneeFromPeerIdentity(uplink), header.UplinkPieceHash); err != nil {
+		return nil, ErrUnrecoverable.New("unable to verify uplink piece hash: %v", err)
+	}
+
+	creation, err := ptypes.Timestamp(header.CreationTime)
+	if err != nil {
+		return nil, ErrUnrecoverable.Wrap(err)
+	}
+
+	var expiration *time.Time
+	if header.PieceExpiration != nil {
+		t, err := ptypes.Timestamp(header.PieceExpiration)
+		if err != nil {
+			return nil, ErrUnrecoverable.Wrap(err)
+		}
+		expiration = &t
+	}
+
+	return &Info{
+		SatelliteID:     satelliteID,
+		PieceID:         pieceID,
+		PieceSize:       reader.StoredSize(),
+		PieceCreation:   creation,
+		PieceExpiration: expiration,
+		UplinkPieceHash: header.UplinkPieceHash,
+		Uplink:          uplink,
+		StorageDir:      dir.ID,
+	}, nil
+}
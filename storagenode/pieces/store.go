@@ -5,6 +5,8 @@ package pieces
 
 import (
 	"context"
+	"io"
+	"sync"
 	"time"
 
 	"github.com/zeebo/errs"
@@ -21,6 +23,8 @@ const (
 	readBufferSize  = 256 * memory.KiB
 	writeBufferSize = 256 * memory.KiB
 	preallocSize    = 4 * memory.MiB
+
+	drainBatchSize = 1000
 )
 
 // Error is the default error class.
@@ -32,10 +36,13 @@ type Info struct {
 
 	PieceID         storj.PieceID
 	PieceSize       int64
+	PieceCreation   time.Time
 	PieceExpiration *time.Time
 
 	UplinkPieceHash *pb.PieceHash
 	Uplink          *identity.PeerIdentity
+
+	StorageDir string
 }
 
 // ExpiredInfo is a fully namespaced piece id
@@ -45,39 +52,98 @@ type ExpiredInfo struct {
 	PieceSize   int64
 }
 
+// StoredInfo is a fully namespaced piece id stored in a storage directory
+type StoredInfo struct {
+	SatelliteID storj.NodeID
+	PieceID     storj.PieceID
+	PieceSize   int64
+}
+
 // DB stores meta information about a piece, the actual piece is stored in storage.Blobs
 type DB interface {
 	// Add inserts Info to the database.
 	Add(context.Context, *Info) error
 	// Get returns Info about a piece.
 	Get(ctx context.Context, satelliteID storj.NodeID, pieceID storj.PieceID) (*Info, error)
+	// GetPieceIDs gets pieceIDs using the satelliteID
+	GetPieceIDs(ctx context.Context, satelliteID storj.NodeID, createdBefore time.Time, limit, offset int) (pieceIDs []storj.PieceID, err error)
 	// Delete deletes Info about a piece.
 	Delete(ctx context.Context, satelliteID storj.NodeID, pieceID storj.PieceID) error
 	// DeleteFailed marks piece deletion from disk failed
 	DeleteFailed(ctx context.Context, satelliteID storj.NodeID, pieceID storj.PieceID, failedAt time.Time) error
 	// SpaceUsed calculates disk space used by all pieces
 	SpaceUsed(ctx context.Context) (int64, error)
+	// SpaceUsedBySatellite calculates disk space used by the pieces of each satellite
+	SpaceUsedBySatellite(ctx context.Context) (map[storj.NodeID]int64, error)
+	// SpaceUsedByteHours calculates the byte-hours stored between from and to by the pieces of each satellite
+	SpaceUsedByteHours(ctx context.Context, from, to time.Time) (map[storj.NodeID]float64, error)
+	// SpaceUsedByStorageDir calculates disk space used by the pieces of each storage directory
+	SpaceUsedByStorageDir(ctx context.Context) (map[string]int64, error)
+	// GetByStorageDir gets pieces stored in the storage directory
+	GetByStorageDir(ctx context.Context, storageDir string, limit int) ([]StoredInfo, error)
+	// GetStoredAfter gets pieces ordered by satellite and piece id, starting after the specified piece
+	GetStoredAfter(ctx context.Context, satelliteID storj.NodeID, pieceID storj.PieceID, limit int) ([]StoredInfo, error)
+	// UpdateStorageDir updates the storage directory of a piece
+	UpdateStorageDir(ctx context.Context, satelliteID storj.NodeID, pieceID storj.PieceID, storageDir string) error
 	// GetExpired gets orders that are expired and were created before some time
 	GetExpired(ctx context.Context, expiredAt time.Time, limit int64) ([]ExpiredInfo, error)
 }
 
+// Dir is a storage directory used by the store.
+type Dir struct {
+	// ID identifies the directory in the piece info database,
+	// the main directory has an empty ID.
+	ID    string
+	Blobs storage.Blobs
+	// Allocated is the disk space allocated for the directory,
+	// zero means the directory is only limited by the free disk space.
+	Allocated int64
+}
+
 // Store implements storing pieces onto a blob storage implementation.
+//
+// The pieces are spread across multiple storage directories, new pieces are
+// placed into the directory with the most available space and directories
+// without available space are only used for reading.
 type Store struct {
-	log   *zap.Logger
-	blobs storage.Blobs
+	log       *zap.Logger
+	pieceinfo DB
+	dirs      []Dir
+
+	// used caches the space used by the pieces of each directory, it is
+	// loaded from pieceinfo on first use and updated as pieces are written
+	// and removed. trash caches the space used by the trashed pieces of each
+	// directory and satellite, which stays in use until the trash is emptied.
+	usedMu sync.Mutex
+	used   map[string]int64
+	trash  map[string]map[storj.NodeID]int64
 }
 
-// NewStore creates a new piece store
+// NewStore creates a new piece store with a single storage directory.
 func NewStore(log *zap.Logger, blobs storage.Blobs) *Store {
+	return NewMultiDirStore(log, nil, []Dir{{Blobs: blobs}})
+}
+
+// NewMultiDirStore creates a new piece store using multiple storage directories.
+//
+// pieceinfo is used for locating the pieces and calculating the used space of
+// the directories, when it is nil the pieces are searched from all directories.
+func NewMultiDirStore(log *zap.Logger, pieceinfo DB, dirs []Dir) *Store {
 	return &Store{
-		log:   log,
-		blobs: blobs,
+		log:       log,
+		pieceinfo: pieceinfo,
+		dirs:      dirs,
 	}
 }
 
 // Writer returns a new piece writer.
 func (store *Store) Writer(ctx context.Context, satellite storj.NodeID, pieceID storj.PieceID) (*Writer, error) {
-	blob, err := store.blobs.Create(ctx, storage.BlobRef{
+	dir, err := store.selectDir(ctx, nil)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	blob, err := dir.Blobs.Create(ctx, storage.BlobRef{
 		Namespace: satellite.Bytes(),
 		Key:       pieceID.Bytes(),
 	}, preallocSize.Int64())
@@ -86,15 +152,29 @@ func (store *Store) Writer(ctx context.Context, satellite storj.NodeID, pieceID
 	}
 
 	writer, err := NewWriter(blob, writeBufferSize.Int())
-	return writer, Error.Wrap(err)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	writer.storageDir = dir.ID
+	writer.store = store
+	return writer, nil
 }
 
 // Reader returns a new piece reader.
 func (store *Store) Reader(ctx context.Context, satellite storj.NodeID, pieceID storj.PieceID) (*Reader, error) {
-	blob, err := store.blobs.Open(ctx, storage.BlobRef{
+	ref := storage.BlobRef{
 		Namespace: satellite.Bytes(),
 		Key:       pieceID.Bytes(),
-	})
+	}
+
+	var blob storage.BlobReader
+	var err error
+	for _, dir := range store.locate(ctx, satellite, pieceID) {
+		blob, err = dir.Blobs.Open(ctx, ref)
+		if err == nil {
+			break
+		}
+	}
 	if err != nil {
 		return nil, Error.Wrap(err)
 	}
@@ -105,11 +185,302 @@ func (store *Store) Reader(ctx context.Context, satellite storj.NodeID, pieceID
 
 // Delete deletes the specified piece.
 func (store *Store) Delete(ctx context.Context, satellite storj.NodeID, pieceID storj.PieceID) error {
-	err := store.blobs.Delete(ctx, storage.BlobRef{
+	ref := storage.BlobRef{
 		Namespace: satellite.Bytes(),
 		Key:       pieceID.Bytes(),
-	})
-	return Error.Wrap(err)
+	}
+
+	// the piece info may already be deleted, hence the piece is deleted from all directories
+	var group errs.Group
+	for i := range store.dirs {
+		dir := &store.dirs[i]
+		size, sizeErr := blobSize(ctx, dir.Blobs, ref)
+		err := dir.Blobs.Delete(ctx, ref)
+		if err == nil && sizeErr == nil {
+			store.addUsed(dir.ID, -size)
+		}
+		group.Add(err)
+	}
+	return Error.Wrap(group.Err())
+}
+
+// Drain moves all pieces from the specified storage directory to the other directories.
+func (store *Store) Drain(ctx context.Context, dirID string) (moved int64, err error) {
+	source := store.dir(dirID)
+	if source == nil {
+		return 0, Error.New("unknown storage directory %q", dirID)
+	}
+	if store.pieceinfo == nil {
+		return 0, Error.New("piece info database required for draining")
+	}
+
+	for {
+		infos, err := store.pieceinfo.GetByStorageDir(ctx, dirID, drainBatchSize)
+		if err != nil {
+			return moved, Error.Wrap(err)
+		}
+		if len(infos) == 0 {
+			return moved, nil
+		}
+
+		for _, info := range infos {
+			if err := store.move(ctx, source, info); err != nil {
+				return moved, Error.Wrap(err)
+			}
+			moved++
+		}
+	}
+}
+
+// move copies a piece from the source directory to the directory with the most
+// available space and removes it from the source.
+func (store *Store) move(ctx context.Context, source *Dir, info StoredInfo) error {
+	target, err := store.selectDir(ctx, source)
+	if err != nil {
+		return err
+	}
+
+	ref := storage.BlobRef{
+		Namespace: info.SatelliteID.Bytes(),
+		Key:       info.PieceID.Bytes(),
+	}
+
+	reader, err := source.Blobs.Open(ctx, ref)
+	if err != nil {
+		return err
+	}
+	size, err := copyBlob(ctx, target.Blobs, ref, reader)
+	// the reader needs to be closed before the source can be deleted
+	if err := errs.Combine(err, reader.Close()); err != nil {
+		return err
+	}
+
+	if err := store.pieceinfo.UpdateStorageDir(ctx, info.SatelliteID, info.PieceID, target.ID); err != nil {
+		return errs.Combine(err, target.Blobs.Delete(ctx, ref))
+	}
+	store.addUsed(target.ID, size)
+
+	if err := source.Blobs.Delete(ctx, ref); err != nil {
+		return err
+	}
+	store.addUsed(source.ID, -size)
+	return nil
+}
+
+// copyBlob copies the content of the reader into a new blob and returns its size.
+func copyBlob(ctx context.Context, blobs storage.Blobs, ref storage.BlobRef, reader storage.BlobReader) (int64, error) {
+	size, err := reader.Size()
+	if err != nil {
+		return 0, err
+	}
+
+	writer, err := blobs.Create(ctx, ref, size)
+	if err != nil {
+		return 0, err
+	}
+	if _, err := io.Copy(writer, reader); err != nil {
+		return 0, errs.Combine(err, writer.Cancel())
+	}
+	return size, writer.Commit()
+}
+
+// blobSize returns the size of the blob on disk, including the piece header.
+func blobSize(ctx context.Context, blobs storage.Blobs, ref storage.BlobRef) (int64, error) {
+	blob, err := blobs.Open(ctx, ref)
+	if err != nil {
+		return 0, err
+	}
+	size, err := blob.Size()
+	return size, errs.Combine(err, blob.Close())
+}
+
+// selectDir returns the directory with the most available space,
+// directories without enough space for a new piece are skipped.
+func (store *Store) selectDir(ctx context.Context, exclude *Dir) (*Dir, error) {
+	used, err := store.usedByDir(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var selected *Dir
+	var selectedAvailable int64
+	for i := range store.dirs {
+		dir := &store.dirs[i]
+		if exclude != nil && dir.ID == exclude.ID {
+			continue
+		}
+
+		available, err := dir.Blobs.FreeSpace()
+		if err != nil {
+			store.log.Warn("unable to get free space", zap.String("dir", dir.ID), zap.Error(err))
+			continue
+		}
+		if dir.Allocated > 0 && dir.Allocated-used[dir.ID] < available {
+			available = dir.Allocated - used[dir.ID]
+		}
+
+		// full directories are read-only
+		if available < preallocSize.Int64() {
+			continue
+		}
+
+		if selected == nil || available > selectedAvailable {
+			selected, selectedAvailable = dir, available
+		}
+	}
+
+	if selected == nil {
+		return nil, Error.New("no storage directory with available space")
+	}
+	return selected, nil
+}
+
+// usedByDir returns the space used by the pieces and the trash of each directory.
+func (store *Store) usedByDir(ctx context.Context) (map[string]int64, error) {
+	store.usedMu.Lock()
+	defer store.usedMu.Unlock()
+
+	if err := store.loadUsedLocked(ctx); err != nil {
+		return nil, err
+	}
+
+	used := make(map[string]int64, len(store.used))
+	for id, size := range store.used {
+		used[id] = size
+	}
+	for id, trash := range store.trash {
+		for _, size := range trash {
+			used[id] += size
+		}
+	}
+	return used, nil
+}
+
+// SpaceUsedByTrash returns the space used by the trashed pieces of each satellite.
+func (store *Store) SpaceUsedByTrash(ctx context.Context) (map[storj.NodeID]int64, error) {
+	store.usedMu.Lock()
+	defer store.usedMu.Unlock()
+
+	if err := store.loadUsedLocked(ctx); err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	used := map[storj.NodeID]int64{}
+	for _, trash := range store.trash {
+		for satellite, size := range trash {
+			used[satellite] += size
+		}
+	}
+	return used, nil
+}
+
+// loadUsedLocked loads the caches of the used space on first use.
+func (store *Store) loadUsedLocked(ctx context.Context) error {
+	if store.used != nil {
+		return nil
+	}
+
+	used := map[string]int64{}
+	if store.pieceinfo != nil {
+		var err error
+		used, err = store.pieceinfo.SpaceUsedByStorageDir(ctx)
+		if err != nil {
+			return err
+		}
+	}
+
+	trash := make(map[string]map[storj.NodeID]int64, len(store.dirs))
+	for _, dir := range store.dirs {
+		dirTrash, err := trashUsed(ctx, dir.Blobs)
+		if err != nil {
+			return err
+		}
+		trash[dir.ID] = dirTrash
+	}
+
+	store.used, store.trash = used, trash
+	return nil
+}
+
+// addUsed adds size to the cached space used by the pieces of the directory.
+func (store *Store) addUsed(dirID string, size int64) {
+	store.usedMu.Lock()
+	defer store.usedMu.Unlock()
+
+	// the cache is loaded with the current usage on first use
+	if store.used != nil {
+		store.used[dirID] += size
+	}
+}
+
+// moveToTrash moves size from the cached space used by the pieces of the
+// directory to its trash.
+func (store *Store) moveToTrash(dirID string, satellite storj.NodeID, size int64) {
+	store.usedMu.Lock()
+	defer store.usedMu.Unlock()
+
+	if store.used != nil {
+		store.used[dirID] -= size
+		store.trash[dirID][satellite] += size
+	}
+}
+
+// reloadTrash reloads the cached space used by the trash of the directory.
+func (store *Store) reloadTrash(ctx context.Context, dir *Dir) error {
+	store.usedMu.Lock()
+	defer store.usedMu.Unlock()
+
+	if store.used == nil {
+		return nil
+	}
+
+	dirTrash, err := trashUsed(ctx, dir.Blobs)
+	if err != nil {
+		return err
+	}
+	store.trash[dir.ID] = dirTrash
+	return nil
+}
+
+// trashUsed returns the space used by the trashed pieces of each satellite.
+func trashUsed(ctx context.Context, blobs storage.Blobs) (map[storj.NodeID]int64, error) {
+	namespaces, err := blobs.TrashUsed(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	used := make(map[storj.NodeID]int64, len(namespaces))
+	for namespace, size := range namespaces {
+		satellite, err := storj.NodeIDFromBytes([]byte(namespace))
+		if err != nil {
+			continue
+		}
+		used[satellite] += size
+	}
+	return used, nil
+}
+
+// locate returns the directories where the piece may be stored.
+func (store *Store) locate(ctx context.Context, satellite storj.NodeID, pieceID storj.PieceID) []Dir {
+	if store.pieceinfo != nil {
+		info, err := store.pieceinfo.Get(ctx, satellite, pieceID)
+		if err == nil {
+			if dir := store.dir(info.StorageDir); dir != nil {
+				return []Dir{*dir}
+			}
+		}
+	}
+	return store.dirs
+}
+
+// dir returns the directory with the specified ID.
+func (store *Store) dir(id string) *Dir {
+	for i := range store.dirs {
+		if store.dirs[i].ID == id {
+			return &store.dirs[i]
+		}
+	}
+	return nil
 }
 
 // StorageStatus contains information about the disk store is using.
@@ -118,11 +489,26 @@ type StorageStatus struct {
 	DiskFree int64
 }
 
-// StorageStatus returns information about the disk.
+// StorageStatus returns information about the disk. The free space of a
+// filesystem is counted once, even when it holds several directories.
 func (store *Store) StorageStatus() (StorageStatus, error) {
-	diskFree, err := store.blobs.FreeSpace()
-	if err != nil {
-		return StorageStatus{}, err
+	var diskFree int64
+	filesystems := make(map[string]bool)
+	for _, dir := range store.dirs {
+		filesystem, err := dir.Blobs.FilesystemID()
+		if err != nil {
+			return StorageStatus{}, err
+		}
+		if filesystems[filesystem] {
+			continue
+		}
+		filesystems[filesystem] = true
+
+		free, err := dir.Blobs.FreeSpace()
+		if err != nil {
+			return StorageStatus{}, err
+		}
+		diskFree += free
 	}
 	return StorageStatus{
 		DiskUsed: -1, // TODO set value
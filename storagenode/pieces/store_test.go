@@ -5,20 +5,30 @@ package pieces_test
 
 import (
 	"bytes"
+	"context"
 	"io"
 	"math/rand"
 	"testing"
+	"time"
 
+	"github.com/golang/protobuf/ptypes"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/zeebo/errs"
 	"go.uber.org/zap/zaptest"
 
+	"storj.io/storj/internal/memory"
 	"storj.io/storj/internal/testcontext"
 	"storj.io/storj/internal/testidentity"
+	"storj.io/storj/pkg/auth/signing"
+	"storj.io/storj/pkg/pb"
 	"storj.io/storj/pkg/pkcrypto"
 	"storj.io/storj/pkg/storj"
+	"storj.io/storj/storage"
 	"storj.io/storj/storage/filestore"
+	"storj.io/storj/storagenode"
 	"storj.io/storj/storagenode/pieces"
+	"storj.io/storj/storagenode/storagenodedb/storagenodedbtest"
 )
 
 func TestPieces(t *testing.T) {
@@ -54,7 +64,7 @@ func TestPieces(t *testing.T) {
 		assert.Equal(t, hash.Sum(nil), writer.Hash())
 
 		// commit
-		require.NoError(t, writer.Commit())
+		require.NoError(t, writer.Commit(&pb.PieceHeader{}))
 		// after commit we should be able to call cancel without an error
 		require.NoError(t, writer.Cancel())
 	}
@@ -103,10 +113,371 @@ func TestPieces(t *testing.T) {
 		// cancel writing
 		require.NoError(t, writer.Cancel())
 		// commit should not fail
-		require.Error(t, writer.Commit())
+		require.Error(t, writer.Commit(&pb.PieceHeader{}))
 
 		// read should fail
 		_, err = store.Reader(ctx, satelliteID, cancelledPieceID)
 		assert.Error(t, err)
 	}
 }
+
+func TestMultipleStorageDirs(t *testing.T) {
+	storagenodedbtest.Run(t, func(t *testing.T, db storagenode.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		newBlobs := func(name string) *filestore.Store {
+			dir, err := filestore.NewDir(ctx.Dir(name))
+			require.NoError(t, err)
+			return filestore.New(dir)
+		}
+		first, second := newBlobs("first"), newBlobs("second")
+		defer ctx.Check(first.Close)
+		defer ctx.Check(second.Close)
+
+		satellite := testidentity.MustPregeneratedSignedIdentity(0, storj.LatestIDVersion())
+		uplink := testidentity.MustPregeneratedSignedIdentity(3, storj.LatestIDVersion())
+		pieceID := storj.NewPieceID()
+
+		source := make([]byte, 8000)
+		_, _ = rand.Read(source[:])
+
+		read := func(store *pieces.Store) []byte {
+			reader, err := store.Reader(ctx, satellite.ID, pieceID)
+			require.NoError(t, err)
+			defer ctx.Check(reader.Close)
+
+			data := make([]byte, reader.Size())
+			_, err = io.ReadFull(reader, data)
+			require.NoError(t, err)
+			return data
+		}
+
+		{ // the first directory is full, hence new pieces are written to the second
+			store := pieces.NewMultiDirStore(zaptest.NewLogger(t), db.PieceInfo(), []pieces.Dir{
+				{Blobs: first, Allocated: 1},
+				{ID: "second", Blobs: second},
+			})
+
+			writer, err := store.Writer(ctx, satellite.ID, pieceID)
+			require.NoError(t, err)
+			_, err = io.Copy(writer, bytes.NewReader(source))
+			require.NoError(t, err)
+
+			pieceHash, err := signing.SignPieceHash(signing.SignerFromFullIdentity(uplink), &pb.PieceHash{
+				PieceId: pieceID,
+				Hash:    writer.Hash(),
+			})
+			require.NoError(t, err)
+
+			require.NoError(t, writer.Commit(&pb.PieceHeader{UplinkPieceHash: pieceHash}))
+			require.Equal(t, "second", writer.StorageDir())
+
+			err = db.PieceInfo().Add(ctx, &pieces.Info{
+				SatelliteID:     satellite.ID,
+				PieceID:         pieceID,
+				PieceSize:       writer.StoredSize(),
+				PieceCreation:   time.Now(),
+				UplinkPieceHash: pieceHash,
+				Uplink:          uplink.PeerIdentity(),
+				StorageDir:      writer.StorageDir(),
+			})
+			require.NoError(t, err)
+
+			used, err := db.PieceInfo().SpaceUsedByStorageDir(ctx)
+			require.NoError(t, err)
+			require.Equal(t, map[string]int64{"second": writer.StoredSize()}, used)
+
+			require.Equal(t, source, read(store))
+		}
+
+		{ // draining the second directory moves the pieces into the first
+			store := pieces.NewMultiDirStore(zaptest.NewLogger(t), db.PieceInfo(), []pieces.Dir{
+				{Blobs: first},
+				{ID: "second", Blobs: second},
+			})
+
+			moved, err := store.Drain(ctx, "second")
+			require.NoError(t, err)
+			require.EqualValues(t, 1, moved)
+
+			info, err := db.PieceInfo().Get(ctx, satellite.ID, pieceID)
+			require.NoError(t, err)
+			require.Equal(t, "", info.StorageDir)
+
+			_, err = second.Open(ctx, storage.BlobRef{Namespace: satellite.ID.Bytes(), Key: pieceID.Bytes()})
+			require.Error(t, err)
+
+			require.Equal(t, source, read(store))
+
+			require.NoError(t, store.Delete(ctx, satellite.ID, pieceID))
+			_, err = store.Reader(ctx, satellite.ID, pieceID)
+			require.Error(t, err)
+		}
+	})
+}
+
+func TestStorageDirUsage(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	newBlobs := func(name string) *filestore.Store {
+		dir, err := filestore.NewDir(ctx.Dir(name))
+		require.NoError(t, err)
+		return filestore.New(dir)
+	}
+	first, second := newBlobs("first"), newBlobs("second")
+	defer ctx.Check(first.Close)
+	defer ctx.Check(second.Close)
+
+	store := pieces.NewMultiDirStore(zaptest.NewLogger(t), nil, []pieces.Dir{
+		{Blobs: first, Allocated: 10 * memory.MiB.Int64()},
+		{ID: "second", Blobs: second, Allocated: 9 * memory.MiB.Int64()},
+	})
+
+	satellite := testidentity.MustPregeneratedSignedIdentity(0, storj.LatestIDVersion())
+	write := func(pieceID storj.PieceID) string {
+		writer, err := store.Writer(ctx, satellite.ID, pieceID)
+		require.NoError(t, err)
+		_, err = writer.Write(make([]byte, 2*memory.MiB.Int()))
+		require.NoError(t, err)
+		require.NoError(t, writer.Commit(&pb.PieceHeader{}))
+		return writer.StorageDir()
+	}
+
+	// the written pieces are accounted without the piece info database
+	first1, second1 := storj.NewPieceID(), storj.NewPieceID()
+	require.Equal(t, "", write(first1))
+	require.Equal(t, "second", write(second1))
+	require.Equal(t, "", write(storj.NewPieceID()))
+
+	// deleted pieces free up space, trashed pieces only when the trash is emptied
+	require.NoError(t, store.Delete(ctx, satellite.ID, second1))
+	require.NoError(t, store.Trash(ctx, satellite.ID, first1))
+	require.Equal(t, "second", write(storj.NewPieceID()))
+	require.Equal(t, "second", write(storj.NewPieceID()))
+
+	require.NoError(t, store.EmptyTrash(ctx, time.Now().Add(48*time.Hour)))
+	require.Equal(t, "", write(storj.NewPieceID()))
+
+	// both directories are on the same filesystem, its free space is counted once
+	free, err := first.FreeSpace()
+	require.NoError(t, err)
+	status, err := store.StorageStatus()
+	require.NoError(t, err)
+	require.InDelta(t, free, status.DiskFree, float64(free)/2)
+}
+
+func TestRecoverPieceInfo(t *testing.T) {
+	storagenodedbtest.Run(t, func(t *testing.T, db storagenode.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		dir, err := filestore.NewDir(ctx.Dir("pieces"))
+		require.NoError(t, err)
+		blobs := filestore.New(dir)
+		defer ctx.Check(blobs.Close)
+
+		store := pieces.NewMultiDirStore(zaptest.NewLogger(t), db.PieceInfo(), []pieces.Dir{{Blobs: blobs}})
+
+		satellite := testidentity.MustPregeneratedSignedIdentity(0, storj.LatestIDVersion())
+		uplink := testidentity.MustPregeneratedSignedIdentity(3, storj.LatestIDVersion())
+		pieceID := storj.NewPieceID()
+
+		source := make([]byte, 8000)
+		_, _ = rand.Read(source[:])
+
+		creation := time.Now().Add(-time.Hour).UTC().Truncate(time.Second)
+		expiration := creation.Add(24 * time.Hour)
+		creationTime, err := ptypes.TimestampProto(creation)
+		require.NoError(t, err)
+		expirationTime, err := ptypes.TimestampProto(expiration)
+		require.NoError(t, err)
+
+		{ // write a piece with a header
+			writer, err := store.Writer(ctx, satellite.ID, pieceID)
+			require.NoError(t, err)
+			_, err = io.Copy(writer, bytes.NewReader(source))
+			require.NoError(t, err)
+
+			pieceHash, err := signing.SignPieceHash(signing.SignerFromFullIdentity(uplink), &pb.PieceHash{
+				PieceId: pieceID,
+				Hash:    writer.Hash(),
+			})
+			require.NoError(t, err)
+
+			limit, err := signing.SignOrderLimit(signing.SignerFromFullIdentity(satellite), &pb.OrderLimit2{
+				SatelliteId: satellite.ID,
+				UplinkId:    uplink.ID,
+				PieceId:     pieceID,
+			})
+			require.NoError(t, err)
+
+			require.NoError(t, writer.Commit(&pb.PieceHeader{
+				OrderLimit:             limit,
+				UplinkPieceHash:        pieceHash,
+				UplinkCertificateChain: uplink.PeerIdentity().RawChain(),
+				CreationTime:           creationTime,
+				PieceExpiration:        expirationTime,
+			}))
+		}
+
+		{ // the header is not part of the piece data
+			reader, err := store.Reader(ctx, satellite.ID, pieceID)
+			require.NoError(t, err)
+			require.Equal(t, pb.PieceHeader_FORMAT_V1, reader.Header().FormatVersion)
+			require.Equal(t, int64(len(source)), reader.Size())
+
+			data := make([]byte, 100)
+			_, err = reader.ReadAt(data, 1000)
+			require.NoError(t, err)
+			require.Equal(t, source[1000:1100], data)
+
+			_, err = reader.Seek(2000, io.SeekStart)
+			require.NoError(t, err)
+			_, err = io.ReadFull(reader, data)
+			require.NoError(t, err)
+			require.Equal(t, source[2000:2100], data)
+
+			require.NoError(t, reader.Close())
+		}
+
+		{ // a piece without a header cannot be recovered
+			writer, err := blobs.Create(ctx, storage.BlobRef{
+				Namespace: satellite.ID.Bytes(),
+				Key:       storj.NewPieceID().Bytes(),
+			}, -1)
+			require.NoError(t, err)
+			_, err = writer.Write(source)
+			require.NoError(t, err)
+			require.NoError(t, writer.Commit())
+		}
+
+		{ // a piece with an order limit not signed by the satellite cannot be recovered
+			otherID := storj.NewPieceID()
+			writer, err := store.Writer(ctx, satellite.ID, otherID)
+			require.NoError(t, err)
+			_, err = io.Copy(writer, bytes.NewReader(source))
+			require.NoError(t, err)
+
+			pieceHash, err := signing.SignPieceHash(signing.SignerFromFullIdentity(uplink), &pb.PieceHash{
+				PieceId: otherID,
+				Hash:    writer.Hash(),
+			})
+			require.NoError(t, err)
+
+			limit, err := signing.SignOrderLimit(signing.SignerFromFullIdentity(uplink), &pb.OrderLimit2{
+				SatelliteId: satellite.ID,
+				UplinkId:    uplink.ID,
+				PieceId:     otherID,
+			})
+			require.NoError(t, err)
+
+			require.NoError(t, writer.Commit(&pb.PieceHeader{
+				OrderLimit:             limit,
+				UplinkPieceHash:        pieceHash,
+				UplinkCertificateChain: uplink.PeerIdentity().RawChain(),
+				CreationTime:           creationTime,
+			}))
+		}
+
+		// the pieces of untrusted satellites cannot be recovered
+		stats, err := store.RecoverPieceInfo(ctx, signees{})
+		require.NoError(t, err)
+		require.Equal(t, pieces.RecoverStats{Unrecoverable: 3}, stats)
+
+		trusted := signees{satellite.ID: signing.SigneeFromPeerIdentity(satellite.PeerIdentity())}
+		stats, err = store.RecoverPieceInfo(ctx, trusted)
+		require.NoError(t, err)
+		require.Equal(t, pieces.RecoverStats{Recovered: 1, Unrecoverable: 2}, stats)
+
+		info, err := db.PieceInfo().Get(ctx, satellite.ID, pieceID)
+		require.NoError(t, err)
+		// the recorded size includes the header
+		require.Equal(t, int64(len(source))+4096, info.PieceSize)
+		require.True(t, creation.Equal(info.PieceCreation))
+		require.NotNil(t, info.PieceExpiration)
+		require.True(t, expiration.Equal(*info.PieceExpiration))
+		require.Equal(t, uplink.ID, info.Uplink.ID)
+		require.NoError(t, signing.VerifyPieceHashSignature(signing.SigneeFromPeerIdentity(info.Uplink), info.UplinkPieceHash))
+
+		stats, err = store.RecoverPieceInfo(ctx, trusted)
+		require.NoError(t, err)
+		require.Equal(t, pieces.RecoverStats{Existing: 1, Unrecoverable: 2}, stats)
+	})
+}
+
+// signees implements pieces.Signees for the satellites in the map.
+type signees map[storj.NodeID]signing.Signee
+
+func (signees signees) GetSignee(ctx context.Context, id storj.NodeID) (signing.Signee, error) {
+	if signee, ok := signees[id]; ok {
+		return signee, nil
+	}
+	return nil, errs.New("signee %s is untrusted", id)
+}
+
+func TestCheckPieceInfo(t *testing.T) {
+	storagenodedbtest.Run(t, func(t *testing.T, db storagenode.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		dir, err := filestore.NewDir(ctx.Dir("pieces"))
+		require.NoError(t, err)
+		blobs := filestore.New(dir)
+		defer ctx.Check(blobs.Close)
+
+		store := pieces.NewMultiDirStore(zaptest.NewLogger(t), db.PieceInfo(), []pieces.Dir{{Blobs: blobs}})
+
+		satellite := testidentity.MustPregeneratedSignedIdentity(0, storj.LatestIDVersion())
+		uplink := testidentity.MustPregeneratedSignedIdentity(3, storj.LatestIDVersion())
+
+		writePiece := func(pieceID storj.PieceID) {
+			writer, err := blobs.Create(ctx, storage.BlobRef{
+				Namespace: satellite.ID.Bytes(),
+				Key:       pieceID.Bytes(),
+			}, -1)
+			require.NoError(t, err)
+			_, err = writer.Write([]byte{1, 2, 3})
+			require.NoError(t, err)
+			require.NoError(t, writer.Commit())
+		}
+
+		addInfo := func(pieceID storj.PieceID) {
+			pieceHash, err := signing.SignPieceHash(signing.SignerFromFullIdentity(uplink), &pb.PieceHash{
+				PieceId: pieceID,
+				Hash:    []byte{1, 2, 3},
+			})
+			require.NoError(t, err)
+			require.NoError(t, db.PieceInfo().Add(ctx, &pieces.Info{
+				SatelliteID:     satellite.ID,
+				PieceID:         pieceID,
+				PieceSize:       3,
+				PieceCreation:   time.Now(),
+				UplinkPieceHash: pieceHash,
+				Uplink:          uplink.PeerIdentity(),
+			}))
+		}
+
+		stored, missing, unknown := storj.NewPieceID(), storj.NewPieceID(), storj.NewPieceID()
+		writePiece(stored)
+		addInfo(stored)
+		addInfo(missing)
+		writePiece(unknown)
+
+		stats, err := store.CheckPieceInfo(ctx, false)
+		require.NoError(t, err)
+		require.Equal(t, pieces.CheckStats{Checked: 2, Missing: 1, Unknown: 1}, stats)
+
+		stats, err = store.CheckPieceInfo(ctx, true)
+		require.NoError(t, err)
+		require.Equal(t, pieces.CheckStats{Checked: 2, Missing: 1, Removed: 1, Unknown: 1}, stats)
+
+		_, err = db.PieceInfo().Get(ctx, satellite.ID, missing)
+		require.Error(t, err)
+
+		stats, err = store.CheckPieceInfo(ctx, true)
+		require.NoError(t, err)
+		require.Equal(t, pieces.CheckStats{Checked: 1, Unknown: 1}, stats)
+	})
+}
@@ -0,0 +1,111 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package pieces
+
+import (
+	"context"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/storj/pkg/auth/signing"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/storage"
+)
+
+// Trash moves the specified piece into the trash, it can be restored
+// with RestoreTrash until the trash is emptied. The trashed piece keeps
+// using space until then.
+func (store *Store) Trash(ctx context.Context, satellite storj.NodeID, pieceID storj.PieceID) error {
+	ref := storage.BlobRef{
+		Namespace: satellite.Bytes(),
+		Key:       pieceID.Bytes(),
+	}
+
+	// the piece info may already be deleted, hence the piece is trashed in all directories
+	var group errs.Group
+	for i := range store.dirs {
+		dir := &store.dirs[i]
+		size, sizeErr := blobSize(ctx, dir.Blobs, ref)
+		err := dir.Blobs.Trash(ctx, ref)
+		if err == nil && sizeErr == nil {
+			store.moveToTrash(dir.ID, satellite, size)
+		}
+		group.Add(err)
+	}
+	return Error.Wrap(group.Err())
+}
+
+// RestoreTrash moves the trashed pieces of the satellite back and recreates
+// their piece info from the verified piece headers. It returns the number of
+// restored pieces.
+func (store *Store) RestoreTrash(ctx context.Context, satelliteSignee signing.Signee) (restored int64, _ error) {
+	satellite := satelliteSignee.ID()
+
+	if store.pieceinfo == nil {
+		return 0, Error.New("piece info database required for restoring trash")
+	}
+
+	for i := range store.dirs {
+		dir := &store.dirs[i]
+
+		keys, err := dir.Blobs.RestoreTrash(ctx, satellite.Bytes())
+		// the restored pieces and the removed trashed copies of the recreated
+		// pieces are no longer part of the trash
+		if reloadErr := store.reloadTrash(ctx, dir); reloadErr != nil {
+			store.log.Warn("unable to calculate trash usage", zap.String("dir", dir.ID), zap.Error(reloadErr))
+		}
+		if err != nil {
+			return restored, Error.Wrap(err)
+		}
+
+		for _, key := range keys {
+			pieceID, err := storj.PieceIDFromBytes(key)
+			if err != nil {
+				store.log.Warn("invalid piece id in trash", zap.String("dir", dir.ID), zap.Error(err))
+				continue
+			}
+			restored++
+
+			if size, err := blobSize(ctx, dir.Blobs, storage.BlobRef{Namespace: satellite.Bytes(), Key: key}); err == nil {
+				store.addUsed(dir.ID, size)
+			}
+
+			if _, err := store.pieceinfo.Get(ctx, satellite, pieceID); err == nil {
+				continue
+			}
+
+			info, err := store.recoverInfo(ctx, dir, satelliteSignee, pieceID)
+			if err != nil {
+				if !ErrUnrecoverable.Has(err) {
+					return restored, Error.Wrap(err)
+				}
+				// the piece stays in the blob store until garbage collection removes it
+				store.log.Warn("unable to restore piece info",
+					zap.String("dir", dir.ID),
+					zap.Stringer("Satellite ID", satellite),
+					zap.Stringer("Piece ID", pieceID),
+					zap.Error(err))
+				continue
+			}
+
+			if err := store.pieceinfo.Add(ctx, info); err != nil {
+				return restored, Error.Wrap(err)
+			}
+		}
+	}
+	return restored, nil
+}
+
+// EmptyTrash permanently deletes the pieces trashed before trashedBefore.
+func (store *Store) EmptyTrash(ctx context.Context, trashedBefore time.Time) error {
+	var group errs.Group
+	for i := range store.dirs {
+		dir := &store.dirs[i]
+		group.Add(dir.Blobs.EmptyTrash(ctx, trashedBefore))
+		group.Add(store.reloadTrash(ctx, dir))
+	}
+	return Error.Wrap(group.Err())
+}
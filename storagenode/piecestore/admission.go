@@ -0,0 +1,70 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package piecestore
+
+import (
+	"sync"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"storj.io/storj/pkg/pb"
+)
+
+// transferLimiter limits the number of concurrent transfers.
+//
+// Priority transfers may use the reserved slots when all the regular slots are in use.
+type transferLimiter struct {
+	limit    int
+	reserved int
+
+	mu       sync.Mutex
+	active   int
+	priority int
+}
+
+// newTransferLimiter creates a limiter allowing limit concurrent transfers and
+// additional reserved priority transfers, limit <= 0 disables the limit.
+func newTransferLimiter(limit, reserved int) *transferLimiter {
+	return &transferLimiter{
+		limit:    limit,
+		reserved: reserved,
+	}
+}
+
+// acquire claims a slot for a transfer, it returns false when no slot is available.
+// The returned func must be called when the transfer finishes.
+func (limiter *transferLimiter) acquire(priority bool) (release func(), ok bool) {
+	limiter.mu.Lock()
+	defer limiter.mu.Unlock()
+
+	switch {
+	case limiter.limit <= 0 || limiter.active < limiter.limit:
+		limiter.active++
+		return func() {
+			limiter.mu.Lock()
+			limiter.active--
+			limiter.mu.Unlock()
+		}, true
+	case priority && limiter.priority < limiter.reserved:
+		limiter.priority++
+		return func() {
+			limiter.mu.Lock()
+			limiter.priority--
+			limiter.mu.Unlock()
+		}, true
+	}
+	return nil, false
+}
+
+// isPriority returns whether the action may use the reserved transfer slots.
+func isPriority(action pb.PieceAction) bool {
+	return action == pb.PieceAction_GET_AUDIT || action == pb.PieceAction_GET_REPAIR
+}
+
+// errOverloaded returns the status for rejecting a transfer due to too many
+// concurrent transfers, clients can retry the transfer with a different node.
+func errOverloaded(format string, args ...interface{}) error {
+	return status.Error(codes.ResourceExhausted, Error.New(format, args...).Error())
+}
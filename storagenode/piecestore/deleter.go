@@ -0,0 +1,103 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package piecestore
+
+import (
+	"context"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/storagenode/pieces"
+)
+
+// deleteRequest is a piece queued for deletion.
+type deleteRequest struct {
+	satelliteID storj.NodeID
+	pieceID     storj.PieceID
+}
+
+// Deleter deletes the pieces queued by DeletePieces requests in the background.
+//
+// The queue is bounded, the pieces which don't fit into it are left for the
+// garbage collection to delete.
+type Deleter struct {
+	log       *zap.Logger
+	store     *pieces.Store
+	pieceinfo pieces.DB
+	workers   int
+
+	queue chan deleteRequest
+}
+
+// NewDeleter creates a new deleter with a queue of queueSize pieces.
+func NewDeleter(log *zap.Logger, store *pieces.Store, pieceinfo pieces.DB, queueSize, workers int) *Deleter {
+	if queueSize < 1 {
+		queueSize = 1
+	}
+	if workers < 1 {
+		workers = 1
+	}
+	return &Deleter{
+		log:       log,
+		store:     store,
+		pieceinfo: pieceinfo,
+		workers:   workers,
+		queue:     make(chan deleteRequest, queueSize),
+	}
+}
+
+// Enqueue queues the pieces of the satellite for deletion without blocking,
+// it returns the number of pieces which fit into the queue.
+func (deleter *Deleter) Enqueue(ctx context.Context, satelliteID storj.NodeID, pieceIDs []storj.PieceID) (queued int) {
+	defer mon.Task()(&ctx)(nil)
+
+	for _, pieceID := range pieceIDs {
+		select {
+		case deleter.queue <- deleteRequest{satelliteID: satelliteID, pieceID: pieceID}:
+			queued++
+		default:
+			mon.Meter("delete_queue_full").Mark(len(pieceIDs) - queued)
+			return queued
+		}
+	}
+	return queued
+}
+
+// Run deletes the queued pieces until the context is canceled.
+func (deleter *Deleter) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var group errgroup.Group
+	for i := 0; i < deleter.workers; i++ {
+		group.Go(func() error {
+			for {
+				select {
+				case <-ctx.Done():
+					return ctx.Err()
+				case req := <-deleter.queue:
+					deleter.delete(ctx, req)
+				}
+			}
+		})
+	}
+	return group.Wait()
+}
+
+// delete deletes a single piece, the errors are only logged.
+func (deleter *Deleter) delete(ctx context.Context, req deleteRequest) {
+	pieceInfoErr := deleter.pieceinfo.Delete(ctx, req.satelliteID, req.pieceID)
+	pieceErr := deleter.store.Trash(ctx, req.satelliteID, req.pieceID)
+
+	if err := errs.Combine(pieceInfoErr, pieceErr); err != nil {
+		deleter.log.Error("delete failed",
+			zap.Stringer("Satellite ID", req.satelliteID),
+			zap.Stringer("Piece ID", req.pieceID),
+			zap.Error(err))
+		return
+	}
+	deleter.log.Debug("deleted", zap.Stringer("Satellite ID", req.satelliteID), zap.Stringer("Piece ID", req.pieceID))
+}
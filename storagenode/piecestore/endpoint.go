@@ -6,19 +6,24 @@ package piecestore
 import (
 	"context"
 	"io"
+	"strings"
 	"time"
 
 	"github.com/golang/protobuf/ptypes"
 	"github.com/zeebo/errs"
 	"go.uber.org/zap"
 	"golang.org/x/sync/errgroup"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	monkit "gopkg.in/spacemonkeygo/monkit.v2"
 
 	"storj.io/storj/internal/memory"
 	"storj.io/storj/internal/sync2"
 	"storj.io/storj/pkg/auth/signing"
+	"storj.io/storj/pkg/bloomfilter"
 	"storj.io/storj/pkg/identity"
 	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
 	"storj.io/storj/storagenode/bandwidth"
 	"storj.io/storj/storagenode/monitor"
 	"storj.io/storj/storagenode/orders"
@@ -40,18 +45,140 @@ var _ pb.PiecestoreServer = (*Endpoint)(nil)
 
 // OldConfig contains everything necessary for a server
 type OldConfig struct {
-	Path string `help:"path to store data in" default:"$CONFDIR/storage"`
+	Path      string `help:"path to store data in" default:"$CONFDIR/storage"`
+	ExtraDirs string `user:"true" help:"a comma-separated list of additional directories to store data in, each with its allocated disk space, e.g. /mnt/disk2=2TB" default:""`
 
 	WhitelistedSatelliteIDs string        `help:"a comma-separated list of approved satellite node ids" devDefault:"" releaseDefault:"12EayRS2V1kEsWESU9QMRseFhdxYxKicsiFmxrsLZHeLUtdps3S,118UWpMCHzs6CvSgWd9BfFVjw5K9pZbJjkfZJexMtSkmKxvvAW,121RTSDpyNZVcEU84Ticf2L1ntiuUimbWgfATz21tuvgk3vzoA6,12L9ZFwhzVpuEKMUNUqkaTLGzwY9G24tbiigLiXpmZWKwmcNDDs"`
 	SatelliteIDRestriction  bool          `help:"if true, only allow data from approved satellites" devDefault:"false" releaseDefault:"true"`
 	AllocatedDiskSpace      memory.Size   `user:"true" help:"total allocated disk space in bytes" default:"1TB"`
 	AllocatedBandwidth      memory.Size   `user:"true" help:"total allocated bandwidth in bytes" default:"500GiB"`
+	SatelliteAllocations    string        `user:"true" help:"a comma-separated list of per satellite allocations reserving and limiting disk space and bandwidth, each as id=disk/bandwidth where either may be empty, e.g. 12EayRS2V1kEsWESU9QMRseFhdxYxKicsiFmxrsLZHeLUtdps3S=500GB/1TB" default:""`
 	KBucketRefreshInterval  time.Duration `help:"how frequently Kademlia bucket should be refreshed with node stats" default:"1h0m0s"`
 }
 
+// ExtraDir is an additional storage directory with its own allocated disk space.
+type ExtraDir struct {
+	Path               string
+	AllocatedDiskSpace memory.Size
+}
+
+// ParseExtraDirs parses the additional storage directories.
+func (config OldConfig) ParseExtraDirs() (dirs []ExtraDir, err error) {
+	if strings.TrimSpace(config.ExtraDirs) == "" {
+		return nil, nil
+	}
+
+	for _, entry := range strings.Split(config.ExtraDirs, ",") {
+		// the allocation is after the last "=", because paths may contain it
+		separator := strings.LastIndex(entry, "=")
+		if separator < 0 {
+			return nil, Error.New("storage directory %q missing allocated disk space", entry)
+		}
+
+		dir := ExtraDir{Path: strings.TrimSpace(entry[:separator])}
+		if dir.Path == "" {
+			return nil, Error.New("storage directory %q missing path", entry)
+		}
+		if err := dir.AllocatedDiskSpace.Set(strings.TrimSpace(entry[separator+1:])); err != nil {
+			return nil, Error.New("storage directory %q has invalid allocated disk space: %v", entry, err)
+		}
+		for _, existing := range dirs {
+			if existing.Path == dir.Path {
+				return nil, Error.New("storage directory %q listed multiple times", dir.Path)
+			}
+		}
+		dirs = append(dirs, dir)
+	}
+	return dirs, nil
+}
+
+// TotalAllocatedDiskSpace returns the allocated disk space of all storage directories.
+func (config OldConfig) TotalAllocatedDiskSpace() (memory.Size, error) {
+	extraDirs, err := config.ParseExtraDirs()
+	if err != nil {
+		return 0, err
+	}
+
+	total := config.AllocatedDiskSpace
+	for _, dir := range extraDirs {
+		total += dir.AllocatedDiskSpace
+	}
+	return total, nil
+}
+
+// ParseSatelliteAllocations parses the per satellite allocations, the sum of
+// the allocations can't exceed the total allocated disk space and bandwidth.
+func (config OldConfig) ParseSatelliteAllocations() (map[storj.NodeID]monitor.Allocation, error) {
+	allocations := map[storj.NodeID]monitor.Allocation{}
+	if strings.TrimSpace(config.SatelliteAllocations) == "" {
+		return allocations, nil
+	}
+
+	var totalDiskSpace, totalBandwidth memory.Size
+	for _, entry := range strings.Split(config.SatelliteAllocations, ",") {
+		separator := strings.Index(entry, "=")
+		if separator < 0 {
+			return nil, Error.New("satellite allocation %q missing allocation", entry)
+		}
+
+		satelliteID, err := storj.NodeIDFromString(strings.TrimSpace(entry[:separator]))
+		if err != nil {
+			return nil, Error.New("satellite allocation %q has invalid satellite id: %v", entry, err)
+		}
+		if _, exists := allocations[satelliteID]; exists {
+			return nil, Error.New("satellite %q listed multiple times", satelliteID)
+		}
+
+		values := strings.Split(entry[separator+1:], "/")
+		if len(values) != 2 {
+			return nil, Error.New("satellite allocation %q should be in the form id=disk/bandwidth", entry)
+		}
+
+		var diskSpace, bandwidth memory.Size
+		if value := strings.TrimSpace(values[0]); value != "" {
+			if err := diskSpace.Set(value); err != nil {
+				return nil, Error.New("satellite allocation %q has invalid disk space: %v", entry, err)
+			}
+		}
+		if value := strings.TrimSpace(values[1]); value != "" {
+			if err := bandwidth.Set(value); err != nil {
+				return nil, Error.New("satellite allocation %q has invalid bandwidth: %v", entry, err)
+			}
+		}
+
+		totalDiskSpace += diskSpace
+		totalBandwidth += bandwidth
+		allocations[satelliteID] = monitor.Allocation{
+			DiskSpace: diskSpace.Int64(),
+			Bandwidth: bandwidth.Int64(),
+		}
+	}
+
+	allocatedDiskSpace, err := config.TotalAllocatedDiskSpace()
+	if err != nil {
+		return nil, err
+	}
+	if totalDiskSpace > allocatedDiskSpace {
+		return nil, Error.New("satellite allocations of %v exceed the allocated disk space %v", totalDiskSpace, allocatedDiskSpace)
+	}
+	if totalBandwidth > config.AllocatedBandwidth {
+		return nil, Error.New("satellite allocations of %v exceed the allocated bandwidth %v", totalBandwidth, config.AllocatedBandwidth)
+	}
+
+	return allocations, nil
+}
+
 // Config defines parameters for piecestore endpoint.
 type Config struct {
 	ExpirationGracePeriod time.Duration `help:"how soon before expiration date should things be considered expired" default:"48h0m0s"`
+	RetainTimeBuffer      time.Duration `help:"allows for small differences in the satellite and storagenode clocks" default:"1h0m0s"`
+
+	MaxConcurrentUploads      int `help:"how many concurrent uploads are allowed, 0 means unlimited" default:"0"`
+	MaxConcurrentDownloads    int `help:"how many concurrent downloads are allowed, 0 means unlimited" default:"0"`
+	ReservedPriorityDownloads int `help:"how many additional concurrent audit and repair downloads are allowed when the download limit is reached" default:"4"`
+
+	DeleteQueueSize int `help:"how many pieces can be queued for deletion by the satellites, the rest are left for garbage collection" default:"10000"`
+	DeleteWorkers   int `help:"how many pieces are deleted concurrently from the deletion queue" default:"1"`
 
 	Monitor monitor.Config
 	Sender  orders.SenderConfig
@@ -71,10 +198,14 @@ type Endpoint struct {
 	orders      orders.DB
 	usage       bandwidth.DB
 	usedSerials UsedSerials
+	deleter     *Deleter
+
+	uploads   *transferLimiter
+	downloads *transferLimiter
 }
 
 // NewEndpoint creates a new piecestore endpoint.
-func NewEndpoint(log *zap.Logger, signer signing.Signer, trust *trust.Pool, monitor *monitor.Service, store *pieces.Store, pieceinfo pieces.DB, orders orders.DB, usage bandwidth.DB, usedSerials UsedSerials, config Config) (*Endpoint, error) {
+func NewEndpoint(log *zap.Logger, signer signing.Signer, trust *trust.Pool, monitor *monitor.Service, store *pieces.Store, pieceinfo pieces.DB, orders orders.DB, usage bandwidth.DB, usedSerials UsedSerials, deleter *Deleter, config Config) (*Endpoint, error) {
 	return &Endpoint{
 		log:    log,
 		config: config,
@@ -88,6 +219,10 @@ func NewEndpoint(log *zap.Logger, signer signing.Signer, trust *trust.Pool, moni
 		orders:      orders,
 		usage:       usage,
 		usedSerials: usedSerials,
+		deleter:     deleter,
+
+		uploads:   newTransferLimiter(config.MaxConcurrentUploads, 0),
+		downloads: newTransferLimiter(config.MaxConcurrentDownloads, config.ReservedPriorityDownloads),
 	}, nil
 }
 
@@ -106,7 +241,7 @@ func (endpoint *Endpoint) Delete(ctx context.Context, delete *pb.PieceDeleteRequ
 
 	// TODO: parallelize this and maybe return early
 	pieceInfoErr := endpoint.pieceinfo.Delete(ctx, delete.Limit.SatelliteId, delete.Limit.PieceId)
-	pieceErr := endpoint.store.Delete(ctx, delete.Limit.SatelliteId, delete.Limit.PieceId)
+	pieceErr := endpoint.store.Trash(ctx, delete.Limit.SatelliteId, delete.Limit.PieceId)
 
 	if err := errs.Combine(pieceInfoErr, pieceErr); err != nil {
 		// explicitly ignoring error because the errors
@@ -121,6 +256,144 @@ func (endpoint *Endpoint) Delete(ctx context.Context, delete *pb.PieceDeleteRequ
 	return &pb.PieceDeleteResponse{}, nil
 }
 
+// DeletePieces queues the pieces of a satellite for deletion, the request is
+// authorized by the signature of the satellite instead of order limits.
+func (endpoint *Endpoint) DeletePieces(ctx context.Context, req *pb.DeletePiecesRequest) (_ *pb.DeletePiecesResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	switch {
+	case req.SatelliteId.IsZero():
+		return nil, status.Error(codes.InvalidArgument, ErrProtocol.New("missing satellite id").Error())
+	case endpoint.signer.ID() != req.StorageNodeId:
+		return nil, status.Error(codes.InvalidArgument, ErrProtocol.New("request intended for other storagenode: %v", req.StorageNodeId).Error())
+	case endpoint.IsExpired(req.Expiration):
+		return nil, status.Error(codes.InvalidArgument, ErrProtocol.New("request expired: %v", req.Expiration).Error())
+	case len(req.SatelliteSignature) == 0:
+		return nil, status.Error(codes.Unauthenticated, ErrProtocol.New("missing satellite signature").Error())
+	}
+
+	if err := endpoint.trust.VerifySatelliteID(ctx, req.SatelliteId); err != nil {
+		return nil, status.Error(codes.PermissionDenied, ErrVerifyUntrusted.Wrap(err).Error())
+	}
+
+	signee, err := endpoint.trust.GetSignee(ctx, req.SatelliteId)
+	if err != nil {
+		if err == context.Canceled {
+			return nil, err
+		}
+		return nil, status.Error(codes.Unavailable, ErrVerifyUntrusted.New("unable to get signee: %v", err).Error())
+	}
+	if err := signing.VerifyDeletePiecesRequestSignature(signee, req); err != nil {
+		return nil, status.Error(codes.Unauthenticated, ErrVerifyUntrusted.New("invalid delete pieces signature: %v", err).Error())
+	}
+
+	queued := endpoint.deleter.Enqueue(ctx, req.SatelliteId, req.PieceIds)
+	endpoint.log.Info("queued pieces for deletion", zap.Stringer("Satellite ID", req.SatelliteId),
+		zap.Int("queued", queued), zap.Int("dropped", len(req.PieceIds)-queued))
+
+	return &pb.DeletePiecesResponse{Queued: int64(queued)}, nil
+}
+
+// Retain keeps only the pieces in the bloom filter of the calling satellite,
+// deleting the pieces which are not in it and were created before the filter.
+func (endpoint *Endpoint) Retain(ctx context.Context, retainReq *pb.RetainRequest) (_ *pb.RetainResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	peer, err := identity.PeerIdentityFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, Error.Wrap(err).Error())
+	}
+
+	if err := endpoint.trust.VerifySatelliteID(ctx, peer.ID); err != nil {
+		return nil, status.Error(codes.PermissionDenied, Error.New("retain called with untrusted ID").Error())
+	}
+
+	filter, err := bloomfilter.NewFromBytes(retainReq.GetFilter())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, Error.Wrap(err).Error())
+	}
+
+	createdBefore, err := ptypes.Timestamp(retainReq.GetCreationDate())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, Error.Wrap(err).Error())
+	}
+	// the clocks of the satellite and the storage node may differ
+	createdBefore = createdBefore.Add(-endpoint.config.RetainTimeBuffer)
+
+	const limit = 1000
+	offset := 0
+	numDeleted := 0
+	for {
+		pieceIDs, err := endpoint.pieceinfo.GetPieceIDs(ctx, peer.ID, createdBefore, limit, offset)
+		if err != nil {
+			return nil, status.Error(codes.Internal, Error.Wrap(err).Error())
+		}
+		if len(pieceIDs) == 0 {
+			break
+		}
+
+		for _, pieceID := range pieceIDs {
+			if filter.Contains(pieceID) {
+				offset++
+				continue
+			}
+
+			if err := endpoint.pieceinfo.Delete(ctx, peer.ID, pieceID); err != nil {
+				endpoint.log.Error("failed to delete piece info", zap.Stringer("Satellite ID", peer.ID), zap.Stringer("Piece ID", pieceID), zap.Error(err))
+				offset++
+				continue
+			}
+			if err := endpoint.store.Trash(ctx, peer.ID, pieceID); err != nil {
+				endpoint.log.Error("failed to delete piece", zap.Stringer("Satellite ID", peer.ID), zap.Stringer("Piece ID", pieceID), zap.Error(err))
+			}
+			numDeleted++
+		}
+	}
+
+	endpoint.log.Info("retained pieces", zap.Stringer("Satellite ID", peer.ID), zap.Int("deleted", numDeleted), zap.Int("kept", offset))
+	return &pb.RetainResponse{}, nil
+}
+
+// RestoreTrash restores the trashed pieces of a satellite, the request is
+// authorized by the signature of the satellite like DeletePieces.
+func (endpoint *Endpoint) RestoreTrash(ctx context.Context, req *pb.RestoreTrashRequest) (_ *pb.RestoreTrashResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	switch {
+	case req.SatelliteId.IsZero():
+		return nil, status.Error(codes.InvalidArgument, ErrProtocol.New("missing satellite id").Error())
+	case endpoint.signer.ID() != req.StorageNodeId:
+		return nil, status.Error(codes.InvalidArgument, ErrProtocol.New("request intended for other storagenode: %v", req.StorageNodeId).Error())
+	case endpoint.IsExpired(req.Expiration):
+		return nil, status.Error(codes.InvalidArgument, ErrProtocol.New("request expired: %v", req.Expiration).Error())
+	case len(req.SatelliteSignature) == 0:
+		return nil, status.Error(codes.Unauthenticated, ErrProtocol.New("missing satellite signature").Error())
+	}
+
+	if err := endpoint.trust.VerifySatelliteID(ctx, req.SatelliteId); err != nil {
+		return nil, status.Error(codes.PermissionDenied, ErrVerifyUntrusted.Wrap(err).Error())
+	}
+
+	signee, err := endpoint.trust.GetSignee(ctx, req.SatelliteId)
+	if err != nil {
+		if err == context.Canceled {
+			return nil, err
+		}
+		return nil, status.Error(codes.Unavailable, ErrVerifyUntrusted.New("unable to get signee: %v", err).Error())
+	}
+	if err := signing.VerifyRestoreTrashRequestSignature(signee, req); err != nil {
+		return nil, status.Error(codes.Unauthenticated, ErrVerifyUntrusted.New("invalid restore trash signature: %v", err).Error())
+	}
+
+	restored, err := endpoint.store.RestoreTrash(ctx, signee)
+	if err != nil {
+		return nil, status.Error(codes.Internal, Error.Wrap(err).Error())
+	}
+
+	endpoint.log.Info("restored trash", zap.Stringer("Satellite ID", req.SatelliteId), zap.Int64("restored", restored))
+	return &pb.RestoreTrashResponse{Restored: restored}, nil
+}
+
 // Upload handles uploading a piece on piece store.
 func (endpoint *Endpoint) Upload(stream pb.Piecestore_UploadServer) (err error) {
 	ctx := stream.Context()
@@ -148,10 +421,19 @@ func (endpoint *Endpoint) Upload(stream pb.Piecestore_UploadServer) (err error)
 		return ErrProtocol.New("expected put or put repair action got %v", limit.Action) // TODO: report grpc status unauthorized or bad request
 	}
 
+	// verify the limit before taking a slot, so that invalid requests
+	// cannot crowd out legitimate ones.
 	if err := endpoint.VerifyOrderLimit(ctx, limit); err != nil {
 		return err // TODO: report grpc status unauthorized or bad request
 	}
 
+	release, ok := endpoint.uploads.acquire(isPriority(limit.Action))
+	if !ok {
+		mon.Meter("upload_rejected_overloaded").Mark(1)
+		return errOverloaded("too many concurrent uploads")
+	}
+	defer release()
+
 	defer func() {
 		if err != nil {
 			endpoint.log.Info("upload failed", zap.Stringer("Piece ID", limit.PieceId), zap.Stringer("Node ID", limit.StorageNodeId), zap.Stringer("Action", limit.Action), zap.Error(err))
@@ -176,12 +458,12 @@ func (endpoint *Endpoint) Upload(stream pb.Piecestore_UploadServer) (err error)
 		}
 	}()
 
-	availableBandwidth, err := endpoint.monitor.AvailableBandwidth(ctx)
+	availableBandwidth, err := endpoint.monitor.AvailableBandwidth(ctx, limit.SatelliteId)
 	if err != nil {
 		return ErrInternal.Wrap(err)
 	}
 
-	availableSpace, err := endpoint.monitor.AvailableSpace(ctx)
+	availableSpace, err := endpoint.monitor.AvailableSpace(ctx, limit.SatelliteId)
 	if err != nil {
 		return ErrInternal.Wrap(err)
 	}
@@ -240,7 +522,19 @@ func (endpoint *Endpoint) Upload(stream pb.Piecestore_UploadServer) (err error)
 				return err // TODO: report grpc status internal server error
 			}
 
-			if err := pieceWriter.Commit(); err != nil {
+			creation := time.Now().UTC()
+			creationTime, err := ptypes.TimestampProto(creation)
+			if err != nil {
+				return ErrInternal.Wrap(err)
+			}
+
+			if err := pieceWriter.Commit(&pb.PieceHeader{
+				OrderLimit:             limit,
+				UplinkPieceHash:        message.Done,
+				UplinkCertificateChain: peer.RawChain(),
+				CreationTime:           creationTime,
+				PieceExpiration:        limit.PieceExpiration,
+			}); err != nil {
 				return ErrInternal.Wrap(err) // TODO: report grpc status internal server error
 			}
 
@@ -260,11 +554,14 @@ func (endpoint *Endpoint) Upload(stream pb.Piecestore_UploadServer) (err error)
 					SatelliteID: limit.SatelliteId,
 
 					PieceID:         limit.PieceId,
-					PieceSize:       pieceWriter.Size(),
+					PieceSize:       pieceWriter.StoredSize(),
+					PieceCreation:   creation,
 					PieceExpiration: expiration,
 
 					UplinkPieceHash: message.Done,
 					Uplink:          peer,
+
+					StorageDir: pieceWriter.StorageDir(),
 				}
 
 				if err := endpoint.pieceinfo.Add(ctx, info); err != nil {
@@ -316,10 +613,19 @@ func (endpoint *Endpoint) Download(stream pb.Piecestore_DownloadServer) (err err
 		return ErrProtocol.New("requested more that order limit allows, limit=%v requested=%v", limit.Limit, chunk.ChunkSize)
 	}
 
+	// verify the limit before taking a slot, so that invalid requests
+	// cannot crowd out legitimate ones.
 	if err := endpoint.VerifyOrderLimit(ctx, limit); err != nil {
 		return Error.Wrap(err) // TODO: report grpc status unauthorized or bad request
 	}
 
+	release, ok := endpoint.downloads.acquire(isPriority(limit.Action))
+	if !ok {
+		mon.Meter("download_rejected_overloaded").Mark(1)
+		return errOverloaded("too many concurrent downloads")
+	}
+	defer release()
+
 	defer func() {
 		if err != nil {
 			endpoint.log.Info("download failed", zap.Stringer("Piece ID", limit.PieceId), zap.Stringer("Action", limit.Action), zap.Error(err))
@@ -350,7 +656,7 @@ func (endpoint *Endpoint) Download(stream pb.Piecestore_DownloadServer) (err err
 		return Error.New("requested more data than available, requesting=%v available=%v", chunk.Offset+chunk.ChunkSize, pieceReader.Size())
 	}
 
-	availableBandwidth, err := endpoint.monitor.AvailableBandwidth(ctx)
+	availableBandwidth, err := endpoint.monitor.AvailableBandwidth(ctx, limit.SatelliteId)
 	if err != nil {
 		return ErrInternal.Wrap(err)
 	}
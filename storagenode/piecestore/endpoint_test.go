@@ -16,12 +16,17 @@ import (
 
 	"storj.io/storj/internal/memory"
 	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/testidentity"
 	"storj.io/storj/internal/testplanet"
 	"storj.io/storj/pkg/auth/signing"
 	"storj.io/storj/pkg/pb"
 	"storj.io/storj/pkg/pkcrypto"
 	"storj.io/storj/pkg/storj"
+	"storj.io/storj/storagenode"
 	"storj.io/storj/storagenode/bandwidth"
+	"storj.io/storj/storagenode/monitor"
+	"storj.io/storj/storagenode/pieces"
+	snpiecestore "storj.io/storj/storagenode/piecestore"
 	"storj.io/storj/uplink/piecestore"
 )
 
@@ -371,6 +376,257 @@ func TestDelete(t *testing.T) {
 	}
 }
 
+func TestConcurrentDownloadLimit(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 1, UplinkCount: 1,
+		Reconfigure: testplanet.Reconfigure{
+			StorageNode: func(index int, config *storagenode.Config) {
+				config.Storage2.MaxConcurrentDownloads = 1
+				config.Storage2.ReservedPriorityDownloads = 1
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		client, err := planet.Uplinks[0].DialPiecestore(ctx, planet.StorageNodes[0])
+		require.NoError(t, err)
+		defer ctx.Check(client.Close)
+
+		signer := signing.SignerFromFullIdentity(planet.Satellites[0].Identity)
+		newLimit := func(action pb.PieceAction, size int64) *pb.OrderLimit2 {
+			var serialNumber storj.SerialNumber
+			_, _ = rand.Read(serialNumber[:])
+
+			orderLimit := GenerateOrderLimit(
+				t,
+				planet.Satellites[0].ID(),
+				planet.Uplinks[0].ID(),
+				planet.StorageNodes[0].ID(),
+				storj.PieceID{1},
+				action,
+				serialNumber,
+				24*time.Hour,
+				24*time.Hour,
+				size,
+			)
+			orderLimit, err := signing.SignOrderLimit(signer, orderLimit)
+			require.NoError(t, err)
+			return orderLimit
+		}
+
+		expectedData := make([]byte, 1*memory.MiB)
+		_, _ = rand.Read(expectedData)
+
+		uploader, err := client.Upload(ctx, newLimit(pb.PieceAction_PUT, int64(len(expectedData))))
+		require.NoError(t, err)
+		_, err = uploader.Write(expectedData)
+		require.NoError(t, err)
+		_, err = uploader.Commit()
+		require.NoError(t, err)
+
+		download := func(action pb.PieceAction) (piecestore.Downloader, error) {
+			downloader, err := client.Download(ctx, newLimit(action, int64(len(expectedData))), 0, int64(len(expectedData)))
+			require.NoError(t, err)
+			// reading the first chunk ensures the storage node has admitted the download
+			_, err = downloader.Read(make([]byte, memory.KiB))
+			return downloader, err
+		}
+
+		// keep the regular download slot busy
+		active, err := download(pb.PieceAction_GET)
+		require.NoError(t, err)
+
+		rejected, err := download(pb.PieceAction_GET)
+		require.Error(t, err)
+		require.True(t, piecestore.ErrOverloaded.Has(err), err.Error())
+		require.Error(t, rejected.Close())
+
+		// audits can use the reserved slot
+		audit, err := download(pb.PieceAction_GET_AUDIT)
+		require.NoError(t, err)
+
+		repair, err := download(pb.PieceAction_GET_REPAIR)
+		require.Error(t, err)
+		require.True(t, piecestore.ErrOverloaded.Has(err), err.Error())
+		require.Error(t, repair.Close())
+
+		require.NoError(t, audit.Close())
+		require.NoError(t, active.Close())
+
+		// the slots are released after the storage node finishes the downloads
+		for attempt := 0; ; attempt++ {
+			downloader, err := client.Download(ctx, newLimit(pb.PieceAction_GET, int64(len(expectedData))), 0, int64(len(expectedData)))
+			require.NoError(t, err)
+			buffer := make([]byte, len(expectedData))
+			_, err = io.ReadFull(downloader, buffer)
+			if piecestore.ErrOverloaded.Has(err) && attempt < 100 {
+				_ = downloader.Close()
+				time.Sleep(10 * time.Millisecond)
+				continue
+			}
+			require.NoError(t, err)
+			require.Equal(t, expectedData, buffer)
+			require.NoError(t, downloader.Close())
+			break
+		}
+	})
+}
+
+func TestRestoreTrash(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+
+		expectedData := make([]byte, 100*memory.KiB)
+		_, err := rand.Read(expectedData)
+		require.NoError(t, err)
+
+		err = planet.Uplinks[0].Upload(ctx, satellite, "testbucket", "test/path", expectedData)
+		require.NoError(t, err)
+
+		usedBefore := map[storj.NodeID]int64{}
+		for _, storageNode := range planet.StorageNodes {
+			used, err := storageNode.DB.PieceInfo().SpaceUsed(ctx)
+			require.NoError(t, err)
+			usedBefore[storageNode.ID()] = used
+		}
+
+		err = planet.Uplinks[0].Delete(ctx, satellite, "testbucket", "test/path")
+		require.NoError(t, err)
+
+		newRequest := func(signer signing.Signer, storageNodeID storj.NodeID, expiration time.Duration) *pb.RestoreTrashRequest {
+			timestamp, err := ptypes.TimestampProto(time.Now().Add(expiration))
+			require.NoError(t, err)
+
+			req, err := signing.SignRestoreTrashRequest(signer, &pb.RestoreTrashRequest{
+				SatelliteId:   satellite.ID(),
+				StorageNodeId: storageNodeID,
+				Expiration:    timestamp,
+			})
+			require.NoError(t, err)
+			return req
+		}
+
+		satelliteSigner := signing.SignerFromFullIdentity(satellite.Identity)
+		uplinkSigner := signing.SignerFromFullIdentity(planet.Uplinks[0].Identity)
+
+		restoredNodes := 0
+		for _, storageNode := range planet.StorageNodes {
+			// nodes cut from the upload as long tail still have their pieces
+			used, err := storageNode.DB.PieceInfo().SpaceUsed(ctx)
+			require.NoError(t, err)
+			deleted := used < usedBefore[storageNode.ID()]
+
+			client, err := planet.Uplinks[0].DialPiecestore(ctx, storageNode)
+			require.NoError(t, err)
+
+			for _, invalid := range []*pb.RestoreTrashRequest{
+				newRequest(uplinkSigner, storageNode.ID(), time.Hour),
+				newRequest(satelliteSigner, planet.Uplinks[0].ID(), time.Hour),
+				newRequest(satelliteSigner, storageNode.ID(), -100*time.Hour),
+			} {
+				_, err := client.RestoreTrash(ctx, invalid)
+				require.Error(t, err)
+			}
+
+			// the request is authorized by the signature, anyone can send it
+			restored, err := client.RestoreTrash(ctx, newRequest(satelliteSigner, storageNode.ID(), time.Hour))
+			require.NoError(t, err)
+			require.NoError(t, client.Close())
+
+			used, err = storageNode.DB.PieceInfo().SpaceUsed(ctx)
+			require.NoError(t, err)
+			require.Equal(t, usedBefore[storageNode.ID()], used)
+			if deleted {
+				require.NotZero(t, restored)
+				restoredNodes++
+			}
+		}
+		require.NotZero(t, restoredNodes)
+	})
+}
+
+func TestDeletePieces(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 1, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		storageNode := planet.StorageNodes[0]
+		uplink := planet.Uplinks[0]
+
+		pieceID := storj.NewPieceID()
+		{ // store a piece on the storage node
+			writer, err := storageNode.Storage2.Store.Writer(ctx, satellite.ID(), pieceID)
+			require.NoError(t, err)
+			_, err = writer.Write([]byte{1, 2, 3})
+			require.NoError(t, err)
+
+			pieceHash, err := signing.SignPieceHash(signing.SignerFromFullIdentity(uplink.Identity), &pb.PieceHash{
+				PieceId: pieceID,
+				Hash:    writer.Hash(),
+			})
+			require.NoError(t, err)
+			require.NoError(t, writer.Commit(&pb.PieceHeader{UplinkPieceHash: pieceHash}))
+
+			require.NoError(t, storageNode.DB.PieceInfo().Add(ctx, &pieces.Info{
+				SatelliteID:     satellite.ID(),
+				PieceID:         pieceID,
+				PieceSize:       writer.StoredSize(),
+				PieceCreation:   time.Now(),
+				UplinkPieceHash: pieceHash,
+				Uplink:          uplink.Identity.PeerIdentity(),
+			}))
+		}
+
+		client, err := uplink.DialPiecestore(ctx, storageNode)
+		require.NoError(t, err)
+		defer ctx.Check(client.Close)
+
+		newRequest := func(signer signing.Signer, storageNodeID storj.NodeID, expiration time.Duration) *pb.DeletePiecesRequest {
+			timestamp, err := ptypes.TimestampProto(time.Now().Add(expiration))
+			require.NoError(t, err)
+
+			req, err := signing.SignDeletePiecesRequest(signer, &pb.DeletePiecesRequest{
+				SatelliteId:   satellite.ID(),
+				StorageNodeId: storageNodeID,
+				PieceIds:      []storj.PieceID{pieceID},
+				Expiration:    timestamp,
+			})
+			require.NoError(t, err)
+			return req
+		}
+
+		satelliteSigner := signing.SignerFromFullIdentity(satellite.Identity)
+		for _, invalid := range []*pb.DeletePiecesRequest{
+			newRequest(signing.SignerFromFullIdentity(uplink.Identity), storageNode.ID(), time.Hour),
+			newRequest(satelliteSigner, uplink.ID(), time.Hour),
+			newRequest(satelliteSigner, storageNode.ID(), -100*time.Hour),
+		} {
+			_, err := client.DeletePieces(ctx, invalid)
+			require.Error(t, err)
+		}
+
+		_, err = storageNode.DB.PieceInfo().Get(ctx, satellite.ID(), pieceID)
+		require.NoError(t, err)
+
+		// the request is authorized by the signature, anyone can send it
+		queued, err := client.DeletePieces(ctx, newRequest(satelliteSigner, storageNode.ID(), time.Hour))
+		require.NoError(t, err)
+		require.Equal(t, int64(1), queued)
+
+		deadline := time.Now().Add(10 * time.Second)
+		for {
+			_, err := storageNode.DB.PieceInfo().Get(ctx, satellite.ID(), pieceID)
+			if err != nil {
+				break
+			}
+			require.True(t, time.Now().Before(deadline), "piece not deleted")
+			time.Sleep(10 * time.Millisecond)
+		}
+		_, err = storageNode.Storage2.Store.Reader(ctx, satellite.ID(), pieceID)
+		require.Error(t, err)
+	})
+}
+
 func GenerateOrderLimit(t *testing.T, satellite storj.NodeID, uplink storj.NodeID, storageNode storj.NodeID, pieceID storj.PieceID,
 	action pb.PieceAction, serialNumber storj.SerialNumber, pieceExpiration, orderExpiration time.Duration, limit int64) *pb.OrderLimit2 {
 
@@ -391,3 +647,64 @@ func GenerateOrderLimit(t *testing.T, satellite storj.NodeID, uplink storj.NodeI
 	}
 	return orderLimit
 }
+
+func TestParseExtraDirs(t *testing.T) {
+	dirs, err := (&snpiecestore.OldConfig{
+		AllocatedDiskSpace: 1 * memory.TB,
+		ExtraDirs:          "/mnt/disk2=2TB, /mnt/a=b=500GB",
+	}).ParseExtraDirs()
+	require.NoError(t, err)
+	require.Equal(t, []snpiecestore.ExtraDir{
+		{Path: "/mnt/disk2", AllocatedDiskSpace: 2 * memory.TB},
+		{Path: "/mnt/a=b", AllocatedDiskSpace: 500 * memory.GB},
+	}, dirs)
+
+	for _, invalid := range []string{
+		"/mnt/disk2",
+		"=2TB",
+		"/mnt/disk2=many",
+		"/mnt/disk2=2TB,/mnt/disk2=1TB",
+	} {
+		_, err := (&snpiecestore.OldConfig{ExtraDirs: invalid}).ParseExtraDirs()
+		require.Error(t, err, invalid)
+	}
+
+	total, err := (&snpiecestore.OldConfig{
+		AllocatedDiskSpace: 1 * memory.TB,
+		ExtraDirs:          "/mnt/disk2=2TB",
+	}).TotalAllocatedDiskSpace()
+	require.NoError(t, err)
+	require.Equal(t, 3*memory.TB, total)
+}
+
+func TestParseSatelliteAllocations(t *testing.T) {
+	satellite0 := testidentity.MustPregeneratedSignedIdentity(0, storj.LatestIDVersion()).ID
+	satellite1 := testidentity.MustPregeneratedSignedIdentity(1, storj.LatestIDVersion()).ID
+
+	config := snpiecestore.OldConfig{
+		AllocatedDiskSpace: 1 * memory.TB,
+		AllocatedBandwidth: 2 * memory.TB,
+	}
+
+	config.SatelliteAllocations = satellite0.String() + "=500GB/1TB, " + satellite1.String() + "=/500GB"
+	allocations, err := config.ParseSatelliteAllocations()
+	require.NoError(t, err)
+	require.Equal(t, map[storj.NodeID]monitor.Allocation{
+		satellite0: {DiskSpace: (500 * memory.GB).Int64(), Bandwidth: memory.TB.Int64()},
+		satellite1: {Bandwidth: (500 * memory.GB).Int64()},
+	}, allocations)
+
+	for _, invalid := range []string{
+		satellite0.String(),
+		satellite0.String() + "=500GB",
+		"abc=500GB/1TB",
+		satellite0.String() + "=many/1TB",
+		satellite0.String() + "=500GB/1TB," + satellite0.String() + "=1GB/1GB",
+		satellite0.String() + "=2TB/",
+		satellite0.String() + "=/1TB," + satellite1.String() + "=/1.5TB",
+	} {
+		config.SatelliteAllocations = invalid
+		_, err := config.ParseSatelliteAllocations()
+		require.Error(t, err, invalid)
+	}
+}
@@ -61,6 +61,11 @@ func (endpoint *Endpoint) VerifyOrderLimit(ctx context.Context, limit *pb.OrderL
 	if err := endpoint.trust.VerifySatelliteID(ctx, limit.SatelliteId); err != nil {
 		return ErrVerifyUntrusted.Wrap(err)
 	}
+	if limit.Action == pb.PieceAction_PUT || limit.Action == pb.PieceAction_PUT_REPAIR {
+		if err := endpoint.trust.VerifySatelliteUpload(ctx, limit.SatelliteId); err != nil {
+			return ErrVerifyUntrusted.Wrap(err)
+		}
+	}
 	if err := endpoint.trust.VerifyUplinkID(ctx, limit.UplinkId); err != nil {
 		return ErrVerifyUntrusted.Wrap(err)
 	}
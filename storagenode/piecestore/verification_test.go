@@ -304,7 +304,7 @@ func setBandwidth(ctx context.Context, t *testing.T, planet *testplanet.Planet,
 		return
 	}
 	for _, storageNode := range planet.StorageNodes {
-		availableBandwidth, err := storageNode.Storage2.Monitor.AvailableBandwidth(ctx)
+		availableBandwidth, err := storageNode.Storage2.Monitor.AvailableBandwidth(ctx, planet.Satellites[0].ID())
 		require.NoError(t, err)
 		diff := (bandwidth - availableBandwidth) * -1
 		err = storageNode.DB.Bandwidth().Add(ctx, planet.Satellites[0].ID(), pb.PieceAction_GET, diff, time.Now())
@@ -317,7 +317,7 @@ func setSpace(ctx context.Context, t *testing.T, planet *testplanet.Planet, spac
 		return
 	}
 	for _, storageNode := range planet.StorageNodes {
-		availableSpace, err := storageNode.Storage2.Monitor.AvailableSpace(ctx)
+		availableSpace, err := storageNode.Storage2.Monitor.AvailableSpace(ctx, planet.Satellites[0].ID())
 		require.NoError(t, err)
 		diff := (space - availableSpace) * -1
 		err = storageNode.DB.PieceInfo().Add(ctx, &pieces.Info{
@@ -0,0 +1,257 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package scrubber implements detecting corrupted pieces on the storage node.
+package scrubber
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+	monkit "gopkg.in/spacemonkeygo/monkit.v2"
+
+	"storj.io/storj/internal/memory"
+	"storj.io/storj/internal/sync2"
+	"storj.io/storj/pkg/pkcrypto"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/storagenode/pieces"
+)
+
+var mon = monkit.Package()
+
+const (
+	batchSize = 1000
+	chunkSize = 256 * memory.KiB
+)
+
+// Config defines parameters for storage node Scrubber.
+type Config struct {
+	Interval time.Duration `help:"how frequently all pieces are verified" default:"168h0m0s"`
+	MaxRate  memory.Size   `help:"maximum bytes per second read while verifying pieces" default:"4.0 MiB"`
+}
+
+// CorruptPiece describes a piece that failed verification.
+type CorruptPiece struct {
+	SatelliteID storj.NodeID
+	PieceID     storj.PieceID
+	// Missing is set when the piece file doesn't exist.
+	Missing    bool
+	DetectedAt time.Time
+}
+
+type pieceKey struct {
+	satelliteID storj.NodeID
+	pieceID     storj.PieceID
+}
+
+// Service implements verifying the hashes of the stored pieces.
+//
+// The corrupt pieces found by the last complete scrub are kept in memory,
+// pieces found during the ongoing scrub are added as they are detected.
+type Service struct {
+	log        *zap.Logger
+	pieces     *pieces.Store
+	pieceinfos pieces.DB
+	config     Config
+
+	Loop sync2.Cycle
+
+	mu           sync.Mutex
+	corrupt      map[pieceKey]CorruptPiece
+	lastScrubbed time.Time
+}
+
+// NewService creates a new scrubber service.
+func NewService(log *zap.Logger, pieces *pieces.Store, pieceinfos pieces.DB, config Config) *Service {
+	return &Service{
+		log:        log,
+		pieces:     pieces,
+		pieceinfos: pieceinfos,
+		config:     config,
+		Loop:       *sync2.NewCycle(config.Interval),
+		corrupt:    map[pieceKey]CorruptPiece{},
+	}
+}
+
+// Run runs the scrubber service.
+func (service *Service) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return service.Loop.Run(ctx, func(ctx context.Context) error {
+		err := service.Scrub(ctx)
+		if err != nil {
+			service.log.Error("error during scrubbing pieces", zap.Error(err))
+		}
+		return nil
+	})
+}
+
+// Close stops the scrubber service.
+func (service *Service) Close() (err error) {
+	service.Loop.Close()
+	return nil
+}
+
+// Scrub verifies the hashes of all stored pieces.
+func (service *Service) Scrub(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var count, size int64
+	found := map[pieceKey]CorruptPiece{}
+	defer func() {
+		service.log.Info("scrub", zap.Int64("count", count), zap.Stringer("size", memory.Size(size)), zap.Int("corrupt", len(found)))
+	}()
+
+	var satelliteID storj.NodeID
+	var pieceID storj.PieceID
+	for {
+		infos, err := service.pieceinfos.GetStoredAfter(ctx, satelliteID, pieceID, batchSize)
+		if err != nil {
+			return err
+		}
+		if len(infos) == 0 {
+			break
+		}
+
+		for _, info := range infos {
+			satelliteID, pieceID = info.SatelliteID, info.PieceID
+
+			corrupt, err := service.verify(ctx, info)
+			if err != nil {
+				return err
+			}
+			count++
+			size += info.PieceSize
+
+			key := pieceKey{info.SatelliteID, info.PieceID}
+
+			service.mu.Lock()
+			if corrupt != nil {
+				// keep the time the piece was first detected
+				if previous, ok := service.corrupt[key]; ok {
+					corrupt.DetectedAt = previous.DetectedAt
+				}
+				service.corrupt[key] = *corrupt
+				found[key] = *corrupt
+			} else {
+				delete(service.corrupt, key)
+			}
+			service.mu.Unlock()
+
+			if corrupt != nil {
+				service.log.Warn("corrupt piece",
+					zap.Stringer("Satellite ID", corrupt.SatelliteID),
+					zap.Stringer("Piece ID", corrupt.PieceID),
+					zap.Bool("missing", corrupt.Missing))
+				mon.Meter("corrupt_pieces").Mark(1)
+			}
+		}
+	}
+
+	service.mu.Lock()
+	service.corrupt = found
+	service.lastScrubbed = time.Now()
+	service.mu.Unlock()
+
+	return nil
+}
+
+// verify checks the piece data against the uplink piece hash,
+// it returns nil when the piece is intact or has been deleted meanwhile.
+func (service *Service) verify(ctx context.Context, info pieces.StoredInfo) (_ *CorruptPiece, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	stored, err := service.pieceinfos.Get(ctx, info.SatelliteID, info.PieceID)
+	if err != nil {
+		// the piece was deleted during the scrub
+		return nil, nil
+	}
+
+	corrupt := &CorruptPiece{
+		SatelliteID: info.SatelliteID,
+		PieceID:     info.PieceID,
+		DetectedAt:  time.Now(),
+	}
+
+	reader, err := service.pieces.Reader(ctx, info.SatelliteID, info.PieceID)
+	if err != nil {
+		if os.IsNotExist(errs.Unwrap(err)) {
+			corrupt.Missing = true
+			return corrupt, nil
+		}
+		return nil, err
+	}
+	defer func() { _ = reader.Close() }()
+
+	if reader.StoredSize() != stored.PieceSize {
+		return corrupt, nil
+	}
+
+	hash := pkcrypto.NewHash()
+	for remaining := reader.Size(); remaining > 0; {
+		n := remaining
+		if n > chunkSize.Int64() {
+			n = chunkSize.Int64()
+		}
+
+		start := time.Now()
+		if _, err := io.CopyN(hash, reader, n); err != nil {
+			return corrupt, nil
+		}
+		remaining -= n
+
+		if !service.throttle(ctx, n, time.Since(start)) {
+			return nil, ctx.Err()
+		}
+	}
+
+	if stored.UplinkPieceHash == nil || !bytes.Equal(hash.Sum(nil), stored.UplinkPieceHash.Hash) {
+		return corrupt, nil
+	}
+	return nil, nil
+}
+
+// throttle sleeps to keep reading n bytes within the configured rate,
+// it returns false when the context was canceled.
+func (service *Service) throttle(ctx context.Context, n int64, elapsed time.Duration) bool {
+	if service.config.MaxRate <= 0 {
+		return true
+	}
+	expected := time.Duration(float64(n) / service.config.MaxRate.Float64() * float64(time.Second))
+	if expected <= elapsed {
+		return ctx.Err() == nil
+	}
+	return sync2.Sleep(ctx, expected-elapsed)
+}
+
+// CorruptPieces returns the known corrupt pieces ordered by satellite and piece id.
+func (service *Service) CorruptPieces() []CorruptPiece {
+	service.mu.Lock()
+	defer service.mu.Unlock()
+
+	corrupt := make([]CorruptPiece, 0, len(service.corrupt))
+	for _, piece := range service.corrupt {
+		corrupt = append(corrupt, piece)
+	}
+	sort.Slice(corrupt, func(i, k int) bool {
+		if corrupt[i].SatelliteID != corrupt[k].SatelliteID {
+			return corrupt[i].SatelliteID.Less(corrupt[k].SatelliteID)
+		}
+		return bytes.Compare(corrupt[i].PieceID[:], corrupt[k].PieceID[:]) < 0
+	})
+	return corrupt
+}
+
+// LastScrubbed returns when the last complete scrub finished.
+func (service *Service) LastScrubbed() time.Time {
+	service.mu.Lock()
+	defer service.mu.Unlock()
+	return service.lastScrubbed
+}
@@ -0,0 +1,104 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package scrubber_test
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/storj/internal/memory"
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/testidentity"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/storage/filestore"
+	"storj.io/storj/storagenode"
+	"storj.io/storj/storagenode/pieces"
+	"storj.io/storj/storagenode/scrubber"
+	"storj.io/storj/storagenode/storagenodedb/storagenodedbtest"
+)
+
+func TestScrub(t *testing.T) {
+	storagenodedbtest.Run(t, func(t *testing.T, db storagenode.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		dir, err := filestore.NewDir(ctx.Dir("pieces"))
+		require.NoError(t, err)
+		blobs := filestore.New(dir)
+		defer ctx.Check(blobs.Close)
+
+		store := pieces.NewStore(zaptest.NewLogger(t), blobs)
+		service := scrubber.NewService(zaptest.NewLogger(t), store, db.PieceInfo(), scrubber.Config{
+			Interval: time.Hour,
+		})
+
+		satellite := testidentity.MustPregeneratedSignedIdentity(0, storj.LatestIDVersion())
+		uplink := testidentity.MustPregeneratedSignedIdentity(3, storj.LatestIDVersion())
+
+		writePiece := func(pieceID storj.PieceID) []byte {
+			data := make([]byte, 10*memory.KiB)
+			_, _ = rand.Read(data)
+
+			writer, err := store.Writer(ctx, satellite.ID, pieceID)
+			require.NoError(t, err)
+			_, err = writer.Write(data)
+			require.NoError(t, err)
+			hash := &pb.PieceHash{PieceId: pieceID, Hash: writer.Hash()}
+			require.NoError(t, writer.Commit(&pb.PieceHeader{UplinkPieceHash: hash}))
+			return hash.Hash
+		}
+
+		addPiece := func(pieceID storj.PieceID) {
+			hash := writePiece(pieceID)
+			err := db.PieceInfo().Add(ctx, &pieces.Info{
+				SatelliteID:     satellite.ID,
+				PieceID:         pieceID,
+				PieceSize:       int64(10*memory.KiB) + 4096, // including the piece header
+				PieceCreation:   time.Now(),
+				UplinkPieceHash: &pb.PieceHash{PieceId: pieceID, Hash: hash},
+				Uplink:          uplink.PeerIdentity(),
+			})
+			require.NoError(t, err)
+		}
+
+		intact, corrupted, missing := storj.NewPieceID(), storj.NewPieceID(), storj.NewPieceID()
+		for _, pieceID := range []storj.PieceID{intact, corrupted, missing} {
+			addPiece(pieceID)
+		}
+
+		require.NoError(t, service.Scrub(ctx))
+		require.Empty(t, service.CorruptPieces())
+		require.False(t, service.LastScrubbed().IsZero())
+
+		// replace the data of a piece without updating its piece info
+		require.NoError(t, store.Delete(ctx, satellite.ID, corrupted))
+		writePiece(corrupted)
+		require.NoError(t, store.Delete(ctx, satellite.ID, missing))
+
+		require.NoError(t, service.Scrub(ctx))
+
+		corrupt := service.CorruptPieces()
+		require.Len(t, corrupt, 2)
+		found := map[storj.PieceID]scrubber.CorruptPiece{}
+		for _, piece := range corrupt {
+			require.Equal(t, satellite.ID, piece.SatelliteID)
+			found[piece.PieceID] = piece
+		}
+		require.Contains(t, found, corrupted)
+		require.False(t, found[corrupted].Missing)
+		require.Contains(t, found, missing)
+		require.True(t, found[missing].Missing)
+
+		// deleted pieces are no longer reported
+		require.NoError(t, db.PieceInfo().Delete(ctx, satellite.ID, corrupted))
+		require.NoError(t, db.PieceInfo().Delete(ctx, satellite.ID, missing))
+		require.NoError(t, service.Scrub(ctx))
+		require.Empty(t, service.CorruptPieces())
+	})
+}
@@ -0,0 +1,292 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package storagenodedb
+
+import (
+	"context"
+	"database/sql"
+	"io"
+	"os"
+	"path/filepath"
+
+	sqlite3 "github.com/mattn/go-sqlite3"
+	"github.com/zeebo/errs"
+	monkit "gopkg.in/spacemonkeygo/monkit.v2"
+)
+
+var mon = monkit.Package()
+
+const (
+	// backupStepPages is the number of pages copied while holding the lock.
+	backupStepPages = 100
+	// backupMaxSteps is the number of steps after which the rest of the backup
+	// is copied at once, so that concurrent writes cannot keep restarting it.
+	backupMaxSteps = 10000
+)
+
+// Backup writes a consistent copy of the info database to path using the
+// SQLite online backup API. The backup is written to a temporary file and
+// moved to path only after it has passed the integrity check.
+func (db *InfoDB) Backup(ctx context.Context, path string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return ErrInfo.Wrap(err)
+	}
+
+	tmpPath := path + ".tmp"
+	if err := removeDatabase(tmpPath); err != nil {
+		return ErrInfo.Wrap(err)
+	}
+	defer func() {
+		if err != nil {
+			err = errs.Combine(err, removeDatabase(tmpPath))
+		}
+	}()
+
+	if err := db.backupTo(ctx, tmpPath); err != nil {
+		return err
+	}
+
+	problems, err := CheckIntegrityOf(ctx, tmpPath)
+	if err != nil {
+		return err
+	}
+	if len(problems) > 0 {
+		return ErrInfo.New("backup failed integrity check: %v", problems)
+	}
+
+	return ErrInfo.Wrap(os.Rename(tmpPath, path))
+}
+
+// backupTo copies the database into a new database at path. The pages are
+// copied in steps and the lock is released between the steps, so that the
+// node is not blocked during the whole backup.
+func (db *InfoDB) backupTo(ctx context.Context, path string) (err error) {
+	stepLocked := db.locked
+	if db.inMemory {
+		// a new connection to an in-memory database opens an empty database,
+		// so the queries must not run while the backup holds the connection
+		defer db.locked()()
+		stepLocked = func() func() { return func() {} }
+	}
+
+	dest, err := sql.Open("sqlite3", "file:"+path)
+	if err != nil {
+		return ErrInfo.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, ErrInfo.Wrap(dest.Close())) }()
+
+	srcConn, err := db.db.Conn(ctx)
+	if err != nil {
+		return ErrInfo.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, ErrInfo.Wrap(srcConn.Close())) }()
+
+	destConn, err := dest.Conn(ctx)
+	if err != nil {
+		return ErrInfo.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, ErrInfo.Wrap(destConn.Close())) }()
+
+	return ErrInfo.Wrap(destConn.Raw(func(destDriverConn interface{}) error {
+		return srcConn.Raw(func(srcDriverConn interface{}) (err error) {
+			destSQLite, ok := destDriverConn.(*sqlite3.SQLiteConn)
+			if !ok {
+				return errs.New("unexpected backup connection %T", destDriverConn)
+			}
+			srcSQLite, ok := srcDriverConn.(*sqlite3.SQLiteConn)
+			if !ok {
+				return errs.New("unexpected database connection %T", srcDriverConn)
+			}
+
+			backup, err := destSQLite.Backup("main", srcSQLite, "main")
+			if err != nil {
+				return err
+			}
+			defer func() { err = errs.Combine(err, backup.Finish()) }()
+
+			for step := 0; ; step++ {
+				if err = ctx.Err(); err != nil {
+					return err
+				}
+
+				pages := backupStepPages
+				if step >= backupMaxSteps {
+					pages = -1
+				}
+
+				// the lock keeps the step from restarting due to concurrent writes
+				unlock := stepLocked()
+				var done bool
+				done, err = backup.Step(pages)
+				unlock()
+
+				if err != nil || done {
+					return err
+				}
+			}
+		})
+	}))
+}
+
+// CheckIntegrity returns the problems found by the SQLite integrity check,
+// the result is empty when the database is intact.
+func (db *InfoDB) CheckIntegrity(ctx context.Context) (_ []string, err error) {
+	defer mon.Task()(&ctx)(&err)
+	defer db.locked()()
+
+	return checkIntegrity(ctx, db.db)
+}
+
+// CheckIntegrityOf returns the problems found by the SQLite integrity check
+// of the database at path, the result is empty when the database is intact.
+func CheckIntegrityOf(ctx context.Context, path string) (_ []string, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if _, err := os.Stat(path); err != nil {
+		return nil, ErrInfo.Wrap(err)
+	}
+
+	db, err := sql.Open("sqlite3", "file:"+path)
+	if err != nil {
+		return nil, ErrInfo.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, ErrInfo.Wrap(db.Close())) }()
+
+	return checkIntegrity(ctx, db)
+}
+
+// checkIntegrity runs the SQLite integrity and foreign key checks.
+func checkIntegrity(ctx context.Context, db *sql.DB) (problems []string, err error) {
+	rows, err := db.QueryContext(ctx, `PRAGMA integrity_check`)
+	if err != nil {
+		return nil, ErrInfo.Wrap(err)
+	}
+	for rows.Next() {
+		var problem string
+		if err := rows.Scan(&problem); err != nil {
+			return nil, ErrInfo.Wrap(errs.Combine(err, rows.Close()))
+		}
+		if problem != "ok" {
+			problems = append(problems, problem)
+		}
+	}
+	if err := errs.Combine(rows.Err(), rows.Close()); err != nil {
+		return nil, ErrInfo.Wrap(err)
+	}
+
+	rows, err = db.QueryContext(ctx, `PRAGMA foreign_key_check`)
+	if err != nil {
+		return nil, ErrInfo.Wrap(err)
+	}
+	for rows.Next() {
+		var table, parent string
+		var rowid sql.NullInt64
+		var index int
+		if err := rows.Scan(&table, &rowid, &parent, &index); err != nil {
+			return nil, ErrInfo.Wrap(errs.Combine(err, rows.Close()))
+		}
+		problems = append(problems, "foreign key violation in "+table+" referencing "+parent)
+	}
+	if err := errs.Combine(rows.Err(), rows.Close()); err != nil {
+		return nil, ErrInfo.Wrap(err)
+	}
+
+	return problems, nil
+}
+
+// Restore replaces the database at path with the backup, the database must not be in use.
+// The replaced database is kept at path with the suffix ".corrupt".
+func Restore(ctx context.Context, backupPath, path string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	problems, err := CheckIntegrityOf(ctx, backupPath)
+	if err != nil {
+		return err
+	}
+	if len(problems) > 0 {
+		return ErrInfo.New("backup failed integrity check: %v", problems)
+	}
+
+	if err := ensureNotInUse(ctx, path); err != nil {
+		return err
+	}
+
+	tmpPath := path + ".restore"
+	if err := removeDatabase(tmpPath); err != nil {
+		return ErrInfo.Wrap(err)
+	}
+	if err := copyFile(backupPath, tmpPath); err != nil {
+		return ErrInfo.Wrap(errs.Combine(err, os.Remove(tmpPath)))
+	}
+
+	if _, err := os.Stat(path); err == nil {
+		if err := removeDatabase(path + ".corrupt"); err != nil {
+			return ErrInfo.Wrap(err)
+		}
+		for _, suffix := range []string{"", "-wal", "-shm"} {
+			err := os.Rename(path+suffix, path+".corrupt"+suffix)
+			if err != nil && !os.IsNotExist(err) {
+				return ErrInfo.Wrap(err)
+			}
+		}
+	}
+
+	return ErrInfo.Wrap(os.Rename(tmpPath, path))
+}
+
+// ensureNotInUse returns an error when the database at path is opened by
+// another connection, e.g. by a running storage node.
+func ensureNotInUse(ctx context.Context, path string) (err error) {
+	if _, err := os.Stat(path); os.IsNotExist(err) {
+		return nil
+	}
+
+	// the exclusive lock can't be taken while other connections have the database open
+	db, err := sql.Open("sqlite3", "file:"+path+"?_locking_mode=EXCLUSIVE&_busy_timeout=0")
+	if err != nil {
+		return ErrInfo.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, ErrInfo.Wrap(db.Close())) }()
+
+	_, err = db.ExecContext(ctx, `BEGIN EXCLUSIVE; COMMIT`)
+	if sqliteErr, ok := err.(sqlite3.Error); ok && (sqliteErr.Code == sqlite3.ErrBusy || sqliteErr.Code == sqlite3.ErrLocked) {
+		return ErrInfo.New("database %s is in use, the storage node must be stopped: %v", path, err)
+	}
+	// other errors are expected from corrupt databases, which are replaced anyway
+	return nil
+}
+
+// copyFile copies the file at src into a new file at dst.
+func copyFile(src, dst string) (err error) {
+	source, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer func() { err = errs.Combine(err, source.Close()) }()
+
+	target, err := os.OpenFile(dst, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+	if err != nil {
+		return err
+	}
+	defer func() { err = errs.Combine(err, target.Close()) }()
+
+	if _, err := io.Copy(target, source); err != nil {
+		return err
+	}
+	return target.Sync()
+}
+
+// removeDatabase removes the database at path together with its journal files.
+func removeDatabase(path string) error {
+	var group errs.Group
+	for _, suffix := range []string{"", "-journal", "-wal", "-shm"} {
+		err := os.Remove(path + suffix)
+		if err != nil && !os.IsNotExist(err) {
+			group.Add(err)
+		}
+	}
+	return group.Err()
+}
@@ -6,8 +6,10 @@ package storagenodedb
 import (
 	"context"
 	"database/sql"
+	"strings"
 	"time"
 
+	sqlite3 "github.com/mattn/go-sqlite3"
 	"github.com/zeebo/errs"
 
 	"storj.io/storj/pkg/pb"
@@ -107,3 +109,89 @@ func (db *bandwidthdb) SummaryBySatellite(ctx context.Context, from, to time.Tim
 
 	return entries, ErrInfo.Wrap(rows.Err())
 }
+
+// SummaryByDay returns the usage of each UTC day, the days are truncated to midnight
+func (db *bandwidthdb) SummaryByDay(ctx context.Context, from, to time.Time) (_ map[time.Time]*bandwidth.Usage, err error) {
+	defer db.locked()()
+
+	entries := map[time.Time]*bandwidth.Usage{}
+
+	rows, err := db.db.Query(`
+		SELECT date(created_at) AS day, action, sum(amount)
+		FROM bandwidth_usage
+		WHERE ? <= created_at AND created_at <= ?
+		GROUP BY day, action`, from, to)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return entries, nil
+		}
+		return nil, ErrInfo.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	for rows.Next() {
+		var dayString string
+		var action pb.PieceAction
+		var amount int64
+
+		err := rows.Scan(&dayString, &action, &amount)
+		if err != nil {
+			return nil, ErrInfo.Wrap(err)
+		}
+
+		day, err := time.Parse("2006-01-02", dayString)
+		if err != nil {
+			return nil, ErrInfo.Wrap(err)
+		}
+
+		entry, ok := entries[day]
+		if !ok {
+			entry = &bandwidth.Usage{}
+			entries[day] = entry
+		}
+
+		entry.Include(action, amount)
+	}
+
+	return entries, ErrInfo.Wrap(rows.Err())
+}
+
+// FirstUsageBySatellite returns when the bandwidth of each satellite was used for the first time.
+func (db *bandwidthdb) FirstUsageBySatellite(ctx context.Context) (_ map[storj.NodeID]time.Time, err error) {
+	defer db.locked()()
+
+	rows, err := db.db.Query(`
+		SELECT satellite_id, MIN(created_at)
+		FROM bandwidth_usage
+		GROUP BY satellite_id`)
+	if err != nil {
+		return nil, ErrInfo.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	first := map[storj.NodeID]time.Time{}
+	for rows.Next() {
+		var satelliteID storj.NodeID
+		var created string
+		if err := rows.Scan(&satelliteID, &created); err != nil {
+			return nil, ErrInfo.Wrap(err)
+		}
+		first[satelliteID], err = parseTimestamp(created)
+		if err != nil {
+			return nil, ErrInfo.Wrap(err)
+		}
+	}
+	return first, ErrInfo.Wrap(rows.Err())
+}
+
+// parseTimestamp parses a timestamp returned by an aggregate function,
+// sqlite returns them as text instead of the type of the column.
+func parseTimestamp(value string) (time.Time, error) {
+	value = strings.TrimSuffix(value, "Z")
+	for _, format := range append(sqlite3.SQLiteTimestampFormats, time.RFC3339Nano) {
+		if timestamp, err := time.ParseInLocation(format, value, time.UTC); err == nil {
+			return timestamp, nil
+		}
+	}
+	return time.Time{}, errs.New("invalid timestamp %q", value)
+}
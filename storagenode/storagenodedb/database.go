@@ -4,6 +4,8 @@
 package storagenodedb
 
 import (
+	"context"
+
 	"github.com/zeebo/errs"
 	"go.uber.org/zap"
 
@@ -25,7 +27,8 @@ type Config struct {
 	Info2    string
 	Kademlia string
 
-	Pieces string
+	Pieces      string
+	ExtraPieces []string
 }
 
 // DB contains access to different database tables
@@ -36,6 +39,7 @@ type DB struct {
 		storage.Blobs
 		Close() error
 	}
+	extraPieces map[string]*filestore.Store
 
 	info *InfoDB
 
@@ -50,6 +54,15 @@ func New(log *zap.Logger, config Config) (*DB, error) {
 	}
 	pieces := filestore.New(piecesDir)
 
+	extraPieces := map[string]*filestore.Store{}
+	for _, path := range config.ExtraPieces {
+		dir, err := filestore.NewDir(path)
+		if err != nil {
+			return nil, err
+		}
+		extraPieces[path] = filestore.New(dir)
+	}
+
 	infodb, err := newInfo(config.Info2)
 	if err != nil {
 		return nil, err
@@ -63,7 +76,8 @@ func New(log *zap.Logger, config Config) (*DB, error) {
 	return &DB{
 		log: log,
 
-		pieces: pieces,
+		pieces:      pieces,
+		extraPieces: extraPieces,
 
 		info: infodb,
 
@@ -104,13 +118,18 @@ func (db *DB) CreateTables() error {
 
 // Close closes any resources.
 func (db *DB) Close() error {
-	return errs.Combine(
+	var group errs.Group
+	group.Add(
 		db.kdb.Close(),
 		db.ndb.Close(),
 
 		db.pieces.Close(),
 		db.info.Close(),
 	)
+	for _, pieces := range db.extraPieces {
+		group.Add(pieces.Close())
+	}
+	return group.Err()
 }
 
 // Pieces returns blob storage for pieces
@@ -118,7 +137,26 @@ func (db *DB) Pieces() storage.Blobs {
 	return db.pieces
 }
 
+// ExtraPieces returns blob storages for the additional storage directories by path
+func (db *DB) ExtraPieces() map[string]storage.Blobs {
+	extraPieces := map[string]storage.Blobs{}
+	for path, pieces := range db.extraPieces {
+		extraPieces[path] = pieces
+	}
+	return extraPieces
+}
+
 // RoutingTable returns kademlia routing table
 func (db *DB) RoutingTable() (kdb, ndb storage.KeyValueStore) {
 	return db.kdb, db.ndb
 }
+
+// Backup writes a consistent copy of the info database to path.
+func (db *DB) Backup(ctx context.Context, path string) error {
+	return db.info.Backup(ctx, path)
+}
+
+// CheckIntegrity returns the problems found by the integrity check of the info database.
+func (db *DB) CheckIntegrity(ctx context.Context) ([]string, error) {
+	return db.info.CheckIntegrity(ctx)
+}
@@ -0,0 +1,87 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package storagenodedb
+
+import (
+	"context"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/storagenode/gracefulexit"
+)
+
+type gracefulexitdb struct{ *InfoDB }
+
+// GracefulExit returns table for storing the progress of graceful exits.
+func (db *DB) GracefulExit() gracefulexit.DB { return db.info.GracefulExit() }
+
+// GracefulExit returns table for storing the progress of graceful exits.
+func (db *InfoDB) GracefulExit() gracefulexit.DB { return &gracefulexitdb{db} }
+
+// InitiateGracefulExit starts a graceful exit from the satellite.
+func (db *gracefulexitdb) InitiateGracefulExit(ctx context.Context, satelliteID storj.NodeID, initiatedAt time.Time) error {
+	defer db.locked()()
+
+	_, err := db.db.Exec(`
+		INSERT INTO
+			satellite_exit_progress(satellite_id, initiated_at, status, bytes_transferred, pieces_transferred, pieces_failed)
+		VALUES(?, ?, ?, 0, 0, 0)`, satelliteID, initiatedAt, gracefulexit.StatusInProgress)
+
+	return ErrInfo.Wrap(err)
+}
+
+// IncrementProgress increments the transferred bytes and the transferred and failed piece counts of an exit.
+func (db *gracefulexitdb) IncrementProgress(ctx context.Context, satelliteID storj.NodeID, bytes, successfulTransfers, failedTransfers int64) error {
+	defer db.locked()()
+
+	_, err := db.db.Exec(`
+		UPDATE satellite_exit_progress
+		SET bytes_transferred = bytes_transferred + ?,
+			pieces_transferred = pieces_transferred + ?,
+			pieces_failed = pieces_failed + ?
+		WHERE satellite_id = ?`, bytes, successfulTransfers, failedTransfers, satelliteID)
+
+	return ErrInfo.Wrap(err)
+}
+
+// CompleteGracefulExit marks the exit from the satellite as finished.
+func (db *gracefulexitdb) CompleteGracefulExit(ctx context.Context, satelliteID storj.NodeID, finishedAt time.Time, status gracefulexit.Status) error {
+	defer db.locked()()
+
+	_, err := db.db.Exec(`
+		UPDATE satellite_exit_progress
+		SET finished_at = ?, status = ?
+		WHERE satellite_id = ?`, finishedAt, status, satelliteID)
+
+	return ErrInfo.Wrap(err)
+}
+
+// ListGracefulExits returns the progress of all graceful exits.
+func (db *gracefulexitdb) ListGracefulExits(ctx context.Context) (_ []gracefulexit.Progress, err error) {
+	defer db.locked()()
+
+	rows, err := db.db.Query(`
+		SELECT satellite_id, initiated_at, finished_at, status, bytes_transferred, pieces_transferred, pieces_failed
+		FROM satellite_exit_progress
+		ORDER BY initiated_at`)
+	if err != nil {
+		return nil, ErrInfo.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	var exits []gracefulexit.Progress
+	for rows.Next() {
+		var progress gracefulexit.Progress
+		err := rows.Scan(&progress.SatelliteID, &progress.InitiatedAt, &progress.FinishedAt, &progress.Status,
+			&progress.BytesTransferred, &progress.PiecesTransferred, &progress.PiecesFailed)
+		if err != nil {
+			return nil, ErrInfo.Wrap(err)
+		}
+		exits = append(exits, progress)
+	}
+
+	return exits, ErrInfo.Wrap(rows.Err())
+}
@@ -21,8 +21,9 @@ var ErrInfo = errs.Class("infodb")
 
 // InfoDB implements information database for piecestore.
 type InfoDB struct {
-	mu sync.Mutex
-	db *sql.DB
+	mu       sync.Mutex
+	db       *sql.DB
+	inMemory bool
 }
 
 // newInfo creates or opens InfoDB at the specified path.
@@ -50,7 +51,7 @@ func NewInfoInMemory() (*InfoDB, error) {
 
 	db.SetMaxIdleConns(dbutil.DefaultMaxIdleConns)
 
-	return &InfoDB{db: db}, nil
+	return &InfoDB{db: db, inMemory: true}, nil
 }
 
 // Close closes any resources.
@@ -182,6 +183,45 @@ func (db *InfoDB) Migration() *migrate.Migration {
 					`ALTER TABLE pieceinfo ADD COLUMN deletion_failed_at TIMESTAMP`,
 				},
 			},
+			{
+				Description: "Add tracking of graceful exits.",
+				Version:     3,
+				Action: migrate.SQL{
+					`CREATE TABLE satellite_exit_progress (
+						satellite_id       BLOB      NOT NULL,
+						initiated_at       TIMESTAMP NOT NULL,
+						finished_at        TIMESTAMP,
+						status             INTEGER   NOT NULL,
+						bytes_transferred  BIGINT    NOT NULL,
+						pieces_transferred BIGINT    NOT NULL,
+						pieces_failed      BIGINT    NOT NULL
+					)`,
+					`CREATE UNIQUE INDEX pk_satellite_exit_progress ON satellite_exit_progress(satellite_id)`,
+				},
+			},
+			{
+				Description: "Add creation date of pieces.",
+				Version:     4,
+				Action: migrate.SQL{
+					`ALTER TABLE pieceinfo ADD COLUMN piece_creation TIMESTAMP NOT NULL DEFAULT '0001-01-01 00:00:00+00:00'`,
+				},
+			},
+			{
+				Description: "Add storage directory of pieces.",
+				Version:     5,
+				Action: migrate.SQL{
+					`ALTER TABLE pieceinfo ADD COLUMN storage_dir TEXT NOT NULL DEFAULT ''`,
+				},
+			},
+			{
+				Description: "Add order rejection reasons and indexes for expiring orders.",
+				Version:     6,
+				Action: migrate.SQL{
+					`ALTER TABLE order_archive ADD COLUMN reject_reason TEXT NOT NULL DEFAULT ''`,
+					`CREATE INDEX idx_order_archive_archived ON order_archive(archived_at)`,
+					`CREATE INDEX idx_unsent_order_expiration ON unsent_order(order_limit_expiration)`,
+				},
+			},
 		},
 	}
 }
@@ -116,16 +116,43 @@ func (db *ordersdb) ListUnsent(ctx context.Context, limit int) (_ []*orders.Info
 	return infos, ErrInfo.Wrap(rows.Err())
 }
 
-// ListUnsentBySatellite returns orders that haven't been sent yet grouped by satellite.
-// Does not return uplink identity.
-func (db *ordersdb) ListUnsentBySatellite(ctx context.Context) (map[storj.NodeID][]*orders.Info, error) {
+// ListUnsentSatellites returns the satellites which have orders that haven't been sent yet.
+func (db *ordersdb) ListUnsentSatellites(ctx context.Context) (_ []storj.NodeID, err error) {
 	defer db.locked()()
-	// TODO: add some limiting
 
 	rows, err := db.db.Query(`
-		SELECT order_limit_serialized, order_serialized
+		SELECT DISTINCT satellite_id
 		FROM unsent_order
 	`)
+	if err != nil {
+		return nil, ErrInfo.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	var satellites []storj.NodeID
+	for rows.Next() {
+		var satellite storj.NodeID
+		if err := rows.Scan(&satellite); err != nil {
+			return nil, ErrInfo.Wrap(err)
+		}
+		satellites = append(satellites, satellite)
+	}
+
+	return satellites, ErrInfo.Wrap(rows.Err())
+}
+
+// ListUnsentForSatellite returns up to limit orders of the satellite that haven't been sent yet,
+// the orders expiring first are returned first. Does not return uplink identity.
+func (db *ordersdb) ListUnsentForSatellite(ctx context.Context, satellite storj.NodeID, limit int) (_ []*orders.Info, err error) {
+	defer db.locked()()
+
+	rows, err := db.db.Query(`
+		SELECT order_limit_serialized, order_serialized
+		FROM unsent_order
+		WHERE satellite_id = ?
+		ORDER BY order_limit_expiration
+		LIMIT ?
+	`, satellite, limit)
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return nil, nil
@@ -134,7 +161,7 @@ func (db *ordersdb) ListUnsentBySatellite(ctx context.Context) (map[storj.NodeID
 	}
 	defer func() { err = errs.Combine(err, rows.Close()) }()
 
-	infos := map[storj.NodeID][]*orders.Info{}
+	var infos []*orders.Info
 	for rows.Next() {
 		var limitSerialized []byte
 		var orderSerialized []byte
@@ -158,14 +185,14 @@ func (db *ordersdb) ListUnsentBySatellite(ctx context.Context) (map[storj.NodeID
 			return nil, ErrInfo.Wrap(err)
 		}
 
-		infos[info.Limit.SatelliteId] = append(infos[info.Limit.SatelliteId], &info)
+		infos = append(infos, &info)
 	}
 
 	return infos, ErrInfo.Wrap(rows.Err())
 }
 
-// Archive marks order as being handled.
-func (db *ordersdb) Archive(ctx context.Context, satellite storj.NodeID, serial storj.SerialNumber, status orders.Status) error {
+// Archive marks order as being handled, reason explains why the order was rejected.
+func (db *ordersdb) Archive(ctx context.Context, satellite storj.NodeID, serial storj.SerialNumber, status orders.Status, reason string) error {
 	defer db.locked()()
 
 	result, err := db.db.Exec(`
@@ -173,18 +200,18 @@ func (db *ordersdb) Archive(ctx context.Context, satellite storj.NodeID, serial
 			satellite_id, serial_number,
 			order_limit_serialized, order_serialized,
 			uplink_cert_id,
-			status, archived_at
+			status, archived_at, reject_reason
 		) SELECT 
 			satellite_id, serial_number,
 			order_limit_serialized, order_serialized, 
 			uplink_cert_id,
-			?, ?
+			?, ?, ?
 		FROM unsent_order
 		WHERE satellite_id = ? AND serial_number = ?;
 
 		DELETE FROM unsent_order 
 		WHERE satellite_id = ? AND serial_number = ?;
-	`, int(status), time.Now(), satellite, serial, satellite, serial)
+	`, int(status), time.Now().UTC(), reason, satellite, serial, satellite, serial)
 	if err != nil {
 		return ErrInfo.Wrap(err)
 	}
@@ -200,13 +227,59 @@ func (db *ordersdb) Archive(ctx context.Context, satellite storj.NodeID, serial
 	return nil
 }
 
+// ArchiveExpired archives the unsent orders which expired before now as expired.
+func (db *ordersdb) ArchiveExpired(ctx context.Context, now time.Time) (_ int64, err error) {
+	defer db.locked()()
+
+	now = now.UTC()
+	result, err := db.db.Exec(`
+		INSERT INTO order_archive (
+			satellite_id, serial_number,
+			order_limit_serialized, order_serialized,
+			uplink_cert_id,
+			status, archived_at
+		) SELECT
+			satellite_id, serial_number,
+			order_limit_serialized, order_serialized,
+			uplink_cert_id,
+			?, ?
+		FROM unsent_order
+		WHERE order_limit_expiration < ?;
+
+		DELETE FROM unsent_order
+		WHERE order_limit_expiration < ?;
+	`, int(orders.StatusExpired), now, now, now)
+	if err != nil {
+		return 0, ErrInfo.Wrap(err)
+	}
+
+	count, err := result.RowsAffected()
+	return count, ErrInfo.Wrap(err)
+}
+
+// CleanArchive deletes the orders archived before archivedBefore.
+func (db *ordersdb) CleanArchive(ctx context.Context, archivedBefore time.Time) (_ int64, err error) {
+	defer db.locked()()
+
+	result, err := db.db.Exec(`
+		DELETE FROM order_archive
+		WHERE archived_at < ?
+	`, archivedBefore.UTC())
+	if err != nil {
+		return 0, ErrInfo.Wrap(err)
+	}
+
+	count, err := result.RowsAffected()
+	return count, ErrInfo.Wrap(err)
+}
+
 // ListArchived returns orders that have been sent.
 func (db *ordersdb) ListArchived(ctx context.Context, limit int) ([]*orders.ArchivedInfo, error) {
 	defer db.locked()()
 
 	rows, err := db.db.Query(`
 		SELECT order_limit_serialized, order_serialized, certificate.peer_identity, 
-			status, archived_at
+			status, archived_at, reject_reason
 		FROM order_archive
 		INNER JOIN certificate on order_archive.uplink_cert_id = certificate.cert_id
 		LIMIT ?
@@ -227,8 +300,9 @@ func (db *ordersdb) ListArchived(ctx context.Context, limit int) ([]*orders.Arch
 
 		var status int
 		var archivedAt time.Time
+		var reason string
 
-		err := rows.Scan(&limitSerialized, &orderSerialized, &uplinkIdentity, &status, &archivedAt)
+		err := rows.Scan(&limitSerialized, &orderSerialized, &uplinkIdentity, &status, &archivedAt, &reason)
 		if err != nil {
 			return nil, ErrInfo.Wrap(err)
 		}
@@ -239,6 +313,7 @@ func (db *ordersdb) ListArchived(ctx context.Context, limit int) ([]*orders.Arch
 
 		info.Status = orders.Status(status)
 		info.ArchivedAt = archivedAt
+		info.Reason = reason
 
 		err = proto.Unmarshal(limitSerialized, info.Limit)
 		if err != nil {
@@ -260,3 +335,113 @@ func (db *ordersdb) ListArchived(ctx context.Context, limit int) ([]*orders.Arch
 
 	return infos, ErrInfo.Wrap(rows.Err())
 }
+
+// CountByStatus returns the number of unsent and archived orders by their status.
+func (db *ordersdb) CountByStatus(ctx context.Context) (_ map[orders.Status]int64, err error) {
+	defer db.locked()()
+
+	counts := map[orders.Status]int64{}
+
+	var unsent int64
+	err = db.db.QueryRow(`SELECT COUNT(*) FROM unsent_order`).Scan(&unsent)
+	if err != nil {
+		return nil, ErrInfo.Wrap(err)
+	}
+	if unsent > 0 {
+		counts[orders.StatusUnsent] = unsent
+	}
+
+	rows, err := db.db.Query(`
+		SELECT status, COUNT(*)
+		FROM order_archive
+		GROUP BY status
+	`)
+	if err != nil {
+		return nil, ErrInfo.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	for rows.Next() {
+		var status int
+		var count int64
+		if err := rows.Scan(&status, &count); err != nil {
+			return nil, ErrInfo.Wrap(err)
+		}
+		counts[orders.Status(status)] = count
+	}
+
+	return counts, ErrInfo.Wrap(rows.Err())
+}
+
+// CountRejectReasons returns the number of rejected orders by the reason of the rejection.
+func (db *ordersdb) CountRejectReasons(ctx context.Context) (_ map[string]int64, err error) {
+	defer db.locked()()
+
+	rows, err := db.db.Query(`
+		SELECT reject_reason, COUNT(*)
+		FROM order_archive
+		WHERE status = ?
+		GROUP BY reject_reason
+	`, int(orders.StatusRejected))
+	if err != nil {
+		return nil, ErrInfo.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	counts := map[string]int64{}
+	for rows.Next() {
+		var reason string
+		var count int64
+		if err := rows.Scan(&reason, &count); err != nil {
+			return nil, ErrInfo.Wrap(err)
+		}
+		counts[reason] = count
+	}
+
+	return counts, ErrInfo.Wrap(rows.Err())
+}
+
+// SumArchived returns the order amounts archived with status between from and to by satellite and action.
+func (db *ordersdb) SumArchived(ctx context.Context, status orders.Status, from, to time.Time) (_ map[storj.NodeID]map[pb.PieceAction]int64, err error) {
+	defer db.locked()()
+
+	rows, err := db.db.Query(`
+		SELECT satellite_id, order_limit_serialized, order_serialized
+		FROM order_archive
+		WHERE status = ? AND ? <= archived_at AND archived_at < ?
+	`, int(status), from.UTC(), to.UTC())
+	if err != nil {
+		return nil, ErrInfo.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	sums := map[storj.NodeID]map[pb.PieceAction]int64{}
+	for rows.Next() {
+		var satelliteID storj.NodeID
+		var limitSerialized []byte
+		var orderSerialized []byte
+
+		if err := rows.Scan(&satelliteID, &limitSerialized, &orderSerialized); err != nil {
+			return nil, ErrInfo.Wrap(err)
+		}
+
+		var limit pb.OrderLimit2
+		if err := proto.Unmarshal(limitSerialized, &limit); err != nil {
+			return nil, ErrInfo.Wrap(err)
+		}
+
+		var order pb.Order2
+		if err := proto.Unmarshal(orderSerialized, &order); err != nil {
+			return nil, ErrInfo.Wrap(err)
+		}
+
+		actions, ok := sums[satelliteID]
+		if !ok {
+			actions = map[pb.PieceAction]int64{}
+			sums[satelliteID] = actions
+		}
+		actions[limit.Action] += order.Amount
+	}
+
+	return sums, ErrInfo.Wrap(rows.Err())
+}
@@ -41,9 +41,9 @@ func (db *pieceinfo) Add(ctx context.Context, info *pieces.Info) error {
 
 	_, err = db.db.ExecContext(ctx, db.Rebind(`
 		INSERT INTO
-			pieceinfo(satellite_id, piece_id, piece_size, piece_expiration, uplink_piece_hash, uplink_cert_id)
-		VALUES (?,?,?,?,?,?)
-	`), info.SatelliteID, info.PieceID, info.PieceSize, info.PieceExpiration, uplinkPieceHash, certid)
+			pieceinfo(satellite_id, piece_id, piece_size, piece_creation, piece_expiration, uplink_piece_hash, uplink_cert_id, storage_dir)
+		VALUES (?,?,?,?,?,?,?,?)
+	`), info.SatelliteID, info.PieceID, info.PieceSize, info.PieceCreation, info.PieceExpiration, uplinkPieceHash, certid, info.StorageDir)
 
 	return ErrInfo.Wrap(err)
 }
@@ -59,11 +59,11 @@ func (db *pieceinfo) Get(ctx context.Context, satelliteID storj.NodeID, pieceID
 
 	db.mu.Lock()
 	err := db.db.QueryRowContext(ctx, db.Rebind(`
-		SELECT piece_size, piece_expiration, uplink_piece_hash, certificate.peer_identity
+		SELECT piece_size, piece_creation, piece_expiration, uplink_piece_hash, certificate.peer_identity, storage_dir
 		FROM pieceinfo
 		INNER JOIN certificate ON pieceinfo.uplink_cert_id = certificate.cert_id
 		WHERE satellite_id = ? AND piece_id = ?
-	`), satelliteID, pieceID).Scan(&info.PieceSize, &info.PieceExpiration, &uplinkPieceHash, &uplinkIdentity)
+	`), satelliteID, pieceID).Scan(&info.PieceSize, &info.PieceCreation, &info.PieceExpiration, &uplinkPieceHash, &uplinkIdentity, &info.StorageDir)
 	db.mu.Unlock()
 
 	if err != nil {
@@ -84,6 +84,32 @@ func (db *pieceinfo) Get(ctx context.Context, satelliteID storj.NodeID, pieceID
 	return info, nil
 }
 
+// GetPieceIDs gets pieceIDs using the satelliteID
+func (db *pieceinfo) GetPieceIDs(ctx context.Context, satelliteID storj.NodeID, createdBefore time.Time, limit, offset int) (pieceIDs []storj.PieceID, err error) {
+	defer db.locked()()
+
+	rows, err := db.db.QueryContext(ctx, db.Rebind(`
+		SELECT piece_id
+		FROM pieceinfo
+		WHERE satellite_id = ? AND datetime(piece_creation) < datetime(?)
+		ORDER BY piece_id
+		LIMIT ? OFFSET ?
+	`), satelliteID, createdBefore, limit, offset)
+	if err != nil {
+		return nil, ErrInfo.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+	for rows.Next() {
+		var pieceID storj.PieceID
+		err = rows.Scan(&pieceID)
+		if err != nil {
+			return pieceIDs, ErrInfo.Wrap(err)
+		}
+		pieceIDs = append(pieceIDs, pieceID)
+	}
+	return pieceIDs, nil
+}
+
 // Delete deletes piece information.
 func (db *pieceinfo) Delete(ctx context.Context, satelliteID storj.NodeID, pieceID storj.PieceID) error {
 	defer db.locked()()
@@ -152,3 +178,155 @@ func (db *pieceinfo) SpaceUsed(ctx context.Context) (int64, error) {
 	}
 	return *sum, err
 }
+
+// SpaceUsedBySatellite calculates disk space used by the pieces of each satellite
+func (db *pieceinfo) SpaceUsedBySatellite(ctx context.Context) (_ map[storj.NodeID]int64, err error) {
+	defer db.locked()()
+
+	rows, err := db.db.QueryContext(ctx, db.Rebind(`
+		SELECT satellite_id, SUM(piece_size)
+		FROM pieceinfo
+		GROUP BY satellite_id
+	`))
+	if err != nil {
+		return nil, ErrInfo.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	used := map[storj.NodeID]int64{}
+	for rows.Next() {
+		var satelliteID storj.NodeID
+		var sum int64
+		if err := rows.Scan(&satelliteID, &sum); err != nil {
+			return nil, ErrInfo.Wrap(err)
+		}
+		used[satelliteID] = sum
+	}
+	return used, ErrInfo.Wrap(rows.Err())
+}
+
+// SpaceUsedByteHours calculates the byte-hours stored between from and to by the pieces of each satellite.
+// Only the pieces which are currently stored are included.
+func (db *pieceinfo) SpaceUsedByteHours(ctx context.Context, from, to time.Time) (_ map[storj.NodeID]float64, err error) {
+	defer db.locked()()
+
+	// each piece is stored from the later of its creation and from
+	// until the earlier of its expiration and to
+	rows, err := db.db.QueryContext(ctx, db.Rebind(`
+		SELECT satellite_id, SUM(piece_size * 24 * MAX(0,
+			MIN(julianday(?), julianday(COALESCE(piece_expiration, ?))) -
+			MAX(julianday(?), julianday(piece_creation))
+		))
+		FROM pieceinfo
+		GROUP BY satellite_id
+	`), to, to, from)
+	if err != nil {
+		return nil, ErrInfo.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	byteHours := map[storj.NodeID]float64{}
+	for rows.Next() {
+		var satelliteID storj.NodeID
+		var sum float64
+		if err := rows.Scan(&satelliteID, &sum); err != nil {
+			return nil, ErrInfo.Wrap(err)
+		}
+		if sum > 0 {
+			byteHours[satelliteID] = sum
+		}
+	}
+	return byteHours, ErrInfo.Wrap(rows.Err())
+}
+
+// SpaceUsedByStorageDir calculates disk space used by the pieces of each storage directory
+func (db *pieceinfo) SpaceUsedByStorageDir(ctx context.Context) (_ map[string]int64, err error) {
+	defer db.locked()()
+
+	rows, err := db.db.QueryContext(ctx, db.Rebind(`
+		SELECT storage_dir, SUM(piece_size)
+		FROM pieceinfo
+		GROUP BY storage_dir
+	`))
+	if err != nil {
+		return nil, ErrInfo.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	used := map[string]int64{}
+	for rows.Next() {
+		var storageDir string
+		var sum int64
+		if err := rows.Scan(&storageDir, &sum); err != nil {
+			return nil, ErrInfo.Wrap(err)
+		}
+		used[storageDir] = sum
+	}
+	return used, ErrInfo.Wrap(rows.Err())
+}
+
+// GetByStorageDir gets pieces stored in the storage directory
+func (db *pieceinfo) GetByStorageDir(ctx context.Context, storageDir string, limit int) (infos []pieces.StoredInfo, err error) {
+	defer db.locked()()
+
+	rows, err := db.db.QueryContext(ctx, db.Rebind(`
+		SELECT satellite_id, piece_id, piece_size
+		FROM pieceinfo
+		WHERE storage_dir = ?
+		ORDER BY satellite_id, piece_id
+		LIMIT ?
+	`), storageDir, limit)
+	if err != nil {
+		return nil, ErrInfo.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+	for rows.Next() {
+		info := pieces.StoredInfo{}
+		err = rows.Scan(&info.SatelliteID, &info.PieceID, &info.PieceSize)
+		if err != nil {
+			return infos, ErrInfo.Wrap(err)
+		}
+		infos = append(infos, info)
+	}
+	return infos, nil
+}
+
+// GetStoredAfter gets pieces ordered by satellite and piece id, starting after the specified piece
+func (db *pieceinfo) GetStoredAfter(ctx context.Context, satelliteID storj.NodeID, pieceID storj.PieceID, limit int) (infos []pieces.StoredInfo, err error) {
+	defer db.locked()()
+
+	rows, err := db.db.QueryContext(ctx, db.Rebind(`
+		SELECT satellite_id, piece_id, piece_size
+		FROM pieceinfo
+		WHERE satellite_id > ? OR (satellite_id = ? AND piece_id > ?)
+		ORDER BY satellite_id, piece_id
+		LIMIT ?
+	`), satelliteID, satelliteID, pieceID, limit)
+	if err != nil {
+		return nil, ErrInfo.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+	for rows.Next() {
+		info := pieces.StoredInfo{}
+		err = rows.Scan(&info.SatelliteID, &info.PieceID, &info.PieceSize)
+		if err != nil {
+			return infos, ErrInfo.Wrap(err)
+		}
+		infos = append(infos, info)
+	}
+	return infos, nil
+}
+
+// UpdateStorageDir updates the storage directory of a piece
+func (db *pieceinfo) UpdateStorageDir(ctx context.Context, satelliteID storj.NodeID, pieceID storj.PieceID, storageDir string) error {
+	defer db.locked()()
+
+	_, err := db.db.ExecContext(ctx, db.Rebind(`
+		UPDATE pieceinfo
+		SET storage_dir = ?
+		WHERE satellite_id = ?
+		  AND piece_id = ?
+	`), storageDir, satelliteID, pieceID)
+
+	return ErrInfo.Wrap(err)
+}
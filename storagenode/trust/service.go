@@ -5,72 +5,200 @@ package trust
 
 import (
 	"context"
+	"crypto"
 	"fmt"
-	"strings"
+	"io/ioutil"
+	"sort"
 	"sync"
+	"time"
 
 	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+	monkit "gopkg.in/spacemonkeygo/monkit.v2"
 
+	"storj.io/storj/internal/sync2"
 	"storj.io/storj/pkg/auth/signing"
 	"storj.io/storj/pkg/identity"
-	"storj.io/storj/pkg/kademlia"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/pkcrypto"
 	"storj.io/storj/pkg/storj"
 )
 
 // Error is the default error class
 var Error = errs.Class("trust:")
 
+var mon = monkit.Package()
+
+// Config defines parameters for refreshing the trusted satellites.
+type Config struct {
+	Sources         string        `help:"comma-separated list of trust sources: satellite entries (id@address), list files (file:///path) or http(s) urls serving signed lists" default:""`
+	ListSignerKey   string        `help:"path to the PEM encoded public key verifying the lists of http(s) trust sources" default:""`
+	RefreshInterval time.Duration `help:"how often the trust sources are refreshed" default:"6h0m0s"`
+
+	RejectRemovedUploads bool `help:"reject uploads from satellites removed from the trust sources, their stored pieces are still served" default:"true"`
+	CleanupRemoved       bool `help:"move the pieces of satellites removed from the trust sources to the trash" default:"false"`
+}
+
+// PeerIdentities fetches the identities of satellites, it is implemented by kademlia.
+type PeerIdentities interface {
+	// FetchPeerIdentity looks up the node and returns its identity.
+	FetchPeerIdentity(ctx context.Context, nodeID storj.NodeID) (*identity.PeerIdentity, error)
+	// FetchPeerIdentityFromNode returns the identity of the node with a known address.
+	FetchPeerIdentityFromNode(ctx context.Context, node pb.Node) (*identity.PeerIdentity, error)
+}
+
 // Pool implements different peer verifications.
+//
+// The trusted satellites are refreshed periodically from the trust sources.
+// Satellites removed from the sources are kept until their pieces are cleaned up,
+// so that the pieces they have stored can still be downloaded and audited.
 type Pool struct {
-	kademlia *kademlia.Kademlia
+	log        *zap.Logger
+	identities PeerIdentities
+	config     Config
+	sources    []Source
+
+	Loop sync2.Cycle
 
 	mu sync.RWMutex
 
 	trustAllSatellites bool
 	trustedSatellites  map[storj.NodeID]*satelliteInfoCache
+	// sourceEntries contains the last successfully fetched entries of each source
+	sourceEntries [][]Entry
 }
 
 // satelliteInfoCache caches identity information about a satellite
 type satelliteInfoCache struct {
+	// address and removed are protected by Pool.mu
+	address string
+	removed bool
+
 	mu       sync.Mutex
 	identity *identity.PeerIdentity
 }
 
-// NewPool creates a new trust pool using kademlia to find certificates and with the specified list of trusted satellites.
-func NewPool(kademlia *kademlia.Kademlia, trustAll bool, trustedSatelliteIDs string) (*Pool, error) {
+// NewPool creates a new trust pool using identities to find certificates. The
+// trusted satellites are the comma separated trustedSatelliteIDs and the
+// satellites of the trust sources in config.
+func NewPool(log *zap.Logger, identities PeerIdentities, trustAll bool, trustedSatelliteIDs string, config Config) (*Pool, error) {
+	pool := &Pool{
+		log:        log,
+		identities: identities,
+		config:     config,
+		Loop:       *sync2.NewCycle(config.RefreshInterval),
+
+		trustAllSatellites: trustAll,
+		trustedSatellites:  map[storj.NodeID]*satelliteInfoCache{},
+	}
 	if trustAll {
-		return &Pool{
-			kademlia: kademlia,
+		return pool, nil
+	}
+
+	var listSigner crypto.PublicKey
+	if config.ListSignerKey != "" {
+		data, err := ioutil.ReadFile(config.ListSignerKey)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		listSigner, err = pkcrypto.PublicKeyFromPEM(data)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+	}
+
+	sources, err := ParseSources(trustedSatelliteIDs+","+config.Sources, listSigner)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	pool.sources = sources
+	pool.sourceEntries = make([][]Entry, len(sources))
 
-			trustAllSatellites: true,
-			trustedSatellites:  map[storj.NodeID]*satelliteInfoCache{},
-		}, nil
+	// static sources are available immediately, the others are fetched by Run
+	for i, source := range sources {
+		if static, ok := source.(StaticSource); ok {
+			pool.sourceEntries[i] = static
+		}
 	}
+	pool.update()
 
-	// TODO: preload all satellite peer identities
+	return pool, nil
+}
 
-	// parse the comma separated list of approved satellite IDs into an array of storj.NodeIDs
-	trusted := make(map[storj.NodeID]*satelliteInfoCache)
+// Run refreshes the trusted satellites periodically.
+func (pool *Pool) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
 
-	for _, s := range strings.Split(trustedSatelliteIDs, ",") {
-		s = strings.TrimSpace(s)
-		if s == "" {
+	if pool.trustAllSatellites {
+		return nil
+	}
+
+	return pool.Loop.Run(ctx, func(ctx context.Context) error {
+		pool.Refresh(ctx)
+		return nil
+	})
+}
+
+// Close stops refreshing the trusted satellites.
+func (pool *Pool) Close() error {
+	pool.Loop.Close()
+	return nil
+}
+
+// Refresh fetches the trust sources and updates the trusted satellites.
+// The satellites of sources which fail to be fetched are kept unchanged.
+func (pool *Pool) Refresh(ctx context.Context) {
+	defer mon.Task()(&ctx)(nil)
+
+	for i, source := range pool.sources {
+		entries, err := source.Fetch(ctx)
+		if err != nil {
+			pool.log.Warn("unable to fetch trust source", zap.Stringer("source", source), zap.Error(err))
 			continue
 		}
 
-		satelliteID, err := storj.NodeIDFromString(s)
-		if err != nil {
-			return nil, err
+		pool.mu.Lock()
+		pool.sourceEntries[i] = entries
+		pool.mu.Unlock()
+	}
+
+	pool.update()
+}
+
+// update updates the trusted satellites from the entries of the sources.
+func (pool *Pool) update() {
+	pool.mu.Lock()
+	defer pool.mu.Unlock()
+
+	listed := map[storj.NodeID]string{}
+	for _, entries := range pool.sourceEntries {
+		for _, entry := range entries {
+			if address, ok := listed[entry.ID]; !ok || address == "" {
+				listed[entry.ID] = entry.Address
+			}
 		}
-		trusted[satelliteID] = &satelliteInfoCache{} // we will set these later
 	}
 
-	return &Pool{
-		kademlia: kademlia,
+	for id, address := range listed {
+		info, ok := pool.trustedSatellites[id]
+		if !ok {
+			pool.trustedSatellites[id] = &satelliteInfoCache{address: address}
+			pool.log.Info("satellite trusted", zap.Stringer("Satellite ID", id))
+			continue
+		}
+		if info.removed {
+			pool.log.Info("satellite trusted again", zap.Stringer("Satellite ID", id))
+		}
+		info.removed = false
+		info.address = address
+	}
 
-		trustAllSatellites: false,
-		trustedSatellites:  trusted,
-	}, nil
+	for id, info := range pool.trustedSatellites {
+		if _, ok := listed[id]; !ok && !info.removed {
+			info.removed = true
+			pool.log.Info("satellite removed from trust sources", zap.Stringer("Satellite ID", id))
+		}
+	}
 }
 
 // VerifySatelliteID checks whether id corresponds to a trusted satellite.
@@ -89,6 +217,82 @@ func (pool *Pool) VerifySatelliteID(ctx context.Context, id storj.NodeID) error
 	return nil
 }
 
+// VerifySatelliteUpload checks whether the satellite with id is trusted for new uploads.
+func (pool *Pool) VerifySatelliteUpload(ctx context.Context, id storj.NodeID) error {
+	if pool.trustAllSatellites {
+		return nil
+	}
+
+	pool.mu.RLock()
+	defer pool.mu.RUnlock()
+
+	info, ok := pool.trustedSatellites[id]
+	if !ok {
+		return fmt.Errorf("satellite %q is untrusted", id)
+	}
+	if info.removed && pool.config.RejectRemovedUploads {
+		return fmt.Errorf("satellite %q was removed from trust sources", id)
+	}
+	return nil
+}
+
+// TrustsAll returns whether all satellites are trusted.
+func (pool *Pool) TrustsAll() bool { return pool.trustAllSatellites }
+
+// GetSatellites returns the trusted satellites ordered by their id,
+// when all satellites are trusted only the satellites seen so far are returned.
+func (pool *Pool) GetSatellites(ctx context.Context) []storj.NodeID {
+	pool.mu.RLock()
+	defer pool.mu.RUnlock()
+
+	satellites := make([]storj.NodeID, 0, len(pool.trustedSatellites))
+	for id, info := range pool.trustedSatellites {
+		if info.removed {
+			continue
+		}
+		satellites = append(satellites, id)
+	}
+	sortNodeIDs(satellites)
+	return satellites
+}
+
+// GetRemovedSatellites returns the satellites removed from the trust sources
+// whose pieces should be cleaned up, ordered by their id.
+func (pool *Pool) GetRemovedSatellites(ctx context.Context) []storj.NodeID {
+	if !pool.config.CleanupRemoved {
+		return nil
+	}
+
+	pool.mu.RLock()
+	defer pool.mu.RUnlock()
+
+	var satellites []storj.NodeID
+	for id, info := range pool.trustedSatellites {
+		if info.removed {
+			satellites = append(satellites, id)
+		}
+	}
+	sortNodeIDs(satellites)
+	return satellites
+}
+
+// ForgetSatellite stops trusting a removed satellite after its pieces have been cleaned up.
+func (pool *Pool) ForgetSatellite(ctx context.Context, id storj.NodeID) {
+	pool.mu.Lock()
+	defer pool.mu.Unlock()
+
+	if info, ok := pool.trustedSatellites[id]; ok && info.removed {
+		delete(pool.trustedSatellites, id)
+	}
+}
+
+// sortNodeIDs sorts the ids in ascending order.
+func sortNodeIDs(ids []storj.NodeID) {
+	sort.Slice(ids, func(i, k int) bool {
+		return ids[i].Less(ids[k])
+	})
+}
+
 // VerifyUplinkID verifides whether id corresponds to a trusted uplink.
 func (pool *Pool) VerifyUplinkID(ctx context.Context, id storj.NodeID) error {
 	// trusting all the uplinks for now
@@ -121,11 +325,27 @@ func (pool *Pool) GetSignee(ctx context.Context, id storj.NodeID) (signing.Signe
 		}
 	}
 
+	pool.mu.RLock()
+	address := info.address
+	pool.mu.RUnlock()
+
 	info.mu.Lock()
 	defer info.mu.Unlock()
 
 	if info.identity == nil {
-		identity, err := pool.kademlia.FetchPeerIdentity(ctx, id)
+		var identity *identity.PeerIdentity
+		var err error
+		if address != "" {
+			identity, err = pool.identities.FetchPeerIdentityFromNode(ctx, pb.Node{
+				Id: id,
+				Address: &pb.NodeAddress{
+					Transport: pb.NodeTransport_TCP_TLS_GRPC,
+					Address:   address,
+				},
+			})
+		} else {
+			identity, err = pool.identities.FetchPeerIdentity(ctx, id)
+		}
 		if err != nil {
 			if err == context.Canceled {
 				return nil, err
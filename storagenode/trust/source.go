@@ -0,0 +1,247 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package trust
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/pkg/pkcrypto"
+	"storj.io/storj/pkg/storj"
+)
+
+// ErrSource is the error class for trust source errors
+var ErrSource = errs.Class("trust source")
+
+// maxListSize is the maximum size of a list served by a http(s) source
+const maxListSize = 1 << 20
+
+// fetchTimeout is the maximum duration of downloading a list from a http(s) source
+const fetchTimeout = time.Minute
+
+// Entry is a satellite listed by a trust source.
+type Entry struct {
+	ID storj.NodeID
+	// Address is optional, when empty the satellite is looked up with kademlia.
+	Address string
+}
+
+// ParseEntry parses an entry in the form id@address, the address is optional.
+func ParseEntry(s string) (Entry, error) {
+	s = strings.TrimSpace(s)
+
+	var entry Entry
+	id := s
+	if at := strings.Index(s, "@"); at >= 0 {
+		id, entry.Address = s[:at], strings.TrimSpace(s[at+1:])
+		if entry.Address == "" {
+			return Entry{}, ErrSource.New("entry %q has an empty address", s)
+		}
+	}
+
+	nodeID, err := storj.NodeIDFromString(strings.TrimSpace(id))
+	if err != nil {
+		return Entry{}, ErrSource.New("entry %q has invalid satellite id: %v", s, err)
+	}
+	entry.ID = nodeID
+	return entry, nil
+}
+
+// String returns the entry in the form id@address.
+func (entry Entry) String() string {
+	if entry.Address == "" {
+		return entry.ID.String()
+	}
+	return entry.ID.String() + "@" + entry.Address
+}
+
+// Source is a source of trusted satellites.
+type Source interface {
+	// String returns a description of the source.
+	String() string
+	// Fetch returns the satellites listed by the source.
+	Fetch(ctx context.Context) ([]Entry, error)
+}
+
+// ParseSources parses a comma-separated list of trust sources. A source is either
+// a satellite entry (id@address), a list file (file:///path) or a http(s) url
+// serving a list signed by listSigner.
+func ParseSources(sources string, listSigner crypto.PublicKey) ([]Source, error) {
+	var parsed []Source
+	var static StaticSource
+	for _, source := range strings.Split(sources, ",") {
+		source = strings.TrimSpace(source)
+		switch {
+		case source == "":
+			continue
+		case strings.HasPrefix(source, "file://"):
+			parsed = append(parsed, FileSource(strings.TrimPrefix(source, "file://")))
+		case strings.HasPrefix(source, "http://") || strings.HasPrefix(source, "https://"):
+			if listSigner == nil {
+				return nil, ErrSource.New("%q requires a list signer key", source)
+			}
+			parsed = append(parsed, &HTTPSource{URL: source, Signer: listSigner})
+		default:
+			entry, err := ParseEntry(source)
+			if err != nil {
+				return nil, err
+			}
+			static = append(static, entry)
+		}
+	}
+
+	if len(static) > 0 {
+		parsed = append([]Source{static}, parsed...)
+	}
+	return parsed, nil
+}
+
+// StaticSource is a fixed list of satellites.
+type StaticSource []Entry
+
+// String returns a description of the source.
+func (source StaticSource) String() string { return "static" }
+
+// Fetch returns the satellites of the list.
+func (source StaticSource) Fetch(ctx context.Context) ([]Entry, error) {
+	return source, nil
+}
+
+// FileSource is the path of a local file listing a satellite entry on each line.
+// Empty lines and lines starting with # are ignored.
+type FileSource string
+
+// String returns a description of the source.
+func (source FileSource) String() string { return "file://" + string(source) }
+
+// Fetch reads the satellites from the file.
+func (source FileSource) Fetch(ctx context.Context) ([]Entry, error) {
+	data, err := ioutil.ReadFile(string(source))
+	if err != nil {
+		return nil, ErrSource.Wrap(err)
+	}
+	return parseList(data)
+}
+
+// parseList parses a satellite entry from each line of data.
+func parseList(data []byte) ([]Entry, error) {
+	var entries []Entry
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		entry, err := ParseEntry(line)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, entry)
+	}
+	return entries, ErrSource.Wrap(scanner.Err())
+}
+
+// SignedList is the format of the lists served by http(s) sources.
+type SignedList struct {
+	// Issued is when the list was signed, older lists are rejected
+	// so that a replayed list cannot bring back removed satellites.
+	Issued time.Time `json:"issued"`
+	// Entries are the satellites in the form id@address.
+	Entries []string `json:"entries"`
+	// Signature is the signature of the issue time and the entries joined by newlines.
+	Signature []byte `json:"signature"`
+}
+
+// signedData returns the data covered by the signature.
+func (list *SignedList) signedData() []byte {
+	lines := append([]string{list.Issued.UTC().Format(time.RFC3339Nano)}, list.Entries...)
+	return []byte(strings.Join(lines, "\n"))
+}
+
+// SignList creates a list of the entries issued at issued and signed with key.
+func SignList(key crypto.PrivateKey, issued time.Time, entries []Entry) (*SignedList, error) {
+	list := &SignedList{Issued: issued.UTC()}
+	for _, entry := range entries {
+		list.Entries = append(list.Entries, entry.String())
+	}
+
+	signature, err := pkcrypto.HashAndSign(key, list.signedData())
+	if err != nil {
+		return nil, ErrSource.Wrap(err)
+	}
+	list.Signature = signature
+	return list, nil
+}
+
+// HTTPSource is a url serving a SignedList in JSON.
+type HTTPSource struct {
+	URL    string
+	Signer crypto.PublicKey
+
+	// mu guards issued, the issue time of the latest fetched list.
+	mu     sync.Mutex
+	issued time.Time
+}
+
+// String returns a description of the source.
+func (source *HTTPSource) String() string { return source.URL }
+
+// Fetch downloads the list and verifies its signature and that it is not
+// older than the previously fetched list.
+func (source *HTTPSource) Fetch(ctx context.Context) ([]Entry, error) {
+	ctx, cancel := context.WithTimeout(ctx, fetchTimeout)
+	defer cancel()
+
+	req, err := http.NewRequest(http.MethodGet, source.URL, nil)
+	if err != nil {
+		return nil, ErrSource.Wrap(err)
+	}
+
+	resp, err := http.DefaultClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, ErrSource.Wrap(err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, ErrSource.New("%s: unexpected status %q", source.URL, resp.Status)
+	}
+
+	var list SignedList
+	if err := json.NewDecoder(io.LimitReader(resp.Body, maxListSize)).Decode(&list); err != nil {
+		return nil, ErrSource.New("%s: invalid list: %v", source.URL, err)
+	}
+
+	if err := pkcrypto.HashAndVerifySignature(source.Signer, list.signedData(), list.Signature); err != nil {
+		return nil, ErrSource.New("%s: invalid signature: %v", source.URL, err)
+	}
+
+	source.mu.Lock()
+	defer source.mu.Unlock()
+	if list.Issued.Before(source.issued) {
+		return nil, ErrSource.New("%s: list issued at %v is older than the list issued at %v", source.URL, list.Issued, source.issued)
+	}
+
+	entries := make([]Entry, 0, len(list.Entries))
+	for _, s := range list.Entries {
+		entry, err := ParseEntry(s)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, entry)
+	}
+	source.issued = list.Issued
+	return entries, nil
+}
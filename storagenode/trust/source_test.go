@@ -0,0 +1,193 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package trust_test
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/testidentity"
+	"storj.io/storj/pkg/pkcrypto"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/storagenode/trust"
+)
+
+func TestParseSources(t *testing.T) {
+	id0 := testidentity.MustPregeneratedSignedIdentity(0, storj.LatestIDVersion()).ID
+	id1 := testidentity.MustPregeneratedSignedIdentity(1, storj.LatestIDVersion()).ID
+
+	entry, err := trust.ParseEntry(id0.String() + "@127.0.0.1:7777")
+	require.NoError(t, err)
+	assert.Equal(t, trust.Entry{ID: id0, Address: "127.0.0.1:7777"}, entry)
+	assert.Equal(t, id0.String()+"@127.0.0.1:7777", entry.String())
+
+	entry, err = trust.ParseEntry(id1.String())
+	require.NoError(t, err)
+	assert.Equal(t, trust.Entry{ID: id1}, entry)
+
+	for _, invalid := range []string{"", "invalid", id0.String() + "@", "@127.0.0.1:7777"} {
+		_, err := trust.ParseEntry(invalid)
+		assert.Error(t, err, invalid)
+	}
+
+	key, err := pkcrypto.GeneratePrivateKey()
+	require.NoError(t, err)
+
+	sources, err := trust.ParseSources(" file:///list.txt, "+id0.String()+", https://example.com/list.json,"+id1.String()+"@127.0.0.1:7777,", pkcrypto.PublicKeyFromPrivate(key))
+	require.NoError(t, err)
+	require.Len(t, sources, 3)
+	assert.Equal(t, trust.StaticSource{{ID: id0}, {ID: id1, Address: "127.0.0.1:7777"}}, sources[0])
+	assert.Equal(t, trust.FileSource("/list.txt"), sources[1])
+	assert.Equal(t, "https://example.com/list.json", sources[2].String())
+
+	_, err = trust.ParseSources("https://example.com/list.json", nil)
+	assert.Error(t, err, "missing list signer")
+}
+
+func TestFileSource(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	id0 := testidentity.MustPregeneratedSignedIdentity(0, storj.LatestIDVersion()).ID
+	id1 := testidentity.MustPregeneratedSignedIdentity(1, storj.LatestIDVersion()).ID
+
+	path := ctx.File("satellites.txt")
+	writeList := func(lines ...string) {
+		require.NoError(t, ioutil.WriteFile(path, []byte(strings.Join(lines, "\n")), 0644))
+	}
+	writeList("# trusted satellites", "", id0.String()+"@127.0.0.1:7777", "  "+id1.String())
+
+	pool, err := trust.NewPool(zaptest.NewLogger(t), nil, false, "", trust.Config{
+		Sources:              "file://" + path,
+		RefreshInterval:      time.Hour,
+		RejectRemovedUploads: true,
+		CleanupRemoved:       true,
+	})
+	require.NoError(t, err)
+
+	// file sources are only fetched on refresh
+	assert.Empty(t, pool.GetSatellites(ctx))
+	assert.Error(t, pool.VerifySatelliteID(ctx, id0))
+
+	pool.Refresh(ctx)
+	assert.Equal(t, sortedIDs(id0, id1), pool.GetSatellites(ctx))
+	assert.NoError(t, pool.VerifySatelliteUpload(ctx, id1))
+	assert.Empty(t, pool.GetRemovedSatellites(ctx))
+
+	// removed satellites are still trusted for downloads until cleaned up
+	writeList(id0.String() + "@127.0.0.1:7777")
+	pool.Refresh(ctx)
+	assert.Equal(t, []storj.NodeID{id0}, pool.GetSatellites(ctx))
+	assert.NoError(t, pool.VerifySatelliteID(ctx, id1))
+	assert.Error(t, pool.VerifySatelliteUpload(ctx, id1))
+	assert.Equal(t, []storj.NodeID{id1}, pool.GetRemovedSatellites(ctx))
+
+	// failing sources keep their previous satellites
+	writeList("invalid")
+	pool.Refresh(ctx)
+	assert.Equal(t, []storj.NodeID{id0}, pool.GetSatellites(ctx))
+
+	// satellites can be trusted again
+	writeList(id0.String(), id1.String())
+	pool.Refresh(ctx)
+	assert.Equal(t, sortedIDs(id0, id1), pool.GetSatellites(ctx))
+	assert.NoError(t, pool.VerifySatelliteUpload(ctx, id1))
+
+	writeList(id0.String())
+	pool.Refresh(ctx)
+	pool.ForgetSatellite(ctx, id1)
+	assert.Error(t, pool.VerifySatelliteID(ctx, id1))
+	assert.Empty(t, pool.GetRemovedSatellites(ctx))
+}
+
+func TestHTTPSource(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	id0 := testidentity.MustPregeneratedSignedIdentity(0, storj.LatestIDVersion()).ID
+	id1 := testidentity.MustPregeneratedSignedIdentity(1, storj.LatestIDVersion()).ID
+	entries := []trust.Entry{{ID: id0, Address: "127.0.0.1:7777"}, {ID: id1}}
+
+	key, err := pkcrypto.GeneratePrivateKey()
+	require.NoError(t, err)
+	otherKey, err := pkcrypto.GeneratePrivateKey()
+	require.NoError(t, err)
+
+	now := time.Now()
+	list, err := trust.SignList(key, now, entries)
+	require.NoError(t, err)
+	oldList, err := trust.SignList(key, now.Add(-time.Hour), entries[:1])
+	require.NoError(t, err)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		switch req.URL.Path {
+		case "/list.json":
+			_ = json.NewEncoder(w).Encode(list)
+		case "/tampered.json":
+			tampered := *list
+			tampered.Entries = []string{id0.String()}
+			_ = json.NewEncoder(w).Encode(tampered)
+		case "/old.json":
+			_ = json.NewEncoder(w).Encode(oldList)
+		case "/reissued.json":
+			reissued := *list
+			reissued.Issued = now.Add(time.Hour)
+			_ = json.NewEncoder(w).Encode(reissued)
+		default:
+			http.NotFound(w, req)
+		}
+	}))
+	defer server.Close()
+
+	source := &trust.HTTPSource{URL: server.URL + "/list.json", Signer: pkcrypto.PublicKeyFromPrivate(key)}
+	fetched, err := source.Fetch(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, entries, fetched)
+
+	// refetching the same list is allowed, an older list is rejected
+	fetched, err = source.Fetch(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, entries, fetched)
+
+	source.URL = server.URL + "/old.json"
+	_, err = source.Fetch(ctx)
+	assert.Error(t, err, "older list")
+
+	source = &trust.HTTPSource{URL: server.URL + "/old.json", Signer: pkcrypto.PublicKeyFromPrivate(key)}
+	fetched, err = source.Fetch(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, entries[:1], fetched)
+
+	source = &trust.HTTPSource{URL: server.URL + "/reissued.json", Signer: pkcrypto.PublicKeyFromPrivate(key)}
+	_, err = source.Fetch(ctx)
+	assert.Error(t, err, "changed issue time")
+
+	source = &trust.HTTPSource{URL: server.URL + "/list.json", Signer: pkcrypto.PublicKeyFromPrivate(otherKey)}
+	_, err = source.Fetch(ctx)
+	assert.Error(t, err, "wrong signer")
+
+	source = &trust.HTTPSource{URL: server.URL + "/tampered.json", Signer: pkcrypto.PublicKeyFromPrivate(key)}
+	_, err = source.Fetch(ctx)
+	assert.Error(t, err, "tampered list")
+
+	source = &trust.HTTPSource{URL: server.URL + "/missing.json", Signer: pkcrypto.PublicKeyFromPrivate(key)}
+	_, err = source.Fetch(ctx)
+	assert.Error(t, err, "missing list")
+}
+
+func sortedIDs(ids ...storj.NodeID) []storj.NodeID {
+	sort.Slice(ids, func(i, k int) bool { return ids[i].Less(ids[k]) })
+	return ids
+}
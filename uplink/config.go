@@ -36,6 +36,7 @@ type RSConfig struct {
 	RepairThreshold  int         `help:"the minimum safe pieces before a repair is triggered. m." releaseDefault:"35" devDefault:"6"`
 	SuccessThreshold int         `help:"the desired total pieces for a segment. o." releaseDefault:"80" devDefault:"8"`
 	MaxThreshold     int         `help:"the largest amount of pieces to encode to. n." releaseDefault:"130" devDefault:"10"`
+	HoldBackSpares   bool        `help:"hold back some of the nodes above the success threshold and upload to them only when a node is overloaded" default:"false"`
 }
 
 // EncryptionConfig is a configuration struct that keeps details about
@@ -77,28 +78,20 @@ var (
 func (c Config) GetMetainfo(ctx context.Context, identity *identity.FullIdentity) (db storj.Metainfo, ss streams.Store, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	tlsOpts, err := tlsopts.NewOptions(identity, c.TLS)
+	tc, err := c.getTransportClient(identity)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	// ToDo: Handle Versioning for Uplinks here
-
-	tc := transport.NewClientWithTimeouts(tlsOpts, transport.Timeouts{
-		Request: c.Client.RequestTimeout,
-		Dial:    c.Client.DialTimeout,
-	})
-
-	if c.Client.SatelliteAddr == "" {
-		return nil, nil, errors.New("satellite address not specified")
-	}
-
-	metainfo, err := metainfo.NewClient(ctx, tc, c.Client.SatelliteAddr, c.Client.APIKey)
+	metainfo, err := c.dialMetainfo(ctx, tc)
 	if err != nil {
-		return nil, nil, Error.New("failed to connect to metainfo service: %v", err)
+		return nil, nil, err
 	}
 
 	ec := ecclient.NewClient(tc, c.RS.MaxBufferMem.Int())
+	if c.RS.HoldBackSpares {
+		ec = ecclient.NewClientWithSpares(tc, c.RS.MaxBufferMem.Int())
+	}
 	fc, err := infectious.NewFEC(c.RS.MinThreshold, c.RS.MaxThreshold)
 	if err != nil {
 		return nil, nil, Error.New("failed to create erasure coding client: %v", err)
@@ -132,6 +125,44 @@ func (c Config) GetMetainfo(ctx context.Context, identity *identity.FullIdentity
 	return kvmetainfo.New(metainfo, buckets, streams, segments, key, c.Enc.BlockSize.Int32(), rs, c.Client.SegmentSize.Int64()), streams, nil
 }
 
+// GetMetainfoClient returns a client for the segment level metainfo service of the satellite
+func (c Config) GetMetainfoClient(ctx context.Context, identity *identity.FullIdentity) (client *metainfo.Metainfo, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	tc, err := c.getTransportClient(identity)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.dialMetainfo(ctx, tc)
+}
+
+func (c Config) getTransportClient(identity *identity.FullIdentity) (transport.Client, error) {
+	tlsOpts, err := tlsopts.NewOptions(identity, c.TLS)
+	if err != nil {
+		return nil, err
+	}
+
+	// ToDo: Handle Versioning for Uplinks here
+
+	return transport.NewClientWithTimeouts(tlsOpts, transport.Timeouts{
+		Request: c.Client.RequestTimeout,
+		Dial:    c.Client.DialTimeout,
+	}), nil
+}
+
+func (c Config) dialMetainfo(ctx context.Context, tc transport.Client) (*metainfo.Metainfo, error) {
+	if c.Client.SatelliteAddr == "" {
+		return nil, errors.New("satellite address not specified")
+	}
+
+	client, err := metainfo.NewClient(ctx, tc, c.Client.SatelliteAddr, c.Client.APIKey)
+	if err != nil {
+		return nil, Error.New("failed to connect to metainfo service: %v", err)
+	}
+	return client, nil
+}
+
 // GetRedundancyScheme returns the configured redundancy scheme for new uploads
 func (c Config) GetRedundancyScheme() storj.RedundancyScheme {
 	return storj.RedundancyScheme{
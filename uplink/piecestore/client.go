@@ -10,6 +10,8 @@ import (
 	"github.com/zeebo/errs"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"storj.io/storj/internal/memory"
 	"storj.io/storj/pkg/auth/signing"
@@ -65,6 +67,32 @@ func (client *Client) Delete(ctx context.Context, limit *pb.OrderLimit2) error {
 	return Error.Wrap(err)
 }
 
+// DeletePieces asks the piece store to delete the pieces of a request signed by the satellite,
+// it returns the number of pieces queued for deletion.
+func (client *Client) DeletePieces(ctx context.Context, req *pb.DeletePiecesRequest) (int64, error) {
+	resp, err := client.client.DeletePieces(ctx, req)
+	if err != nil {
+		return 0, Error.Wrap(err)
+	}
+	return resp.Queued, nil
+}
+
+// Retain uses a bloom filter to tell the piece store which pieces to keep.
+func (client *Client) Retain(ctx context.Context, req *pb.RetainRequest) error {
+	_, err := client.client.Retain(ctx, req)
+	return Error.Wrap(err)
+}
+
+// RestoreTrash asks the piece store to restore the trashed pieces of a request signed by the satellite,
+// it returns the number of restored pieces.
+func (client *Client) RestoreTrash(ctx context.Context, req *pb.RestoreTrashRequest) (int64, error) {
+	resp, err := client.client.RestoreTrash(ctx, req)
+	if err != nil {
+		return 0, Error.Wrap(err)
+	}
+	return resp.Restored, nil
+}
+
 // Close closes the underlying connection.
 func (client *Client) Close() error {
 	return client.conn.Close()
@@ -87,3 +115,12 @@ func ignoreEOF(err error) error {
 	}
 	return err
 }
+
+// checkOverloaded wraps the error with ErrOverloaded when the storage node
+// rejected the request due to too many concurrent transfers.
+func checkOverloaded(err error) error {
+	if status.Code(errs.Unwrap(err)) == codes.ResourceExhausted {
+		return ErrOverloaded.Wrap(err)
+	}
+	return err
+}
@@ -157,7 +157,7 @@ func (client *Download) Read(data []byte) (read int, _ error) {
 		}
 
 		// we still need to continue until we have actually handled all of the errors
-		client.unread.IncludeError(err)
+		client.unread.IncludeError(checkOverloaded(err))
 	}
 
 	// all downloaded
@@ -121,7 +121,7 @@ func (client *Upload) Write(data []byte) (written int, _ error) {
 			Order: order,
 		})
 		if err != nil {
-			client.sendError = err
+			client.sendError = client.closeError(err)
 			return written, ErrProtocol.Wrap(client.sendError)
 		}
 
@@ -133,7 +133,7 @@ func (client *Upload) Write(data []byte) (written int, _ error) {
 			},
 		})
 		if err != nil {
-			client.sendError = err
+			client.sendError = client.closeError(err)
 			return written, ErrProtocol.Wrap(client.sendError)
 		}
 
@@ -168,7 +168,7 @@ func (client *Upload) Commit() (*pb.PieceHash, error) {
 		// something happened during sending, try to figure out what exactly
 		// since sendError was already reported, we don't need to rehandle it.
 		_, closeErr := client.stream.CloseAndRecv()
-		return nil, Error.Wrap(closeErr)
+		return nil, Error.Wrap(checkOverloaded(closeErr))
 	}
 
 	// sign the hash for storage node
@@ -195,7 +195,7 @@ func (client *Upload) Commit() (*pb.PieceHash, error) {
 		// combine all the errors from before
 		// sendErr is io.EOF when failed to send, so don't care
 		// closeErr is io.EOF when storage node closed before sending us a response
-		return nil, errs.Combine(ErrProtocol.New("expected piece hash"), ignoreEOF(sendErr), ignoreEOF(closeErr))
+		return nil, errs.Combine(checkOverloaded(ignoreEOF(closeErr)), ErrProtocol.New("expected piece hash"), ignoreEOF(sendErr))
 	}
 
 	// verification
@@ -206,3 +206,16 @@ func (client *Upload) Commit() (*pb.PieceHash, error) {
 	// closeErr is io.EOF when storage node closed properly
 	return response.Done, errs.Combine(verifyErr, ignoreEOF(sendErr), ignoreEOF(closeErr))
 }
+
+// closeError returns the error the storage node closed the stream with,
+// when sending failed because the stream was closed.
+func (client *Upload) closeError(sendErr error) error {
+	if sendErr != io.EOF {
+		return sendErr
+	}
+	_, closeErr := client.stream.CloseAndRecv()
+	if closeErr == nil || closeErr == io.EOF {
+		return sendErr
+	}
+	return checkOverloaded(closeErr)
+}
@@ -21,6 +21,9 @@ var (
 	ErrProtocol = errs.Class("protocol")
 	// ErrVerifyUntrusted is an error in case there is a trust issue.
 	ErrVerifyUntrusted = errs.Class("untrusted")
+	// ErrOverloaded is an error class for transfers rejected by a storage node
+	// due to too many concurrent transfers, they can be retried with another node.
+	ErrOverloaded = errs.Class("overloaded")
 )
 
 // VerifyPieceHash verifies piece hash which is sent by peer.